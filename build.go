@@ -291,10 +291,12 @@ func runDev() {
 
 func test() {
 	runCmd("go", nil, "test", "-v", "./internal/...", "./pkg/...")
+	runCmd("go", nil, "test", "-v", "-tags", "wasm_plugins", "./pkg/plugin/...")
 }
 
 func vet() {
 	runCmd("go", nil, "vet", "./internal/...", "./pkg/...")
+	runCmd("go", nil, "vet", "-tags", "wasm_plugins", "./pkg/plugin/...")
 	goFmt(false)
 }
 
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
@@ -58,6 +59,14 @@ func WithContentGeneratorPoller(poller Poller) ContentManagerOption {
 	}
 }
 
+// WithContentPushHub configures the hub for content pushed by plugins. Pushed content
+// is sent to the client instead of polling for content.
+func WithContentPushHub(hub *ContentPushHub) ContentManagerOption {
+	return func(manager *ContentManager) {
+		manager.contentPushHub = hub
+	}
+}
+
 // ContentManager manages content for websockets.
 type ContentManager struct {
 	ctx                 context.Context
@@ -66,6 +75,13 @@ type ContentManager struct {
 	contentGenerateFunc ContentGenerateFunc
 	poller              Poller
 	updateContentCh     chan struct{}
+	contentPushHub      *ContentPushHub
+
+	// sentPushMu guards sentPushPath and sentPushVersion, the pushed content last
+	// sent to the client.
+	sentPushMu      sync.Mutex
+	sentPushPath    string
+	sentPushVersion uint64
 }
 
 // NewContentManager creates an instance of ContentManager.
@@ -103,9 +119,68 @@ func (cm *ContentManager) Start(ctx context.Context, state octant.State, s Octan
 	})
 	defer updateCancel()
 
+	if cm.contentPushHub != nil {
+		pushCancel := cm.forwardPushedContent(ctx, state, s)
+		defer pushCancel()
+	}
+
 	cm.poller.Run(ctx, cm.updateContentCh, cm.runUpdate(state, s), event.DefaultScheduleDelay)
 }
 
+// forwardPushedContent sends content pushed for the current content path to the client.
+func (cm *ContentManager) forwardPushedContent(ctx context.Context, state octant.State, s OctantClient) func() {
+	pushCh := make(chan struct{}, 1)
+
+	unsubscribe := cm.contentPushHub.Subscribe(func(contentPath string) {
+		if !isSameContentPath(contentPath, state.GetContentPath()) {
+			return
+		}
+
+		select {
+		case pushCh <- struct{}{}:
+		default:
+		}
+	})
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-pushCh:
+				cm.sendPushedContent(state, s)
+			}
+		}
+	}()
+
+	return unsubscribe
+}
+
+// sendPushedContent sends pushed content for the current content path to the client if
+// it hasn't been sent already. It returns false if content is not being pushed for the path.
+func (cm *ContentManager) sendPushedContent(state octant.State, s OctantClient) bool {
+	contentPath := state.GetContentPath()
+
+	cm.sentPushMu.Lock()
+	defer cm.sentPushMu.Unlock()
+
+	pushed, ok := cm.contentPushHub.latestPushed(contentPath)
+	if !ok {
+		cm.sentPushPath = ""
+		cm.sentPushVersion = 0
+		return false
+	}
+
+	if isSameContentPath(contentPath, cm.sentPushPath) && pushed.version == cm.sentPushVersion {
+		return true
+	}
+
+	s.Send(CreateContentEvent(pushed.response, state.GetNamespace(), contentPath, state.GetQueryParams()))
+	cm.sentPushPath = contentPath
+	cm.sentPushVersion = pushed.version
+	return true
+}
+
 func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFunc {
 	return func(ctx context.Context) bool {
 		contentPath := state.GetContentPath()
@@ -113,6 +188,10 @@ func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFu
 			return false
 		}
 
+		if cm.contentPushHub != nil && cm.sendPushedContent(state, s) {
+			return false
+		}
+
 		content, _, err := cm.contentGenerateFunc(ctx, state)
 		if err != nil {
 			var ae *oerrors.AccessError
@@ -7,7 +7,9 @@ package api_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -72,6 +74,128 @@ func TestContentManager_GenerateContent(t *testing.T) {
 	manager.Start(ctx, state, octantClient)
 }
 
+func TestContentManager_PushedContent(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	state := octantFake.NewMockState(controller)
+
+	state.EXPECT().GetContentPath().Return("/plugin/path").AnyTimes()
+	state.EXPECT().GetNamespace().Return("default").AnyTimes()
+	state.EXPECT().GetQueryParams().Return(nil).AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).Return(func() {})
+
+	hub := api.NewContentPushHub()
+
+	initial := component.NewContentResponse(component.TitleFromString("Initial"))
+	hub.PushContent("plugin/path", *initial)
+
+	updated := component.NewContentResponse(component.TitleFromString("Updated"))
+
+	sent := make(chan struct{})
+	octantClient := fake.NewMockOctantClient(controller)
+	gomock.InOrder(
+		octantClient.EXPECT().Send(api.CreateContentEvent(*initial, "default", "/plugin/path", nil)),
+		octantClient.EXPECT().Send(api.CreateContentEvent(*updated, "default", "/plugin/path", nil)).
+			Do(func(octant.Event) { close(sent) }),
+	)
+
+	contentGenerator := func(ctx context.Context, state octant.State) (api.Content, bool, error) {
+		return api.Content{}, false, fmt.Errorf("content should not be generated for a pushed path")
+	}
+
+	poller := &runOncePoller{started: make(chan struct{})}
+
+	manager := api.NewContentManager(moduleManager, log.NopLogger(),
+		api.WithContentGenerator(contentGenerator),
+		api.WithContentGeneratorPoller(poller),
+		api.WithContentPushHub(hub))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		manager.Start(ctx, state, octantClient)
+		close(done)
+	}()
+
+	<-poller.started
+	hub.PushContent("plugin/path", *updated)
+	hub.PushContent("plugin/other", *initial)
+
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for pushed content")
+	}
+
+	cancel()
+	<-done
+}
+
+func TestContentManager_PushedContent_sent_once(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	state := octantFake.NewMockState(controller)
+
+	state.EXPECT().GetContentPath().Return("/plugin/path").AnyTimes()
+	state.EXPECT().GetNamespace().Return("default").AnyTimes()
+	state.EXPECT().GetQueryParams().Return(nil).AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).Return(func() {})
+
+	hub := api.NewContentPushHub()
+
+	pushed := component.NewContentResponse(component.TitleFromString("Pushed"))
+	hub.PushContent("plugin/path", *pushed)
+
+	// Polling again doesn't resend content which hasn't changed.
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(api.CreateContentEvent(*pushed, "default", "/plugin/path", nil))
+
+	contentGenerator := func(ctx context.Context, state octant.State) (api.Content, bool, error) {
+		return api.Content{}, false, fmt.Errorf("content should not be generated for a pushed path")
+	}
+
+	poller := api.NewSingleRunPoller()
+
+	manager := api.NewContentManager(moduleManager, log.NopLogger(),
+		api.WithContentGenerator(contentGenerator),
+		api.WithContentGeneratorPoller(&repeatPoller{poller: poller, times: 3}),
+		api.WithContentPushHub(hub))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	manager.Start(ctx, state, octantClient)
+}
+
+// repeatPoller runs its action a number of times.
+type repeatPoller struct {
+	poller api.Poller
+	times  int
+}
+
+func (p *repeatPoller) Run(ctx context.Context, ch <-chan struct{}, action api.PollerFunc, resetDuration time.Duration) {
+	for i := 0; i < p.times; i++ {
+		p.poller.Run(ctx, ch, action, resetDuration)
+	}
+}
+
+// runOncePoller runs its action once and waits for the context to be canceled.
+type runOncePoller struct {
+	started chan struct{}
+}
+
+func (p *runOncePoller) Run(ctx context.Context, _ <-chan struct{}, action api.PollerFunc, _ time.Duration) {
+	action(ctx)
+	close(p.started)
+	<-ctx.Done()
+}
+
 func TestContentManager_SetContentPath(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"strings"
+	"sync"
+
+	pluginAPI "github.com/vmware-tanzu/octant/pkg/plugin/api"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// ContentPushHub distributes content pushed by plugins to content managers. It keeps
+// the latest content pushed for each content path so content managers can use it
+// instead of polling.
+type ContentPushHub struct {
+	mu          sync.Mutex
+	latest      map[string]pushedContent
+	subscribers map[int]func(contentPath string)
+	nextID      int
+	version     uint64
+}
+
+// pushedContent is content pushed for a content path. Version increases with every
+// push so content managers can tell whether they have sent it.
+type pushedContent struct {
+	response component.ContentResponse
+	version  uint64
+}
+
+var _ pluginAPI.ContentPusher = (*ContentPushHub)(nil)
+
+// NewContentPushHub creates an instance of ContentPushHub.
+func NewContentPushHub() *ContentPushHub {
+	return &ContentPushHub{
+		latest:      make(map[string]pushedContent),
+		subscribers: make(map[int]func(contentPath string)),
+	}
+}
+
+// PushContent stores content for a content path and notifies subscribers.
+func (h *ContentPushHub) PushContent(contentPath string, response component.ContentResponse) {
+	contentPath = normalizeContentPath(contentPath)
+
+	h.mu.Lock()
+	h.version++
+	h.latest[contentPath] = pushedContent{response: response, version: h.version}
+	subscribers := h.subscriberList()
+	h.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(contentPath)
+	}
+}
+
+// EndContentPush removes pushed content for a content path.
+func (h *ContentPushHub) EndContentPush(contentPath string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.latest, normalizeContentPath(contentPath))
+}
+
+// Latest returns the latest content pushed for a content path. It returns false if
+// content is not being pushed for the path.
+func (h *ContentPushHub) Latest(contentPath string) (component.ContentResponse, bool) {
+	pushed, ok := h.latestPushed(contentPath)
+	return pushed.response, ok
+}
+
+// latestPushed returns the latest content pushed for a content path with its version.
+func (h *ContentPushHub) latestPushed(contentPath string) (pushedContent, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	pushed, ok := h.latest[normalizeContentPath(contentPath)]
+	return pushed, ok
+}
+
+// Subscribe registers a function that is called with the content path when content is
+// pushed. The function should not block. Call the returned function to unsubscribe.
+func (h *ContentPushHub) Subscribe(fn func(contentPath string)) func() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	id := h.nextID
+	h.nextID++
+	h.subscribers[id] = fn
+
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.subscribers, id)
+	}
+}
+
+// subscriberList returns the current subscribers. The caller must hold the lock.
+func (h *ContentPushHub) subscriberList() []func(contentPath string) {
+	list := make([]func(contentPath string), 0, len(h.subscribers))
+	for _, fn := range h.subscribers {
+		list = append(list, fn)
+	}
+
+	return list
+}
+
+// isSameContentPath returns true if two content paths are the same, ignoring leading
+// and trailing slashes.
+func isSameContentPath(a, b string) bool {
+	return normalizeContentPath(a) == normalizeContentPath(b)
+}
+
+func normalizeContentPath(contentPath string) string {
+	return strings.Trim(contentPath, "/")
+}
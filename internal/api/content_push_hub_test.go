@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestContentPushHub(t *testing.T) {
+	hub := api.NewContentPushHub()
+
+	var notified []string
+	unsubscribe := hub.Subscribe(func(contentPath string) {
+		notified = append(notified, contentPath)
+	})
+
+	_, ok := hub.Latest("plugin/path")
+	assert.False(t, ok)
+
+	response := component.NewContentResponse(component.TitleFromString("Pushed"))
+	hub.PushContent("/plugin/path", *response)
+
+	got, ok := hub.Latest("plugin/path/")
+	assert.True(t, ok)
+	assert.Equal(t, *response, got)
+	assert.Equal(t, []string{"plugin/path"}, notified)
+
+	unsubscribe()
+	hub.PushContent("plugin/other", *response)
+	assert.Equal(t, []string{"plugin/path"}, notified)
+
+	hub.EndContentPush("plugin/path")
+	_, ok = hub.Latest("plugin/path")
+	assert.False(t, ok)
+}
@@ -156,6 +156,12 @@ func NavigationGenerator(ctx context.Context, state octant.State, config Navigat
 		return nil, err
 	}
 
+	for _, m := range modules {
+		if contributor, ok := m.(module.NavigationContributor); ok {
+			navigation.Contribute(lookup, contributor.NavigationContributions())
+		}
+	}
+
 	for _, m := range modules {
 		sections = append(sections, lookup[m.Name()]...)
 	}
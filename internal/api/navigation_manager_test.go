@@ -84,6 +84,53 @@ func TestNavigationGenerator(t *testing.T) {
 				{Title: "module"},
 			},
 		},
+		{
+			name: "with navigation contributions",
+			setup: func(controller *gomock.Controller) (*configFake.MockDash, *octantFake.MockState) {
+				m := moduleFake.NewMockModule(controller)
+				m.EXPECT().ContentPath().Return("/overview")
+				m.EXPECT().Name().Return("overview").AnyTimes()
+				m.EXPECT().
+					Navigation(gomock.Any(), "default", "/overview").
+					Return([]navigation.Navigation{
+						{Title: "Workloads"},
+						{Title: "Config and Storage", Children: []navigation.Navigation{{Title: "Secrets"}}},
+					}, nil)
+
+				pluginModule := moduleFake.NewMockModule(controller)
+				pluginModule.EXPECT().ContentPath().Return("plugin")
+				pluginModule.EXPECT().Name().Return("plugin").AnyTimes()
+				pluginModule.EXPECT().
+					Navigation(gomock.Any(), "default", "plugin").
+					Return(nil, nil)
+				contributor := &navigationContributor{
+					Module: pluginModule,
+					contributions: []navigation.Contribution{
+						{Module: "overview", Section: "Config and Storage", Title: "Certificates", Path: "plugin/certificates"},
+						{Module: "overview", Section: "Missing", Title: "Ignored", Path: "plugin/ignored"},
+						{Module: "missing", Section: "Workloads", Title: "Ignored", Path: "plugin/ignored"},
+					},
+				}
+
+				moduleManager := moduleFake.NewMockManagerInterface(controller)
+				moduleManager.EXPECT().Modules().Return([]module.Module{m, contributor})
+
+				dashConfig := configFake.NewMockDash(controller)
+				dashConfig.EXPECT().ModuleManager().Return(moduleManager)
+
+				state := octantFake.NewMockState(controller)
+				state.EXPECT().GetNamespace().Return("default")
+
+				return dashConfig, state
+			},
+			expected: []navigation.Navigation{
+				{Title: "Workloads"},
+				{Title: "Config and Storage", Children: []navigation.Navigation{
+					{Title: "Secrets"},
+					{Title: "Certificates", Path: "plugin/certificates"},
+				}},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -101,3 +148,14 @@ func TestNavigationGenerator(t *testing.T) {
 		})
 	}
 }
+
+type navigationContributor struct {
+	module.Module
+	contributions []navigation.Contribution
+}
+
+var _ module.NavigationContributor = (*navigationContributor)(nil)
+
+func (c *navigationContributor) NavigationContributions() []navigation.Contribution {
+	return c.contributions
+}
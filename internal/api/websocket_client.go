@@ -74,7 +74,12 @@ func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, manager *Webs
 		handlers:   make(map[string][]octant.ClientRequestHandler),
 	}
 
-	state := NewWebsocketState(dashConfig, actionDispatcher, client)
+	var stateOptions []WebsocketStateOption
+	if manager != nil {
+		stateOptions = append(stateOptions, WebsocketStateContentPushHub(manager.ContentPushHub()))
+	}
+
+	state := NewWebsocketState(dashConfig, actionDispatcher, client, stateOptions...)
 	go state.Start(ctx)
 
 	client.state = state
@@ -127,6 +132,9 @@ func (c *WebsocketClient) readPump() {
 	defer func() {
 		c.isOpen = false
 		c.logger.Debugf("closing read pump")
+		if c.manager != nil {
+			c.manager.Unregister(c)
+		}
 	}()
 
 	go func() {
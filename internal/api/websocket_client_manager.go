@@ -46,6 +46,7 @@ type WebsocketClientManager struct {
 
 	ctx              context.Context
 	actionDispatcher ActionDispatcher
+	contentPushHub   *ContentPushHub
 }
 
 var _ ClientManager = (*WebsocketClientManager)(nil)
@@ -60,9 +61,15 @@ func NewWebsocketClientManager(ctx context.Context, dispatcher ActionDispatcher)
 		requestList:      make(chan bool),
 		recvList:         make(chan []*WebsocketClient),
 		actionDispatcher: dispatcher,
+		contentPushHub:   NewContentPushHub(),
 	}
 }
 
+// ContentPushHub returns the hub for content pushed by plugins to the manager's clients.
+func (m *WebsocketClientManager) ContentPushHub() *ContentPushHub {
+	return m.contentPushHub
+}
+
 func (m *WebsocketClientManager) Clients() []*WebsocketClient {
 	m.requestList <- true
 	clients := <-m.recvList
@@ -82,6 +89,9 @@ func (m *WebsocketClientManager) Run(ctx context.Context) {
 			if cancelFunc, ok := m.clients[client]; ok {
 				cancelFunc()
 				delete(m.clients, client)
+				if client.dashConfig != nil {
+					client.dashConfig.ModuleManager().RemoveClient(client.ID())
+				}
 			}
 		case <-m.requestList:
 			clients := []*WebsocketClient{}
@@ -93,6 +103,15 @@ func (m *WebsocketClientManager) Run(ctx context.Context) {
 	}
 }
 
+// Unregister unregisters a client whose connection closed. The state modules
+// keep for the client is dropped.
+func (m *WebsocketClientManager) Unregister(client *WebsocketClient) {
+	select {
+	case m.unregister <- client:
+	case <-m.ctx.Done():
+	}
+}
+
 // ClientFromRequest creates a websocket client from a http request.
 func (m *WebsocketClientManager) ClientFromRequest(dashConfig config.Dash, w http.ResponseWriter, r *http.Request) (*WebsocketClient, error) {
 	clientID, err := uuid.NewUUID()
@@ -108,11 +127,8 @@ func (m *WebsocketClientManager) ClientFromRequest(dashConfig config.Dash, w htt
 	ctx, cancel := context.WithCancel(m.ctx)
 	client := NewWebsocketClient(ctx, conn, m, dashConfig, m.actionDispatcher, clientID)
 	m.register <- &clientMeta{
-		cancelFunc: func() {
-			cancel()
-			m.unregister <- client
-		},
-		client: client,
+		cancelFunc: cancel,
+		client:     client,
 	}
 
 	return client, nil
@@ -132,11 +148,8 @@ func (m *WebsocketClientManager) TemporaryClientFromLoadingRequest(w http.Respon
 	ctx, cancel := context.WithCancel(m.ctx)
 	client := NewTemporaryWebsocketClient(ctx, conn, m, m.actionDispatcher, clientID)
 	m.register <- &clientMeta{
-		cancelFunc: func() {
-			cancel()
-			m.unregister <- client
-		},
-		client: client,
+		cancelFunc: cancel,
+		client:     client,
 	}
 
 	return client, nil
@@ -34,11 +34,16 @@ type StateManager interface {
 	Start(ctx context.Context, state octant.State, s OctantClient)
 }
 
-func defaultStateManagers(clientID string, dashConfig config.Dash) []StateManager {
+func defaultStateManagers(clientID string, dashConfig config.Dash, contentPushHub *ContentPushHub) []StateManager {
 	logger := dashConfig.Logger().With("client-id", clientID)
 
+	var contentManagerOptions []ContentManagerOption
+	if contentPushHub != nil {
+		contentManagerOptions = append(contentManagerOptions, WithContentPushHub(contentPushHub))
+	}
+
 	return []StateManager{
-		NewContentManager(dashConfig.ModuleManager(), logger),
+		NewContentManager(dashConfig.ModuleManager(), logger, contentManagerOptions...),
 		NewHelperStateManager(dashConfig),
 		NewFilterManager(),
 		NewNavigationManager(dashConfig),
@@ -92,6 +97,13 @@ func WebsocketStateManagers(managers []StateManager) WebsocketStateOption {
 	}
 }
 
+// WebsocketStateContentPushHub configures the hub for content pushed by plugins.
+func WebsocketStateContentPushHub(hub *ContentPushHub) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.contentPushHub = hub
+	}
+}
+
 // WebsocketState manages state for a websocket client.
 type WebsocketState struct {
 	dashConfig         config.Dash
@@ -105,6 +117,7 @@ type WebsocketState struct {
 	mu               sync.RWMutex
 	managers         []StateManager
 	actionDispatcher ActionDispatcher
+	contentPushHub   *ContentPushHub
 
 	startCtx           context.Context
 	managersCancelFunc context.CancelFunc
@@ -132,7 +145,7 @@ func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher
 	}
 
 	if len(w.managers) < 1 {
-		w.managers = defaultStateManagers(wsClient.ID(), dashConfig)
+		w.managers = defaultStateManagers(wsClient.ID(), dashConfig, w.contentPushHub)
 	}
 
 	return w
@@ -159,8 +172,10 @@ func NewTemporaryWebsocketState(actionDispatcher ActionDispatcher, wsClient Octa
 	return w
 }
 
-// Start starts WebsocketState by starting all associated StateManagers.
+// Start starts WebsocketState by starting all associated StateManagers. The
+// managers' context carries the ID of the client.
 func (c *WebsocketState) Start(ctx context.Context) {
+	ctx = octant.WithClientID(ctx, c.wsClient.ID())
 	for i := range c.managers {
 		go c.managers[i].Start(ctx, c, c.wsClient)
 	}
@@ -177,8 +192,9 @@ func (c *WebsocketState) Handlers() []octant.ClientRequestHandler {
 	return handlers
 }
 
-// Dispatch dispatches a message.
+// Dispatch dispatches a message. The action's context carries the ID of the client.
 func (c *WebsocketState) Dispatch(ctx context.Context, actionName string, payload action.Payload) error {
+	ctx = octant.WithClientID(ctx, c.wsClient.ID())
 	return c.actionDispatcher.Dispatch(ctx, c, actionName, payload)
 }
 
@@ -27,9 +27,12 @@ func TestWebsocketState_Start(t *testing.T) {
 	mocks := newWebsocketStateMocks(t, "default")
 	defer mocks.finish()
 
+	mocks.wsClient.EXPECT().ID().Return("client")
+
 	started := make(chan bool, 1)
 	mocks.stateManager.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, state octant.State, wsClient api.OctantClient) {
+			assert.Equal(t, "client", octant.ClientIDFrom(ctx))
 			started <- true
 		})
 	s := mocks.factory()
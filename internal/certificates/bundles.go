@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certificates
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Bundle is PEM encoded certificate data stored in a field of an object.
+type Bundle struct {
+	// Field describes where the data is stored, e.g. tls.crt or
+	// webhook example.com caBundle.
+	Field string
+	// Webhook is the name of the webhook whose caBundle this is, if any.
+	Webhook string
+	Data    []byte
+}
+
+// Bundles returns the certificate data an object stores: the certificates of
+// TLS secrets, the caBundle of each webhook of webhook configurations, and the
+// caBundle of APIServices.
+func Bundles(object runtime.Object) ([]Bundle, error) {
+	u, err := toUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.GroupVersionKind().GroupKind().String() {
+	case "Secret":
+		secretType, _, _ := unstructured.NestedString(u.Object, "type")
+		if secretType != "kubernetes.io/tls" {
+			return nil, nil
+		}
+
+		var bundles []Bundle
+		for _, key := range []string{"tls.crt", "ca.crt"} {
+			data, err := decodedField(u, "data", key)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			if len(data) > 0 {
+				bundles = append(bundles, Bundle{Field: key, Data: data})
+			}
+		}
+		return bundles, nil
+	case "MutatingWebhookConfiguration.admissionregistration.k8s.io",
+		"ValidatingWebhookConfiguration.admissionregistration.k8s.io":
+		webhooks, _, err := unstructured.NestedSlice(u.Object, "webhooks")
+		if err != nil {
+			return nil, err
+		}
+
+		var bundles []Bundle
+		for _, item := range webhooks {
+			webhook, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(webhook, "name")
+			data, err := decodedField(&unstructured.Unstructured{Object: webhook}, "clientConfig", "caBundle")
+			if err != nil {
+				return nil, fmt.Errorf("webhook %s caBundle: %w", name, err)
+			}
+			if len(data) > 0 {
+				bundles = append(bundles, Bundle{
+					Field:   fmt.Sprintf("webhook %s caBundle", name),
+					Webhook: name,
+					Data:    data,
+				})
+			}
+		}
+		return bundles, nil
+	case "APIService.apiregistration.k8s.io":
+		data, err := decodedField(u, "spec", "caBundle")
+		if err != nil {
+			return nil, fmt.Errorf("caBundle: %w", err)
+		}
+		if len(data) == 0 {
+			return nil, nil
+		}
+		return []Bundle{{Field: "caBundle", Data: data}}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// Check is the result of checking the certificates of an object.
+type Check struct {
+	Status   Status
+	Messages []string
+}
+
+// CheckBundles checks the certificates in bundles at a point in time. The
+// status is the worst status of any certificate, and there is a message for
+// each certificate which is not valid, or each bundle which can't be parsed.
+func CheckBundles(bundles []Bundle, now time.Time, window time.Duration) Check {
+	check := Check{Status: StatusValid}
+
+	for _, bundle := range bundles {
+		list, err := ParsePEM(bundle.Data)
+		if err != nil {
+			check.Messages = append(check.Messages, fmt.Sprintf("Unable to parse %s: %s", bundle.Field, err))
+			check.Status = worseStatus(check.Status, StatusExpired)
+			continue
+		}
+
+		for _, cert := range list {
+			status := cert.Status(now, window)
+			if status == StatusValid {
+				continue
+			}
+			check.Messages = append(check.Messages,
+				fmt.Sprintf("Certificate %s in %s %s", cert.Subject, bundle.Field, cert.Message(now)))
+			check.Status = worseStatus(check.Status, status)
+		}
+	}
+
+	return check
+}
+
+var statusSeverity = map[Status]int{
+	StatusValid:       0,
+	StatusExpiring:    1,
+	StatusNotYetValid: 2,
+	StatusExpired:     3,
+}
+
+func worseStatus(a, b Status) Status {
+	if statusSeverity[b] > statusSeverity[a] {
+		return b
+	}
+	return a
+}
+
+// decodedField returns the base64 decoded value of a field.
+func decodedField(u *unstructured.Unstructured, fields ...string) ([]byte, error) {
+	s, _, err := unstructured.NestedString(u.Object, fields...)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(s)
+}
+
+func toUnstructured(object runtime.Object) (*unstructured.Unstructured, error) {
+	if u, ok := object.(*unstructured.Unstructured); ok {
+		return u, nil
+	}
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("convert object to unstructured: %w", err)
+	}
+	u := &unstructured.Unstructured{Object: m}
+	u.GetObjectKind().SetGroupVersionKind(object.GetObjectKind().GroupVersionKind())
+	return u, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certificates
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+)
+
+func TestBundles(t *testing.T) {
+	leaf, ca := testutil.CreateCertificateChain(t, "example.com", time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	encodedLeaf := base64.StdEncoding.EncodeToString(leaf)
+	encodedCA := base64.StdEncoding.EncodeToString(ca)
+
+	tests := []struct {
+		name     string
+		object   *unstructured.Unstructured
+		expected []Bundle
+		isErr    bool
+	}{
+		{
+			name: "tls secret",
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"type":       "kubernetes.io/tls",
+				"data": map[string]interface{}{
+					"tls.crt": encodedLeaf,
+					"tls.key": "a2V5",
+					"ca.crt":  encodedCA,
+				},
+			}},
+			expected: []Bundle{
+				{Field: "tls.crt", Data: leaf},
+				{Field: "ca.crt", Data: ca},
+			},
+		},
+		{
+			name: "opaque secret",
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"type":       "Opaque",
+				"data": map[string]interface{}{
+					"tls.crt": encodedLeaf,
+				},
+			}},
+		},
+		{
+			name: "webhook configuration",
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "admissionregistration.k8s.io/v1",
+				"kind":       "ValidatingWebhookConfiguration",
+				"webhooks": []interface{}{
+					map[string]interface{}{
+						"name":         "a.example.com",
+						"clientConfig": map[string]interface{}{"caBundle": encodedCA},
+					},
+					map[string]interface{}{
+						"name":         "b.example.com",
+						"clientConfig": map[string]interface{}{},
+					},
+				},
+			}},
+			expected: []Bundle{
+				{Field: "webhook a.example.com caBundle", Webhook: "a.example.com", Data: ca},
+			},
+		},
+		{
+			name: "api service",
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apiregistration.k8s.io/v1",
+				"kind":       "APIService",
+				"spec":       map[string]interface{}{"caBundle": encodedCA},
+			}},
+			expected: []Bundle{
+				{Field: "caBundle", Data: ca},
+			},
+		},
+		{
+			name: "invalid base64",
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apiregistration.k8s.io/v1",
+				"kind":       "APIService",
+				"spec":       map[string]interface{}{"caBundle": "not base64!"},
+			}},
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := Bundles(test.object)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestCheckBundles(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	valid, _ := testutil.CreateCertificateChain(t, "valid.example.com", now.AddDate(1, 0, 0))
+	expiring, _ := testutil.CreateCertificateChain(t, "expiring.example.com", now.AddDate(0, 0, 10))
+	expired, _ := testutil.CreateCertificateChain(t, "expired.example.com", now.AddDate(0, 0, -2))
+
+	check := CheckBundles([]Bundle{{Field: "tls.crt", Data: valid}}, now, DefaultExpiryWindow)
+	assert.Equal(t, Check{Status: StatusValid}, check)
+
+	check = CheckBundles([]Bundle{
+		{Field: "tls.crt", Data: expiring},
+		{Field: "ca.crt", Data: valid},
+	}, now, DefaultExpiryWindow)
+	assert.Equal(t, Check{
+		Status:   StatusExpiring,
+		Messages: []string{"Certificate CN=expiring.example.com in tls.crt expires in 10 days"},
+	}, check)
+
+	check = CheckBundles([]Bundle{
+		{Field: "tls.crt", Data: expired},
+		{Field: "ca.crt", Data: expiring},
+		{Field: "caBundle", Data: []byte("invalid")},
+	}, now, DefaultExpiryWindow)
+	assert.Equal(t, Check{
+		Status: StatusExpired,
+		Messages: []string{
+			"Certificate CN=expired.example.com in tls.crt expired 2 days ago",
+			"Certificate CN=expiring.example.com in ca.crt expires in 10 days",
+			"Unable to parse caBundle: no PEM encoded certificates found",
+		},
+	}, check)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package certificates inspects the X.509 certificates stored in cluster
+// resources, e.g. the certificate chain of a TLS secret.
+package certificates
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+)
+
+// DefaultExpiryWindow is how long before a certificate expires it is reported
+// as expiring, unless another window is configured.
+const DefaultExpiryWindow = 30 * 24 * time.Hour
+
+// Status is the validity of a certificate at a point in time.
+type Status string
+
+const (
+	StatusValid       Status = "Valid"
+	StatusExpiring    Status = "Expiring"
+	StatusExpired     Status = "Expired"
+	StatusNotYetValid Status = "NotYetValid"
+)
+
+// Certificate is a parsed certificate.
+type Certificate struct {
+	Subject  string
+	Issuer   string
+	DNSNames []string
+	// IPAddresses, EmailAddresses, and URIs are the certificate's other
+	// subject alternative names.
+	IPAddresses    []string
+	EmailAddresses []string
+	URIs           []string
+	NotBefore      time.Time
+	NotAfter       time.Time
+	IsCA           bool
+	SerialNumber   string
+}
+
+// ParsePEM parses the certificates in PEM encoded data, in the order they
+// appear. Blocks which are not certificates, e.g. private keys, are skipped.
+// It returns an error if the data contains no certificates.
+func ParsePEM(data []byte) ([]Certificate, error) {
+	var list []Certificate
+	rest := data
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate %d: %w", len(list)+1, err)
+		}
+		list = append(list, FromX509(cert))
+	}
+
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificates found")
+	}
+
+	return list, nil
+}
+
+// FromX509 converts an X.509 certificate.
+func FromX509(cert *x509.Certificate) Certificate {
+	c := Certificate{
+		Subject:        cert.Subject.String(),
+		Issuer:         cert.Issuer.String(),
+		DNSNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+		NotBefore:      cert.NotBefore,
+		NotAfter:       cert.NotAfter,
+		IsCA:           cert.IsCA,
+	}
+	if cert.SerialNumber != nil {
+		c.SerialNumber = cert.SerialNumber.String()
+	}
+	for _, ip := range cert.IPAddresses {
+		c.IPAddresses = append(c.IPAddresses, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		c.URIs = append(c.URIs, uri.String())
+	}
+	return c
+}
+
+// SubjectAlternativeNames returns all of the certificate's subject
+// alternative names.
+func (c Certificate) SubjectAlternativeNames() []string {
+	var names []string
+	names = append(names, c.DNSNames...)
+	names = append(names, c.IPAddresses...)
+	names = append(names, c.EmailAddresses...)
+	names = append(names, c.URIs...)
+	return names
+}
+
+// Status returns the validity of the certificate at a point in time.
+// Certificates which expire within window are expiring.
+func (c Certificate) Status(now time.Time, window time.Duration) Status {
+	switch {
+	case now.Before(c.NotBefore):
+		return StatusNotYetValid
+	case !now.Before(c.NotAfter):
+		return StatusExpired
+	case c.NotAfter.Sub(now) < window:
+		return StatusExpiring
+	default:
+		return StatusValid
+	}
+}
+
+// Message describes the certificate's status at a point in time, e.g.
+// "expires in 12 days".
+func (c Certificate) Message(now time.Time) string {
+	switch c.Status(now, 0) {
+	case StatusNotYetValid:
+		return fmt.Sprintf("not valid until %s", c.NotBefore.UTC().Format(time.RFC3339))
+	case StatusExpired:
+		return fmt.Sprintf("expired %s ago", humanDuration(now.Sub(c.NotAfter)))
+	default:
+		return fmt.Sprintf("expires in %s", humanDuration(c.NotAfter.Sub(now)))
+	}
+}
+
+// humanDuration formats a duration in days, or hours if it is less than a day.
+func humanDuration(d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	switch {
+	case days == 1:
+		return "1 day"
+	case days > 1:
+		return fmt.Sprintf("%d days", days)
+	}
+
+	hours := int(d / time.Hour)
+	if hours == 1 {
+		return "1 hour"
+	}
+	return fmt.Sprintf("%d hours", hours)
+}
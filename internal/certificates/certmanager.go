@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certificates
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// CertManagerGroup is the API group of cert-manager's resources.
+const CertManagerGroup = "cert-manager.io"
+
+// CertManagerVersions are the versions of cert-manager's Certificate resource.
+var CertManagerVersions = []string{"v1", "v1beta1", "v1alpha3", "v1alpha2"}
+
+// FromCertManager converts a cert-manager Certificate using the expiry
+// cert-manager reports in its status. It returns false if the certificate has
+// not been issued.
+func FromCertManager(object *unstructured.Unstructured) (Certificate, bool) {
+	notAfter, ok := nestedTime(object, "status", "notAfter")
+	if !ok {
+		return Certificate{}, false
+	}
+	notBefore, _ := nestedTime(object, "status", "notBefore")
+
+	dnsNames, _, _ := unstructured.NestedStringSlice(object.Object, "spec", "dnsNames")
+	ipAddresses, _, _ := unstructured.NestedStringSlice(object.Object, "spec", "ipAddresses")
+	emailAddresses, _, _ := unstructured.NestedStringSlice(object.Object, "spec", "emailAddresses")
+	uris, _, _ := unstructured.NestedStringSlice(object.Object, "spec", "uris")
+
+	subject, _, _ := unstructured.NestedString(object.Object, "spec", "commonName")
+	if subject != "" {
+		subject = "CN=" + subject
+	}
+
+	issuerKind, _, _ := unstructured.NestedString(object.Object, "spec", "issuerRef", "kind")
+	issuerName, _, _ := unstructured.NestedString(object.Object, "spec", "issuerRef", "name")
+	if issuerKind == "" {
+		issuerKind = "Issuer"
+	}
+
+	return Certificate{
+		Subject:        subject,
+		Issuer:         fmt.Sprintf("%s %s", issuerKind, issuerName),
+		DNSNames:       dnsNames,
+		IPAddresses:    ipAddresses,
+		EmailAddresses: emailAddresses,
+		URIs:           uris,
+		NotBefore:      notBefore,
+		NotAfter:       notAfter,
+	}, true
+}
+
+func nestedTime(object *unstructured.Unstructured, fields ...string) (time.Time, bool) {
+	s, ok, err := unstructured.NestedString(object.Object, fields...)
+	if err != nil || !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFromCertManager(t *testing.T) {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"spec": map[string]interface{}{
+			"commonName": "example.com",
+			"dnsNames":   []interface{}{"example.com", "www.example.com"},
+			"issuerRef": map[string]interface{}{
+				"kind": "ClusterIssuer",
+				"name": "letsencrypt",
+			},
+		},
+	}}
+
+	_, ok := FromCertManager(object)
+	require.False(t, ok, "certificate which has not been issued was converted")
+
+	require.NoError(t, unstructured.SetNestedField(object.Object, "2020-01-01T00:00:00Z", "status", "notBefore"))
+	require.NoError(t, unstructured.SetNestedField(object.Object, "2020-04-01T00:00:00Z", "status", "notAfter"))
+
+	cert, ok := FromCertManager(object)
+	require.True(t, ok)
+
+	assert.Equal(t, Certificate{
+		Subject:   "CN=example.com",
+		Issuer:    "ClusterIssuer letsencrypt",
+		DNSNames:  []string{"example.com", "www.example.com"},
+		NotBefore: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:  time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+	}, cert)
+}
@@ -25,12 +25,15 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/scale"
 	"k8s.io/client-go/tools/clientcmd"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/openapi"
 	"github.com/vmware-tanzu/octant/internal/util/strings"
 	"github.com/vmware-tanzu/octant/pkg/log"
 
@@ -56,9 +59,12 @@ type ClientInterface interface {
 	ResetMapper()
 	KubernetesClient() (kubernetes.Interface, error)
 	DynamicClient() (dynamic.Interface, error)
+	MetadataClient() (metadata.Interface, error)
 	DiscoveryClient() (discovery.DiscoveryInterface, error)
 	NamespaceClient() (NamespaceInterface, error)
 	InfoClient() (InfoInterface, error)
+	ScaleClient() (scale.ScalesGetter, error)
+	OpenAPIResources() (*openapi.Resources, error)
 	Close()
 	RESTInterface
 }
@@ -76,9 +82,11 @@ type Cluster struct {
 
 	kubernetesClient kubernetes.Interface
 	dynamicClient    dynamic.Interface
+	metadataClient   metadata.Interface
 	discoveryClient  discovery.DiscoveryInterface
 
 	restMapper *restmapper.DeferredDiscoveryRESTMapper
+	openAPI    *openapi.Cache
 
 	closeFn context.CancelFunc
 
@@ -105,6 +113,11 @@ func newCluster(ctx context.Context, clientConfig clientcmd.ClientConfig, restCl
 		return nil, errors.Wrap(err, "create dynamic client")
 	}
 
+	metadataClient, err := metadata.NewForConfig(restClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "create metadata client")
+	}
+
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restClient)
 	if err != nil {
 		return nil, errors.Wrap(err, "create discovery client")
@@ -134,8 +147,10 @@ func newCluster(ctx context.Context, clientConfig clientcmd.ClientConfig, restCl
 		restConfig:         restClient,
 		kubernetesClient:   kubernetesClient,
 		dynamicClient:      dynamicClient,
+		metadataClient:     metadataClient,
 		discoveryClient:    discoveryClient,
 		restMapper:         restMapper,
+		openAPI:            openapi.NewCache(discoveryClient, &crdGetter{dynamicClient: dynamicClient}),
 		logger:             internalLog.From(ctx),
 		defaultNamespace:   defaultNamespace,
 		providedNamespaces: providedNamespaces,
@@ -182,6 +197,7 @@ func (c *Cluster) Resource(gk schema.GroupKind) (schema.GroupVersionResource, bo
 
 func (c *Cluster) ResetMapper() {
 	c.restMapper.Reset()
+	c.openAPI.Reset()
 }
 
 // KubernetesClient returns a Kubernetes client.
@@ -213,6 +229,11 @@ func (c *Cluster) DynamicClient() (dynamic.Interface, error) {
 	return c.dynamicClient, nil
 }
 
+// MetadataClient returns a client for object metadata.
+func (c *Cluster) MetadataClient() (metadata.Interface, error) {
+	return c.metadataClient, nil
+}
+
 // DiscoveryClient returns a DiscoveryClient for the cluster.
 func (c *Cluster) DiscoveryClient() (discovery.DiscoveryInterface, error) {
 	return c.discoveryClient, nil
@@ -223,6 +244,19 @@ func (c *Cluster) InfoClient() (InfoInterface, error) {
 	return newClusterInfo(c.clientConfig), nil
 }
 
+// ScaleClient returns a client for the scale subresource of the cluster's resources.
+func (c *Cluster) ScaleClient() (scale.ScalesGetter, error) {
+	return scale.NewForConfig(c.restConfig, c.restMapper, dynamic.LegacyAPIPathResolverFunc,
+		scale.NewDiscoveryScaleKindResolver(c.discoveryClient))
+}
+
+// OpenAPIResources returns the schemas of the cluster's resources. They are
+// loaded from the cluster's OpenAPI document once, and again after the mapper
+// is reset.
+func (c *Cluster) OpenAPIResources() (*openapi.Resources, error) {
+	return c.openAPI.Resources()
+}
+
 // RESTClient returns a RESTClient for the cluster.
 func (c *Cluster) RESTClient() (rest.Interface, error) {
 	return rest.RESTClientFor(c.restConfig)
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/vmware-tanzu/octant/internal/openapi"
+)
+
+// crdGetter lists a cluster's custom resource definitions for their schemas.
+type crdGetter struct {
+	dynamicClient dynamic.Interface
+}
+
+var _ openapi.CRDGetter = (*crdGetter)(nil)
+
+// CustomResourceDefinitions lists the cluster's custom resource definitions.
+func (g *crdGetter) CustomResourceDefinitions() ([]apiextv1.CustomResourceDefinition, error) {
+	res := apiextv1.SchemeGroupVersion.WithResource("customresourcedefinitions")
+	list, err := g.dynamicClient.Resource(res).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list custom resource definitions")
+	}
+
+	crds := make([]apiextv1.CustomResourceDefinition, len(list.Items))
+	for i := range list.Items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &crds[i]); err != nil {
+			return nil, errors.Wrapf(err, "convert custom resource definition %s", list.Items[i].GetName())
+		}
+	}
+
+	return crds, nil
+}
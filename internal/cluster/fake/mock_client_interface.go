@@ -12,9 +12,12 @@ import (
 	discovery "k8s.io/client-go/discovery"
 	dynamic "k8s.io/client-go/dynamic"
 	kubernetes "k8s.io/client-go/kubernetes"
+	metadata "k8s.io/client-go/metadata"
 	rest "k8s.io/client-go/rest"
+	scale "k8s.io/client-go/scale"
 
 	cluster "github.com/vmware-tanzu/octant/internal/cluster"
+	openapi "github.com/vmware-tanzu/octant/internal/openapi"
 )
 
 // MockClientInterface is a mock of ClientInterface interface
@@ -126,6 +129,21 @@ func (mr *MockClientInterfaceMockRecorder) DynamicClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DynamicClient", reflect.TypeOf((*MockClientInterface)(nil).DynamicClient))
 }
 
+// MetadataClient mocks base method
+func (m *MockClientInterface) MetadataClient() (metadata.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetadataClient")
+	ret0, _ := ret[0].(metadata.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetadataClient indicates an expected call of MetadataClient
+func (mr *MockClientInterfaceMockRecorder) MetadataClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetadataClient", reflect.TypeOf((*MockClientInterface)(nil).MetadataClient))
+}
+
 // DiscoveryClient mocks base method
 func (m *MockClientInterface) DiscoveryClient() (discovery.DiscoveryInterface, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +189,36 @@ func (mr *MockClientInterfaceMockRecorder) InfoClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InfoClient", reflect.TypeOf((*MockClientInterface)(nil).InfoClient))
 }
 
+// ScaleClient mocks base method
+func (m *MockClientInterface) ScaleClient() (scale.ScalesGetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleClient")
+	ret0, _ := ret[0].(scale.ScalesGetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleClient indicates an expected call of ScaleClient
+func (mr *MockClientInterfaceMockRecorder) ScaleClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleClient", reflect.TypeOf((*MockClientInterface)(nil).ScaleClient))
+}
+
+// OpenAPIResources mocks base method
+func (m *MockClientInterface) OpenAPIResources() (*openapi.Resources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAPIResources")
+	ret0, _ := ret[0].(*openapi.Resources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenAPIResources indicates an expected call of OpenAPIResources
+func (mr *MockClientInterfaceMockRecorder) OpenAPIResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAPIResources", reflect.TypeOf((*MockClientInterface)(nil).OpenAPIResources))
+}
+
 // Close mocks base method
 func (m *MockClientInterface) Close() {
 	m.ctrl.T.Helper()
@@ -13,14 +13,17 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog"
 
+	"github.com/vmware-tanzu/octant/internal/certificates"
 	"github.com/vmware-tanzu/octant/internal/config"
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/log"
@@ -80,19 +83,30 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 					Time:    buildTime,
 				}
 
+				informerMaxBytes, err := parseMemory(viper.GetString("informer-max-memory"))
+				if err != nil {
+					golog.Printf("invalid informer-max-memory: %v", err)
+					os.Exit(1)
+				}
+
 				options := dash.Options{
-					DisableClusterOverview: viper.GetBool("disable-cluster-overview"),
-					EnableOpenCensus:       viper.GetBool("enable-opencensus"),
-					KubeConfig:             viper.GetString("kubeconfig"),
-					Namespace:              viper.GetString("namespace"),
-					Namespaces:             viper.GetStringSlice("namespace-list"),
-					FrontendURL:            viper.GetString("ui-url"),
-					BrowserPath:            viper.GetString("browser-path"),
-					Context:                viper.GetString("context"),
-					ClientQPS:              float32(viper.GetFloat64("client-qps")),
-					ClientBurst:            viper.GetInt("client-burst"),
-					UserAgent:              fmt.Sprintf("octant/%s", version),
-					BuildInfo:              buildInfo,
+					DisableClusterOverview:  viper.GetBool("disable-cluster-overview"),
+					EnableOpenCensus:        viper.GetBool("enable-opencensus"),
+					KubeConfig:              viper.GetString("kubeconfig"),
+					Namespace:               viper.GetString("namespace"),
+					Namespaces:              viper.GetStringSlice("namespace-list"),
+					FrontendURL:             viper.GetString("ui-url"),
+					BrowserPath:             viper.GetString("browser-path"),
+					Context:                 viper.GetString("context"),
+					ClientQPS:               float32(viper.GetFloat64("client-qps")),
+					ClientBurst:             viper.GetInt("client-burst"),
+					UserAgent:               fmt.Sprintf("octant/%s", version),
+					BuildInfo:               buildInfo,
+					InformerIdleTTL:         viper.GetDuration("informer-idle-ttl"),
+					InformerMaxObjects:      viper.GetInt("informer-max-objects"),
+					InformerMaxBytes:        informerMaxBytes,
+					Snapshot:                viper.GetString("snapshot"),
+					CertificateExpiryWindow: viper.GetDuration("certificate-expiry-window"),
 				}
 
 				klogVerbosity := viper.GetString("klog-verbosity")
@@ -146,6 +160,7 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	// and replacing - with _. Example: OCTANT_DISABLE_CLUSTER_OVERVIEW
 	octantCmd.Flags().SortFlags = false
 
+	octantCmd.Flags().StringP("config-file", "", "", "path to the octant configuration file. Plugin settings in it are keyed by plugin file name")
 	octantCmd.Flags().StringP("context", "", "", "initial context")
 	octantCmd.Flags().BoolP("disable-cluster-overview", "", false, "disable cluster overview")
 	octantCmd.Flags().BoolP("enable-feature-applications", "", false, "enable applications feature")
@@ -154,6 +169,11 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	octantCmd.Flags().StringSlice("namespace-list", []string{}, "a list of namespaces to use on start")
 	octantCmd.Flags().StringP("plugin-path", "", "", "plugin path")
 	octantCmd.Flags().BoolP("verbose", "v", false, "turn on debug logging")
+	octantCmd.Flags().Duration("informer-idle-ttl", 10*time.Minute, "stop informers which have not been used for this duration, 0 to disable")
+	octantCmd.Flags().Int("informer-max-objects", 0, "maximum number of objects cached by informers, 0 for no limit")
+	octantCmd.Flags().String("informer-max-memory", "", "maximum estimated memory used by informers, e.g. 512Mi")
+	octantCmd.Flags().Duration("certificate-expiry-window", certificates.DefaultExpiryWindow, "flag certificates which expire within this duration")
+	octantCmd.Flags().String("snapshot", "", "browse a cluster dump read-only instead of a cluster: a directory or tarball of YAML or JSON files, e.g. from kubectl cluster-info dump")
 
 	octantCmd.Flags().StringP("accepted-hosts", "", "", "accepted hosts list [DEV]")
 	octantCmd.Flags().Float32P("client-qps", "", 200, "maximum QPS for client [DEV]")
@@ -216,3 +236,17 @@ func newZapLogger(verboseLevel int) (*zap.Logger, error) {
 
 	return cfg.Build()
 }
+
+// parseMemory parses a memory quantity, e.g. 512Mi, into bytes. An empty quantity is 0.
+func parseMemory(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	quantity, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return quantity.Value(), nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+func newPluginCmd(version string) *cobra.Command {
+	pluginCmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage plugins",
+		Long:  "Install, list, and remove octant plugins",
+	}
+
+	pluginCmd.PersistentFlags().StringP("config-file", "", "", "path to the octant configuration file. Plugin settings in it are keyed by plugin file name")
+	pluginCmd.PersistentFlags().StringP("plugin-path", "", "", "plugin path")
+
+	pluginCmd.AddCommand(
+		newPluginInstallCmd(version),
+		newPluginListCmd(version),
+		newPluginRemoveCmd(version),
+		newPluginInfoCmd(version),
+	)
+
+	return pluginCmd
+}
+
+func newPluginInstallCmd(version string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "install <path-or-tarball>",
+		Short: "Install a plugin",
+		Long:  "Install a plugin from a directory, manifest file, or gzipped tarball containing a plugin manifest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, err := newPluginInstaller(cmd, version)
+			if err != nil {
+				return err
+			}
+
+			installedPlugin, err := installer.Install(args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Installed plugin %s %s to %s\n",
+				installedPlugin.Manifest.Name, installedPlugin.Manifest.Version, installedPlugin.Path)
+			return nil
+		},
+	}
+}
+
+func newPluginListCmd(version string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List plugins",
+		Long:  "List the plugins in the plugin directories",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, err := newPluginInstaller(cmd, version)
+			if err != nil {
+				return err
+			}
+
+			list, err := installer.List()
+			if err != nil {
+				return err
+			}
+
+			return printPluginList(cmd.OutOrStdout(), list)
+		},
+	}
+}
+
+func newPluginRemoveCmd(version string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a plugin",
+		Long:  "Remove a plugin and its manifest from the plugin directory",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, err := newPluginInstaller(cmd, version)
+			if err != nil {
+				return err
+			}
+
+			installedPlugin, err := installer.Remove(args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Removed plugin %s from %s\n", installedPlugin.Name(), installedPlugin.Path)
+			return nil
+		},
+	}
+}
+
+func newPluginInfoCmd(version string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "info <name>",
+		Short: "Show plugin information",
+		Long:  "Show a plugin's manifest and the metadata it registers with octant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, err := newPluginInstaller(cmd, version)
+			if err != nil {
+				return err
+			}
+
+			installedPlugin, err := installer.Get(args[0])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := installer.Metadata(context.Background(), *installedPlugin)
+			if err != nil {
+				return err
+			}
+
+			printPluginInfo(cmd.OutOrStdout(), *installedPlugin, metadata)
+			return nil
+		},
+	}
+}
+
+func newPluginInstaller(cmd *cobra.Command, version string) (*plugin.Installer, error) {
+	if err := bindViper(cmd); err != nil {
+		return nil, fmt.Errorf("unable to bind flags: %w", err)
+	}
+
+	return plugin.NewInstaller(plugin.DefaultConfig, version), nil
+}
+
+func printPluginList(out io.Writer, list []plugin.InstalledPlugin) error {
+	if len(list) == 0 {
+		fmt.Fprintln(out, "No plugins found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tVERSION\tOCTANT VERSION\tPATH")
+	for _, installedPlugin := range list {
+		pluginVersion, octantVersion := "-", "-"
+		if manifest := installedPlugin.Manifest; manifest != nil {
+			pluginVersion = manifest.Version
+			octantVersion = manifest.OctantVersion
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", installedPlugin.Name(), pluginVersion, octantVersion, installedPlugin.Path)
+	}
+
+	return w.Flush()
+}
+
+func printPluginInfo(out io.Writer, installedPlugin plugin.InstalledPlugin, metadata *plugin.Metadata) {
+	fmt.Fprintln(out, "Name:", metadata.Name)
+	fmt.Fprintln(out, "Description:", metadata.Description)
+	fmt.Fprintln(out, "Path:", installedPlugin.Path)
+
+	if manifest := installedPlugin.Manifest; manifest != nil {
+		fmt.Fprintln(out, "Version:", manifest.Version)
+		fmt.Fprintln(out, "Octant version:", manifest.OctantVersion)
+		fmt.Fprintln(out, "Checksum:", manifest.Checksum)
+	}
+
+	fmt.Fprintln(out, "Capabilities:")
+	for _, item := range metadata.Capabilities.Summary() {
+		fmt.Fprintln(out, "  -", item)
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+func Test_printPluginList(t *testing.T) {
+	list := []plugin.InstalledPlugin{
+		{
+			Path: "/plugins/octant-sample",
+			Manifest: &plugin.Manifest{
+				Name:          "sample",
+				Version:       "0.1.0",
+				OctantVersion: "0.12.0",
+			},
+		},
+		{
+			Path: "/plugins/unmanaged",
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printPluginList(&buf, list))
+
+	expected := "NAME       VERSION  OCTANT VERSION  PATH\n" +
+		"sample     0.1.0    0.12.0          /plugins/octant-sample\n" +
+		"unmanaged  -        -               /plugins/unmanaged\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func Test_printPluginList_empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, printPluginList(&buf, nil))
+
+	assert.Equal(t, "No plugins found\n", buf.String())
+}
@@ -28,6 +28,8 @@ func Execute(version string, gitCommit string, buildTime string) {
 func newRoot(version string, gitCommit string, buildTime string) *cobra.Command {
 	rootCmd := newOctantCmd(version, gitCommit, buildTime)
 	rootCmd.AddCommand(newVersionCmd(version, gitCommit, buildTime))
+	rootCmd.AddCommand(newPluginCmd(version))
+	rootCmd.AddCommand(newSnapshotCmd(version))
 
 	return rootCmd
 }
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/internal/snapshot"
+)
+
+func newSnapshotCmd(version string) *cobra.Command {
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Capture a snapshot of a cluster",
+		Long: "Write the objects in a cluster to a gzipped tarball which can be browsed with octant --snapshot. " +
+			"Secret data is redacted unless --reveal-secrets is set.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := bindViper(cmd); err != nil {
+				return fmt.Errorf("unable to bind flags: %w", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt)
+			defer signal.Stop(sigCh)
+			go func() {
+				select {
+				case <-sigCh:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+
+			return captureSnapshot(ctx, cmd.OutOrStdout(), cmd.ErrOrStderr(), version)
+		},
+	}
+
+	snapshotCmd.Flags().SortFlags = false
+
+	snapshotCmd.Flags().String("kubeconfig", "", "absolute path to kubeConfig file")
+	snapshotCmd.Flags().String("context", "", "context to capture")
+	snapshotCmd.Flags().StringSliceP("namespace", "n", []string{}, "namespaces to capture; all namespaces and cluster scoped objects are captured if not set")
+	snapshotCmd.Flags().StringP("output", "o", "", "path of the snapshot tarball (default octant-snapshot-<context>-<time>.tar.gz)")
+	snapshotCmd.Flags().Bool("include-logs", false, "capture recent logs for each container")
+	snapshotCmd.Flags().Int64("log-lines", snapshot.DefaultLogTailLines, "number of log lines to capture for each container")
+	snapshotCmd.Flags().Bool("reveal-secrets", false, "capture secret data instead of redacting it")
+
+	snapshotCmd.Flags().Float32P("client-qps", "", 200, "maximum QPS for client [DEV]")
+	snapshotCmd.Flags().IntP("client-burst", "", 400, "maximum burst for client throttle [DEV]")
+
+	return snapshotCmd
+}
+
+func captureSnapshot(ctx context.Context, out, errOut io.Writer, version string) error {
+	kubeConfig := viper.GetString("kubeconfig")
+	if kubeConfig == "" {
+		kubeConfig = clientcmd.NewDefaultClientConfigLoadingRules().GetDefaultFilename()
+	}
+
+	restConfigOptions := cluster.RESTConfigOptions{
+		QPS:       float32(viper.GetFloat64("client-qps")),
+		Burst:     viper.GetInt("client-burst"),
+		UserAgent: fmt.Sprintf("octant/%s", version),
+	}
+
+	client, err := cluster.FromKubeConfig(ctx, kubeConfig, viper.GetString("context"), "", nil, restConfigOptions)
+	if err != nil {
+		return fmt.Errorf("create cluster client: %w", err)
+	}
+	defer client.Close()
+
+	objectStore, err := objectstore.NewDynamicCache(ctx, client, objectstore.MetadataOnly(gvk.Secret))
+	if err != nil {
+		return fmt.Errorf("create object store: %w", err)
+	}
+
+	infoClient, err := client.InfoClient()
+	if err != nil {
+		return fmt.Errorf("create info client: %w", err)
+	}
+	contextName := infoClient.Context()
+
+	now := time.Now()
+	path := viper.GetString("output")
+	if path == "" {
+		path = snapshot.FileName(contextName, now)
+	}
+
+	options := snapshot.CaptureOptions{
+		Namespaces:    viper.GetStringSlice("namespace"),
+		IncludeLogs:   viper.GetBool("include-logs"),
+		LogTailLines:  viper.GetInt64("log-lines"),
+		RevealSecrets: viper.GetBool("reveal-secrets"),
+		OctantVersion: version,
+		Context:       contextName,
+		Now:           func() time.Time { return now },
+	}
+
+	manifest, err := snapshot.CaptureFile(ctx, client, objectStore, path, options)
+	if err != nil {
+		return fmt.Errorf("capture snapshot: %w", err)
+	}
+
+	for _, captureErr := range manifest.Errors {
+		fmt.Fprintf(errOut, "warning: %s\n", captureErr)
+	}
+
+	count := 0
+	for _, resource := range manifest.Resources {
+		count += resource.Count
+	}
+
+	fmt.Fprintf(out, "Captured %d objects from context %s to %s\n", count, contextName, path)
+	if !manifest.SecretsRedacted {
+		fmt.Fprintln(errOut, "warning: the snapshot contains secret data")
+	}
+
+	return nil
+}
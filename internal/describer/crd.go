@@ -21,6 +21,7 @@ func defaultCustomResourceTabs(crdName string) []Tab {
 		{Name: "Metadata", Factory: MetadataTab},
 		{Name: "Resource Viewer", Factory: ResourceViewerTab},
 		{Name: "YAML", Factory: YAMLViewerTab},
+		{Name: "Explain", Factory: ExplainTab},
 	}
 }
 
@@ -97,6 +98,16 @@ func (c *crd) Describe(ctx context.Context, namespace string, options Options) (
 
 	cr := component.NewContentResponse(title)
 
+	if object.GetDeletionTimestamp() == nil {
+		editButton, err := schemaEditButton(ctx, object, options)
+		if err != nil {
+			return component.EmptyContentResponse, fmt.Errorf("create edit button: %w", err)
+		}
+		if editButton != nil {
+			cr.ButtonGroup.AddButton(*editButton)
+		}
+	}
+
 	generatorConfig := TabsGeneratorConfig{
 		Object:      object,
 		TabsFactory: objectTabsFactory(ctx, object, c.tabFuncDescriptors, options),
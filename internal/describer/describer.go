@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"sort"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kLabels "k8s.io/apimachinery/pkg/labels"
@@ -42,6 +43,10 @@ func (f *ObjectLoaderFactory) LoadObjects(ctx context.Context, namespace string,
 	return LoadObjects(ctx, f.dashConfig.ObjectStore(), f.dashConfig.ErrorStore(), namespace, fields, objectStoreKeys)
 }
 
+func (f *ObjectLoaderFactory) LoadObjectsMetadata(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+	return LoadObjectsMetadata(ctx, f.dashConfig.ObjectStore(), f.dashConfig.ErrorStore(), namespace, fields, objectStoreKeys)
+}
+
 // loadObject loads a single object from the object store.
 func LoadObject(ctx context.Context, objectStore store.Store, errorStore oerrors.ErrorStore, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error) {
 	objectStoreKey.Namespace = namespace
@@ -74,6 +79,43 @@ func LoadObject(ctx context.Context, objectStore store.Store, errorStore oerrors
 
 // loadObjects loads objects from the object store sorted by their name.
 func LoadObjects(ctx context.Context, objectStore store.Store, errorStore oerrors.ErrorStore, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+	return loadObjects(ctx, errorStore, namespace, fields, objectStoreKeys, func(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error) {
+		list, _, err := objectStore.List(ctx, key)
+		return list, err
+	})
+}
+
+// LoadObjectsMetadata loads the metadata of objects from the object store sorted by
+// their name. The objects only contain their apiVersion, kind, and metadata.
+func LoadObjectsMetadata(ctx context.Context, objectStore store.Store, errorStore oerrors.ErrorStore, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+	return loadObjects(ctx, errorStore, namespace, fields, objectStoreKeys, func(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error) {
+		metadataList, _, err := store.ListMetadata(ctx, objectStore, key)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := store.FromPartialObjectMetadataList(metadataList, key.APIVersion, key.Kind)
+		if err != nil {
+			return nil, err
+		}
+
+		if key.Name == "" {
+			return list, nil
+		}
+
+		named := &unstructured.UnstructuredList{}
+		for i := range list.Items {
+			if list.Items[i].GetName() == key.Name {
+				named.Items = append(named.Items, list.Items[i])
+			}
+		}
+
+		return named, nil
+	})
+}
+
+func loadObjects(ctx context.Context, errorStore oerrors.ErrorStore, namespace string, fields map[string]string, objectStoreKeys []store.Key,
+	listFunc func(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error)) (*unstructured.UnstructuredList, error) {
 	list := &unstructured.UnstructuredList{}
 
 	for _, objectStoreKey := range objectStoreKeys {
@@ -83,7 +125,7 @@ func LoadObjects(ctx context.Context, objectStore store.Store, errorStore oerror
 			objectStoreKey.Name = name
 		}
 
-		storedObjects, _, err := objectStore.List(ctx, objectStoreKey)
+		storedObjects, err := listFunc(ctx, objectStoreKey)
 		if err != nil {
 			var ae *oerrors.AccessError
 			if errors.As(err, &ae) {
@@ -122,9 +164,14 @@ type Options struct {
 	Printer  printer.Printer
 	LabelSet *kLabels.Set
 	Link     link.Interface
+	// CertificateExpiryWindow is how long before a certificate expires it is
+	// shown as expiring.
+	CertificateExpiryWindow time.Duration
 
 	LoadObjects func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error)
-	LoadObject  func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error)
+	// LoadObjectsMetadata loads objects which only contain their apiVersion, kind, and metadata.
+	LoadObjectsMetadata func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error)
+	LoadObject          func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error)
 }
 
 // Describer creates content.
@@ -25,6 +25,8 @@ type ListConfig struct {
 	ObjectType    func() interface{}
 	IsClusterWide bool
 	RootPath      ResourceLink
+	// MetadataOnly lists only the metadata of objects.
+	MetadataOnly bool
 }
 
 // List describes a list of objects.
@@ -38,6 +40,7 @@ type List struct {
 	objectStoreKey store.Key
 	isClusterWide  bool
 	rootPath       ResourceLink
+	metadataOnly   bool
 }
 
 // NewList creates an instance of List.
@@ -51,6 +54,7 @@ func NewList(c ListConfig) *List {
 		objectType:     c.ObjectType,
 		isClusterWide:  c.IsClusterWide,
 		rootPath:       c.RootPath,
+		metadataOnly:   c.MetadataOnly,
 	}
 }
 
@@ -68,7 +72,12 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 		namespace = ""
 	}
 
-	objectList, err := options.LoadObjects(ctx, namespace, options.Fields, []store.Key{key})
+	loadObjects := options.LoadObjects
+	if d.metadataOnly {
+		loadObjects = options.LoadObjectsMetadata
+	}
+
+	objectList, err := loadObjects(ctx, namespace, options.Fields, []store.Key{key})
 	if err != nil {
 		return component.EmptyContentResponse, err
 	}
@@ -7,12 +7,14 @@ package describer
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
@@ -73,3 +75,52 @@ func TestListDescriber(t *testing.T) {
 
 	assert.Equal(t, expected.Title, cResponse.Title)
 }
+
+func TestListDescriber_metadata_only(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	secret := testutil.CreateSecret("secret")
+
+	key, err := store.KeyFromObject(secret)
+	require.NoError(t, err)
+
+	metadataList, err := store.FromPartialObjectMetadataList(&metav1.PartialObjectMetadataList{
+		Items: []metav1.PartialObjectMetadata{{ObjectMeta: secret.ObjectMeta}},
+	}, "v1", "Secret")
+	require.NoError(t, err)
+
+	objectPrinter := printerFake.NewMockPrinter(controller)
+	secretList := &corev1.SecretList{Items: []corev1.Secret{{
+		TypeMeta:   secret.TypeMeta,
+		ObjectMeta: secret.ObjectMeta,
+	}}}
+	objectPrinter.EXPECT().Print(gomock.Any(), secretList).Return(component.NewText("secrets"), nil)
+
+	options := Options{
+		Dash:    configFake.NewMockDash(controller),
+		Printer: objectPrinter,
+		LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+			return nil, fmt.Errorf("full objects should not be loaded")
+		},
+		LoadObjectsMetadata: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+			return metadataList, nil
+		},
+	}
+
+	d := NewList(ListConfig{
+		Path:     "/",
+		Title:    "list",
+		StoreKey: key,
+		ListType: func() interface{} {
+			return &corev1.SecretList{}
+		},
+		ObjectType: func() interface{} {
+			return &corev1.Secret{}
+		},
+		MetadataOnly: true,
+	})
+
+	_, err = d.Describe(context.Background(), "default", options)
+	require.NoError(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -28,6 +29,8 @@ func defaultObjectTabs() []Tab {
 		{Name: "Metadata", Factory: MetadataTab},
 		{Name: "Resource Viewer", Factory: ResourceViewerTab},
 		{Name: "YAML", Factory: YAMLViewerTab},
+		{Name: "Explain", Factory: ExplainTab},
+		{Name: "Revisions", Factory: RevisionsTab},
 		{Name: "Logs", Factory: LogsTab},
 		{Name: "Terminal", Factory: TerminalTab},
 	}
@@ -154,6 +157,40 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 
 		cr.AddButton("Delete", action.CreatePayload(octant.ActionDeleteObject,
 			key.ToActionPayload()), confirmation)
+
+		rolloutButtons, err := octant.RolloutButtons(currentObject)
+		if err != nil {
+			return component.EmptyContentResponse, fmt.Errorf("create rollout buttons: %w", err)
+		}
+		for _, button := range rolloutButtons {
+			cr.ButtonGroup.AddButton(button)
+		}
+
+		editButton, err := schemaEditButton(ctx, currentObject, options)
+		if err != nil {
+			return component.EmptyContentResponse, fmt.Errorf("create edit button: %w", err)
+		}
+		if editButton != nil {
+			cr.ButtonGroup.AddButton(*editButton)
+		}
+
+		objectActions, err := options.PluginManager().ObjectActions(currentObject)
+		if err != nil {
+			return component.EmptyContentResponse, fmt.Errorf("get plugin object actions: %w", err)
+		}
+
+		for _, objectAction := range objectActions {
+			var buttonOptions []component.ButtonOption
+			if objectAction.Confirmation != "" {
+				buttonOptions = append(buttonOptions, component.WithButtonConfirmation(objectAction.Label, objectAction.Confirmation))
+			}
+			if objectAction.Form != nil {
+				buttonOptions = append(buttonOptions, component.WithButtonForm(*objectAction.Form))
+			}
+
+			cr.AddButton(objectAction.Label, action.CreatePayload(objectAction.ActionName,
+				key.ToActionPayload()), buttonOptions...)
+		}
 	}
 
 	config := TabsGeneratorConfig{
@@ -171,6 +208,18 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 	return *cr, nil
 }
 
+// schemaEditButton creates a button which edits an object with a form generated
+// from its schema. It returns nil if the cluster's schemas can't be loaded.
+func schemaEditButton(ctx context.Context, object runtime.Object, options Options) (*component.Button, error) {
+	resources, err := options.ClusterClient().OpenAPIResources()
+	if err != nil {
+		log.From(ctx).WithErr(err).Debugf("unable to load openapi schemas")
+		return nil, nil
+	}
+
+	return octant.SchemaEditButton(resources, object)
+}
+
 // PathFilters returns the path filters for this object.
 func (d *Object) PathFilters() []PathFilter {
 	return []PathFilter{
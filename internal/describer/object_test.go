@@ -7,6 +7,7 @@ package describer_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -19,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
@@ -49,6 +51,10 @@ func TestObjectDescriber(t *testing.T) {
 	pluginManager := plugin.NewManager(nil, moduleRegistrar, actionRegistrar)
 	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
 
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().OpenAPIResources().Return(nil, fmt.Errorf("not available"))
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+
 	podSummary := component.NewText("summary")
 
 	tg := describerFake.NewMockTabsGenerator(controller)
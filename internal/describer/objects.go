@@ -208,6 +208,7 @@ func initNamespacedOverview() *Section {
 		ObjectType:     &corev1.Secret{},
 		Titles:         ResourceTitle{List: "Secrets", Object: "Secrets"},
 		RootPath:       ResourceLink{Title: "Config and Storage", Url: "/overview/namespace/($NAMESPACE)/config-and-storage"},
+		MetadataOnly:   true,
 	})
 
 	csServiceAccounts := NewResource(ResourceOptions{
@@ -41,6 +41,8 @@ type ResourceOptions struct {
 	ClusterWide           bool
 	IconName              string
 	RootPath              ResourceLink
+	// MetadataOnly lists only the metadata of objects. Object pages load full objects.
+	MetadataOnly bool
 }
 
 type Resource struct {
@@ -74,6 +76,7 @@ func (r *Resource) List() *List {
 			},
 			IsClusterWide: r.ClusterWide,
 			RootPath:      r.RootPath,
+			MetadataOnly:  r.MetadataOnly,
 		},
 	)
 }
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/modules/overview/logviewer"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/terminalviewer"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/yamlviewer"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/printer"
 	"github.com/vmware-tanzu/octant/internal/resourceviewer"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -88,7 +90,8 @@ func ResourceViewerTab(ctx context.Context, object runtime.Object, options Optio
 
 	u := &unstructured.Unstructured{Object: m}
 
-	resourceViewerComponent, err := resourceviewer.Create(ctx, options.Dash, options.Queryer, u)
+	resourceViewerComponent, err := resourceviewer.Create(ctx, options.Dash, options.Queryer, []*unstructured.Unstructured{u},
+		resourceviewer.WithCertificateExpiryWindow(options.CertificateExpiryWindow))
 	if err != nil {
 		return nil, fmt.Errorf("create resource viewer: %w", err)
 	}
@@ -108,6 +111,34 @@ func YAMLViewerTab(_ context.Context, object runtime.Object, _ Options) (compone
 	return yvComponent, nil
 }
 
+// ExplainTab generates a tab which describes the fields of an object's kind
+// from the cluster's OpenAPI schemas. If the schemas can't be loaded, or the
+// cluster does not publish a schema for the kind, the tab explains why.
+func ExplainTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("convert object to unstructured: %w", err)
+	}
+	u := &unstructured.Unstructured{Object: m}
+
+	var explainComponent component.Component
+	resources, err := options.ClusterClient().OpenAPIResources()
+	if err != nil {
+		log.From(ctx).WithErr(err).Debugf("unable to load openapi schemas")
+		explainComponent = component.NewText("Field documentation is not available, because the cluster's OpenAPI schemas could not be loaded.")
+	} else if explanation, ok := resources.Explain(u.GroupVersionKind(), u.Object); !ok {
+		explainComponent = component.NewText(fmt.Sprintf("The cluster does not publish a schema for %s (%s).", u.GetKind(), u.GetAPIVersion()))
+	} else {
+		explainComponent, err = printer.Explain(explanation)
+		if err != nil {
+			return nil, fmt.Errorf("print explanation: %w", err)
+		}
+	}
+
+	explainComponent.SetAccessor("explain")
+	return explainComponent, nil
+}
+
 // LogsTab generates a logs tab for a pod. If the object is not a pod, the
 // returned component will be nil with a nil error.
 func LogsTab(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
@@ -141,3 +172,46 @@ func TerminalTab(ctx context.Context, object runtime.Object, options Options) (c
 
 	return nil, nil
 }
+
+// RevisionsTab generates a revisions tab for a deployment, StatefulSet or
+// DaemonSet. If the object is none of these, the returned component will be nil
+// with a nil error.
+func RevisionsTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+	printOptions := printer.Options{
+		DashConfig: options,
+		Link:       options.Link,
+	}
+
+	if deployment, ok := object.(*appsv1.Deployment); ok {
+		revisionsComponent, err := printer.DeploymentRevisions(ctx, deployment, printOptions)
+		if err != nil {
+			return nil, fmt.Errorf("print revisions: %w", err)
+		}
+
+		revisionsComponent.SetAccessor("revisions")
+		return revisionsComponent, nil
+	}
+
+	if _, _, ok := octant.ControllerRevisionOwner(object); !ok {
+		return nil, nil
+	}
+
+	progress, err := printer.RolloutProgress(ctx, object, printOptions)
+	if err != nil {
+		return nil, fmt.Errorf("print rollout progress: %w", err)
+	}
+
+	history, err := printer.ControllerRevisionHistory(ctx, object, printOptions)
+	if err != nil {
+		return nil, fmt.Errorf("print revisions: %w", err)
+	}
+
+	layout := component.NewFlexLayout("Revisions")
+	layout.AddSections(
+		component.FlexLayoutSection{{Width: component.WidthFull, View: progress}},
+		component.FlexLayoutSection{{Width: component.WidthFull, View: history}},
+	)
+
+	layout.SetAccessor("revisions")
+	return layout, nil
+}
@@ -49,6 +49,17 @@ func NewContextsGenerator(dashConfig config.Dash, options ...ContextGeneratorOpt
 func (g *ContextsGenerator) Event(ctx context.Context) (octant.Event, error) {
 	configPath := g.DashConfig.KubeConfigPath()
 
+	// without a kube config, e.g. when browsing a snapshot, the current context is the only context
+	if configPath == "" {
+		return octant.Event{
+			Type: octant.EventTypeKubeConfig,
+			Data: kubeContextsResponse{
+				CurrentContext: g.DashConfig.ContextName(),
+				Contexts:       []kubeconfig.Context{{Name: g.DashConfig.ContextName()}},
+			},
+		}, nil
+	}
+
 	kubeConfig, err := g.ConfigLoader.Load(configPath)
 	if err != nil {
 		return octant.Event{}, errors.Wrap(err, "unable to load kube config")
@@ -56,3 +56,26 @@ func Test_kubeContextGenerator(t *testing.T) {
 
 	assert.Equal(t, resp, e.Data)
 }
+
+func Test_kubeContextGenerator_withoutKubeConfig(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := dashConfigFake.NewMockDash(controller)
+	dashConfig.EXPECT().KubeConfigPath().Return("")
+	dashConfig.EXPECT().ContextName().Return("snapshot:dump").AnyTimes()
+
+	kgc := NewContextsGenerator(dashConfig, func(x *ContextsGenerator) {
+		x.ConfigLoader = fake.NewMockLoader(controller)
+	})
+
+	e, err := kgc.Event(context.Background())
+	require.NoError(t, err)
+
+	resp := kubeContextsResponse{
+		CurrentContext: "snapshot:dump",
+		Contexts:       []kubeconfig.Context{{Name: "snapshot:dump"}},
+	}
+
+	assert.Equal(t, resp, e.Data)
+}
@@ -7,6 +7,7 @@ package generator
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
@@ -38,11 +39,14 @@ var _ Interface = (*Generator)(nil)
 // Options are additional options to pass a Generator
 type Options struct {
 	LabelSet *kLabels.Set
+	// CertificateExpiryWindow is how long before a certificate expires it is
+	// shown as expiring.
+	CertificateExpiryWindow time.Duration
 }
 
-// NewGenerator creates a Generator.
-func NewGenerator(pm *describer.PathMatcher, dashConfig config.Dash) (*Generator, error) {
-	p := printer.NewResource(dashConfig)
+// NewGenerator creates a Generator. Its printer is configured with printerOptions.
+func NewGenerator(pm *describer.PathMatcher, dashConfig config.Dash, printerOptions ...printer.ResourceOption) (*Generator, error) {
+	p := printer.NewResource(dashConfig, printerOptions...)
 
 	if err := printer.AddHandlers(p); err != nil {
 		return nil, errors.Wrap(err, "add print handlers")
@@ -100,8 +104,11 @@ func (g *Generator) Generate(ctx context.Context, contentPath string, opts Optio
 		Dash:     g.dashConfig,
 		Link:     linkGenerator,
 
-		LoadObjects: loaderFactory.LoadObjects,
-		LoadObject:  loaderFactory.LoadObject,
+		CertificateExpiryWindow: opts.CertificateExpiryWindow,
+
+		LoadObjects:         loaderFactory.LoadObjects,
+		LoadObjectsMetadata: loaderFactory.LoadObjectsMetadata,
+		LoadObject:          loaderFactory.LoadObject,
 	}
 
 	cResponse, err := pf.Describer.Describe(ctx, namespace, options)
@@ -123,6 +123,18 @@ func (mr *MockManagerInterfaceMockRecorder) Register(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockManagerInterface)(nil).Register), arg0)
 }
 
+// RemoveClient mocks base method
+func (m *MockManagerInterface) RemoveClient(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveClient", arg0)
+}
+
+// RemoveClient indicates an expected call of RemoveClient
+func (mr *MockManagerInterfaceMockRecorder) RemoveClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveClient", reflect.TypeOf((*MockManagerInterface)(nil).RemoveClient), arg0)
+}
+
 // SetNamespace mocks base method
 func (m *MockManagerInterface) SetNamespace(arg0 string) {
 	m.ctrl.T.Helper()
@@ -44,6 +44,8 @@ type ManagerInterface interface {
 
 	ClientRequestHandlers() []octant.ClientRequestHandler
 
+	RemoveClient(clientID string)
+
 	ObjectPath(namespace, apiVersion, kind, name string) (string, error)
 }
 
@@ -199,6 +201,15 @@ func (m *Manager) ModuleForContentPath(contentPath string) (Module, bool) {
 	return nil, false
 }
 
+// RemoveClient drops the state modules keep for a client.
+func (m *Manager) RemoveClient(clientID string) {
+	for _, mod := range m.Modules() {
+		if holder, ok := mod.(ClientStateHolder); ok {
+			holder.RemoveClient(clientID)
+		}
+	}
+}
+
 // ClientRequestHandlers returns client request handlers for all modules.
 func (m *Manager) ClientRequestHandlers() []octant.ClientRequestHandler {
 	var list []octant.ClientRequestHandler
@@ -48,6 +48,38 @@ func TestManager(t *testing.T) {
 	manager.Unload()
 }
 
+type clientStateModule struct {
+	*fake.MockModule
+	removed []string
+}
+
+func (m *clientStateModule) RemoveClient(clientID string) {
+	m.removed = append(m.removed, clientID)
+}
+
+func TestManager_RemoveClient(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	clusterClient := clusterfake.NewMockClientInterface(controller)
+
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	manager, err := module.NewManager(clusterClient, "default", actionRegistrar, log.NopLogger())
+	require.NoError(t, err)
+
+	holder := &clientStateModule{MockModule: fake.NewMockModule(controller)}
+	holder.EXPECT().Start().Return(nil)
+	require.NoError(t, manager.Register(holder))
+
+	other := fake.NewMockModule(controller)
+	other.EXPECT().Start().Return(nil)
+	require.NoError(t, manager.Register(other))
+
+	manager.RemoveClient("client")
+
+	assert.Equal(t, []string{"client"}, holder.removed)
+}
+
 func TestManager_ObjectPath(t *testing.T) {
 	cases := []struct {
 		name       string
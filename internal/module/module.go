@@ -19,6 +19,22 @@ import (
 
 //go:generate mockgen -destination=./fake/mock_module.go -package=fake github.com/vmware-tanzu/octant/internal/module Module
 
+// NavigationContributor is a module that adds navigation entries to sections
+// generated by other modules. Contributed entries are routed to the contributing
+// module's Content.
+type NavigationContributor interface {
+	// NavigationContributions returns the entries the module adds to other modules' sections.
+	NavigationContributions() []navigation.Contribution
+}
+
+// ClientStateHolder is a module that keeps state for each client, such as
+// previews. RemoveClient is called when a client disconnects so the module can
+// drop the client's state.
+type ClientStateHolder interface {
+	// RemoveClient drops the state of a client.
+	RemoveClient(clientID string)
+}
+
 // ContentOptions are additional options for content generation
 type ContentOptions struct {
 	LabelSet *labels.Set
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -32,6 +33,9 @@ import (
 // Options are options for ClusterOverview.
 type Options struct {
 	DashConfig config.Dash
+	// CertificateExpiryWindow is how long before a certificate expires it is
+	// shown as expiring.
+	CertificateExpiryWindow time.Duration
 }
 
 // ClusterOverview is a module for the cluster overview.
@@ -140,7 +144,7 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 
 	q := queryer.New(objectStore, discoveryInterface)
 
-	p := printer.NewResource(co.DashConfig)
+	p := printer.NewResource(co.DashConfig, printer.WithCertificateExpiryWindow(co.CertificateExpiryWindow))
 	if err := printer.AddHandlers(p); err != nil {
 		return component.EmptyContentResponse, errors.Wrap(err, "add print handlers")
 	}
@@ -160,8 +164,11 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 		Dash:     co.DashConfig,
 		Link:     linkGenerator,
 
-		LoadObjects: loaderFactory.LoadObjects,
-		LoadObject:  loaderFactory.LoadObject,
+		CertificateExpiryWindow: co.CertificateExpiryWindow,
+
+		LoadObjects:         loaderFactory.LoadObjects,
+		LoadObjectsMetadata: loaderFactory.LoadObjectsMetadata,
+		LoadObject:          loaderFactory.LoadObject,
 	}
 
 	cResponse, err := pf.Describer.Describe(ctx, "", options)
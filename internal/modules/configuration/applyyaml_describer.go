@@ -15,32 +15,62 @@ import (
 
 // ApplyYamlDescriber describes an apply
 type ApplyYamlDescriber struct {
+	previews *ApplyYamlPreviews
 }
 
 var _ describer.Describer = (*ApplyYamlDescriber)(nil)
 
-// Describe describes the apply yaml interface
+// Describe describes the apply yaml interface. YAML is previewed with a
+// dry-run, and is applied from the preview once it is confirmed. Each client
+// sees its own preview.
 func (d *ApplyYamlDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
 	title := append([]component.TitleComponent{}, component.NewText("Apply YAML"))
-	editor := component.NewEditor(component.TitleFromString("YAML"), "", false)
-	editor.Config.SubmitLabel = "Apply"
-	editor.Config.SubmitAction = octant.ActionApplyYaml
+
+	preview := d.previews.Get(octant.NewSession(ctx, options.ContextName()))
+
+	value := ""
+	if preview != nil {
+		value = preview.Update
+	}
+
+	editor := component.NewEditor(component.TitleFromString("YAML"), value, false)
+	editor.Config.SubmitLabel = "Preview"
+	editor.Config.SubmitAction = octant.ActionApplyYamlPreview
 	list := component.NewList(title, []component.Component{editor})
 
+	if preview != nil && len(preview.Errors) > 0 {
+		list.Add(applyYamlValidationTable(preview))
+	} else if preview != nil {
+		table, err := applyYamlPreviewTable(preview)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+		list.Add(table, applyYamlPreviewButtons(preview))
+	}
+
 	return component.ContentResponse{
 		Components: []component.Component{list},
 	}, nil
 }
 
+// Previews returns the previews the describer shows.
+func (d *ApplyYamlDescriber) Previews() *ApplyYamlPreviews {
+	return d.previews
+}
+
 func (d *ApplyYamlDescriber) PathFilters() []describer.PathFilter {
 	filter := describer.NewPathFilter("/apply", d)
 	return []describer.PathFilter{*filter}
 }
 
+// Reset clears the previews.
 func (d *ApplyYamlDescriber) Reset(ctx context.Context) error {
+	d.previews.Clear()
 	return nil
 }
 
 func NewApplyYamlDescriber() *ApplyYamlDescriber {
-	return &ApplyYamlDescriber{}
+	return &ApplyYamlDescriber{
+		previews: NewApplyYamlPreviews(),
+	}
 }
@@ -14,6 +14,7 @@ import (
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -24,6 +25,7 @@ func TestApplyYamlDescriber(t *testing.T) {
 	namespace := "default"
 
 	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ContextName().Return("cluster").AnyTimes()
 
 	p := NewApplyYamlDescriber()
 
@@ -31,22 +33,47 @@ func TestApplyYamlDescriber(t *testing.T) {
 		Dash: dashConfig,
 	}
 
-	cResponse, err := p.Describe(context.TODO(), namespace, options)
+	ctx := octant.WithClientID(context.TODO(), "client")
+
+	cResponse, err := p.Describe(ctx, namespace, options)
 	require.NoError(t, err)
 
 	list := component.NewList(append([]component.TitleComponent{}, component.NewText("Apply YAML")), nil)
 
 	editor := component.NewEditor(component.TitleFromString("YAML"), "", false)
-	editor.Config.SubmitAction = "action.octant.dev/apply"
-	editor.Config.SubmitLabel = "Apply"
+	editor.Config.SubmitAction = "action.octant.dev/applyPreview"
+	editor.Config.SubmitLabel = "Preview"
 	list.Add(editor)
 
 	require.Len(t, cResponse.Components, 1)
 	component.AssertEqual(t, list, cResponse.Components[0])
 
+	preview := &ApplyYamlPreview{Namespace: namespace, Update: applyYamlPreviewUpdate}
+	p.Previews().Set(octant.Session{ClientID: "client", ContextName: "cluster"}, preview)
+
+	cResponse, err = p.Describe(ctx, namespace, options)
+	require.NoError(t, err)
+
+	table, err := applyYamlPreviewTable(preview)
+	require.NoError(t, err)
+
+	list = component.NewList(append([]component.TitleComponent{}, component.NewText("Apply YAML")), nil)
+	editor = component.NewEditor(component.TitleFromString("YAML"), applyYamlPreviewUpdate, false)
+	editor.Config.SubmitAction = "action.octant.dev/applyPreview"
+	editor.Config.SubmitLabel = "Preview"
+	list.Add(editor, table, applyYamlPreviewButtons(preview))
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, list, cResponse.Components[0])
+
+	otherClient := octant.WithClientID(context.TODO(), "other")
+	require.Nil(t, p.Previews().Get(octant.NewSession(otherClient, "cluster")))
+	require.Nil(t, p.Previews().Get(octant.NewSession(ctx, "other-cluster")))
+
 	pf := p.PathFilters()
 	require.Equal(t, "/apply", pf[0].String())
 
 	err = p.Reset(context.TODO())
 	require.NoError(t, err)
+	require.Nil(t, p.Previews().Get(octant.NewSession(ctx, "cluster")))
 }
@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/openapi"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	applyYamlPreviewColumns    = component.NewTableCols("Object", "Change", "Conflicts", "Diff")
+	applyYamlValidationColumns = component.NewTableCols("Line", "Field", "Error")
+)
+
+// ApplyYamlPreview is a server-side dry-run of YAML which has not been applied yet.
+type ApplyYamlPreview struct {
+	Namespace string
+	Update    string
+	Results   []objectstore.DryRunResult
+	// Errors are the fields which do not match their schemas. YAML with
+	// errors is not dry-run.
+	Errors openapi.ValidationErrors
+}
+
+// Conflicts returns the number of fields which applying takes ownership of.
+func (p *ApplyYamlPreview) Conflicts() int {
+	conflicts := 0
+	for _, result := range p.Results {
+		conflicts += len(result.Conflicts)
+	}
+	return conflicts
+}
+
+// ApplyYamlPreviews holds the latest preview of each session, which is shown
+// until YAML is previewed again in the session.
+type ApplyYamlPreviews struct {
+	previews map[octant.Session]*ApplyYamlPreview
+	mu       sync.RWMutex
+}
+
+// NewApplyYamlPreviews creates an instance of ApplyYamlPreviews.
+func NewApplyYamlPreviews() *ApplyYamlPreviews {
+	return &ApplyYamlPreviews{
+		previews: map[octant.Session]*ApplyYamlPreview{},
+	}
+}
+
+// Get returns the latest preview of a session. It returns nil if there is no preview.
+func (a *ApplyYamlPreviews) Get(session octant.Session) *ApplyYamlPreview {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.previews[session]
+}
+
+// Set sets the latest preview of a session. A nil preview clears it.
+func (a *ApplyYamlPreviews) Set(session octant.Session, preview *ApplyYamlPreview) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if preview == nil {
+		delete(a.previews, session)
+		return
+	}
+	a.previews[session] = preview
+}
+
+// Clear clears the previews of every session.
+func (a *ApplyYamlPreviews) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.previews = map[octant.Session]*ApplyYamlPreview{}
+}
+
+// RemoveClient clears the previews of a client's sessions.
+func (a *ApplyYamlPreviews) RemoveClient(clientID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for session := range a.previews {
+		if session.ClientID == clientID {
+			delete(a.previews, session)
+		}
+	}
+}
+
+// ApplyYamlPreviewer previews YAML with a server-side dry-run. The objects are
+// not changed until the preview's apply button is confirmed.
+type ApplyYamlPreviewer struct {
+	logger     log.Logger
+	dashConfig config.Dash
+	previews   *ApplyYamlPreviews
+}
+
+var _ action.Dispatcher = (*ApplyYamlPreviewer)(nil)
+
+// NewApplyYamlPreviewer creates an instance of ApplyYamlPreviewer.
+func NewApplyYamlPreviewer(logger log.Logger, dashConfig config.Dash, previews *ApplyYamlPreviews) *ApplyYamlPreviewer {
+	return &ApplyYamlPreviewer{
+		logger:     logger.With("action", octant.ActionApplyYamlPreview),
+		dashConfig: dashConfig,
+		previews:   previews,
+	}
+}
+
+// ActionName returns the name of the action.
+func (a *ApplyYamlPreviewer) ActionName() string {
+	return octant.ActionApplyYamlPreview
+}
+
+// Handle dry-runs the YAML in the payload and stores the result as the latest
+// preview of the client's session.
+func (a *ApplyYamlPreviewer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	a.logger.With("payload", payload).Debugf("received action payload")
+
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return errors.Wrap(err, "convert payload to apply yaml preview")
+	}
+
+	update, err := payload.String("update")
+	if err != nil {
+		return errors.Wrap(err, "convert payload to apply yaml preview")
+	}
+
+	session := octant.NewSession(ctx, a.dashConfig.ContextName())
+	clusterClient := a.dashConfig.ClusterClient()
+
+	if err := octant.NewSchemaValidator(clusterClient).ValidateYAML(ctx, update); err != nil {
+		validationErrors, ok := err.(openapi.ValidationErrors)
+		if !ok {
+			a.previews.Set(session, nil)
+			message := fmt.Sprintf("Unable to preview yaml: %s", err)
+			alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+			return nil
+		}
+
+		a.previews.Set(session, &ApplyYamlPreview{
+			Namespace: namespace,
+			Update:    update,
+			Errors:    validationErrors,
+		})
+		message := fmt.Sprintf("YAML has %d validation errors", len(validationErrors))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	results, err := objectstore.DryRunFromYAML(ctx, namespace, update, a.dashConfig.ObjectStore().Get, clusterClient)
+	if err != nil {
+		a.logger.Warnf("unable to preview yaml: %s", err)
+		a.previews.Set(session, nil)
+		message := fmt.Sprintf("Unable to preview yaml: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	preview := &ApplyYamlPreview{
+		Namespace: namespace,
+		Update:    update,
+		Results:   results,
+	}
+	a.previews.Set(session, preview)
+
+	if conflicts := preview.Conflicts(); conflicts > 0 {
+		message := fmt.Sprintf("Previewed %d resources. %d fields are managed by other field managers.", len(results), conflicts)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	message := fmt.Sprintf("Previewed %d resources", len(results))
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// applyYamlPreviewTable lists the changes a preview would make.
+func applyYamlPreviewTable(preview *ApplyYamlPreview) (*component.Table, error) {
+	table := component.NewTable("Preview", "The YAML does not contain any resources!", applyYamlPreviewColumns)
+
+	for _, result := range preview.Results {
+		diff, err := result.Diff()
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("%s (%s) %s", result.Key.Kind, result.Key.APIVersion, result.Key.Name)
+		if result.Key.Namespace != "" {
+			name = fmt.Sprintf("%s in %s", name, result.Key.Namespace)
+		}
+
+		change := "Update"
+		switch {
+		case result.Created():
+			change = "Create"
+		case diff == "":
+			change = "No changes"
+		}
+
+		var diffComponent component.Component = component.NewText("")
+		if diff != "" {
+			diffComponent = component.NewCodeBlock(diff)
+		}
+
+		var conflicts component.Component = component.NewText("<none>")
+		if len(result.Conflicts) > 0 {
+			var lines []string
+			for _, conflict := range result.Conflicts {
+				lines = append(lines, fmt.Sprintf("* `%s`: %s", conflict.Field, conflict.Message))
+			}
+			conflicts = component.NewMarkdownText(strings.Join(lines, "\n"))
+		}
+
+		table.Add(component.TableRow{
+			"Object":    component.NewText(name),
+			"Change":    component.NewText(change),
+			"Conflicts": conflicts,
+			"Diff":      diffComponent,
+		})
+	}
+
+	return table, nil
+}
+
+// applyYamlValidationTable lists the fields of a preview which do not match
+// their schemas.
+func applyYamlValidationTable(preview *ApplyYamlPreview) *component.Table {
+	table := component.NewTable("Validation Errors", "The YAML is valid!", applyYamlValidationColumns)
+
+	for _, fieldError := range preview.Errors {
+		table.Add(component.TableRow{
+			"Line":  component.NewText(fmt.Sprintf("%d", fieldError.Line)),
+			"Field": component.NewText(fieldError.Path),
+			"Error": component.NewText(fieldError.Message),
+		})
+	}
+
+	return table
+}
+
+// applyYamlPreviewButtons returns a button which applies a preview's YAML
+// after it is confirmed.
+func applyYamlPreviewButtons(preview *ApplyYamlPreview) *component.ButtonGroup {
+	body := fmt.Sprintf("Are you sure you want to apply %d resources?", len(preview.Results))
+	if conflicts := preview.Conflicts(); conflicts > 0 {
+		body = fmt.Sprintf("%s Octant will take ownership of %d fields which are managed by other field managers.", body, conflicts)
+	}
+
+	payload := action.Payload{
+		"action":    octant.ActionApplyYaml,
+		"namespace": preview.Namespace,
+		"update":    preview.Update,
+	}
+
+	buttonGroup := component.NewButtonGroup()
+	buttonGroup.AddButton(component.NewButton("Apply", payload, component.WithButtonConfirmation("Apply YAML", body)))
+	return buttonGroup
+}
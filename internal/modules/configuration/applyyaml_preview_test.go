@@ -0,0 +1,313 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/openapi"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const applyYamlPreviewUpdate = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: greeting
+data:
+  hello: world
+`
+
+func TestApplyYamlPreviewer_Handle(t *testing.T) {
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	conflict := kerrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "kubectl" using v1`,
+			Field:   ".data.hello",
+		},
+	}, "Apply failed with 1 conflict")
+
+	tests := []struct {
+		name            string
+		patchErr        error
+		expectedType    action.AlertType
+		expectedMessage string
+		expectPreview   bool
+	}{
+		{
+			name:            "preview",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: "Previewed 1 resources",
+			expectPreview:   true,
+		},
+		{
+			name:            "conflicts",
+			patchErr:        conflict,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: "Previewed 1 resources. 1 fields are managed by other field managers.",
+			expectPreview:   true,
+		},
+		{
+			name:            "invalid",
+			patchErr:        kerrors.NewBadRequest("invalid"),
+			expectedType:    action.AlertTypeError,
+			expectedMessage: `Unable to preview yaml: unable to dry-run apply of ConfigMap "greeting": invalid`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			live := &unstructured.Unstructured{}
+			live.SetAPIVersion("v1")
+			live.SetKind("ConfigMap")
+			live.SetName("greeting")
+			live.SetNamespace("default")
+
+			objectStore := storeFake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(live, nil)
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			clusterClient.EXPECT().OpenAPIResources().Return(nil, fmt.Errorf("not available"))
+			dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+			resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+			clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(gvr, true, nil)
+			clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+			dynamicClient.EXPECT().Resource(gvr).Return(resourceClient)
+			resourceClient.EXPECT().Namespace("default").Return(resourceClient)
+
+			patch := resourceClient.EXPECT().
+				Patch(gomock.Any(), "greeting", types.ApplyPatchType, gomock.Any(), gomock.Any())
+			if test.patchErr != nil {
+				patch.Return(nil, test.patchErr)
+			} else {
+				patch.Return(live, nil)
+			}
+			if test.patchErr == conflict {
+				resourceClient.EXPECT().
+					Patch(gomock.Any(), "greeting", types.ApplyPatchType, gomock.Any(), gomock.Any()).
+					Return(live, nil)
+			}
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ObjectStore().Return(objectStore)
+			dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+			dashConfig.EXPECT().ContextName().Return("cluster")
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			ctx := octant.WithClientID(context.Background(), "client")
+			session := octant.Session{ClientID: "client", ContextName: "cluster"}
+
+			previews := NewApplyYamlPreviews()
+			previews.Set(session, &ApplyYamlPreview{Update: "stale"})
+
+			previewer := NewApplyYamlPreviewer(log.NopLogger(), dashConfig, previews)
+			assert.Equal(t, octant.ActionApplyYamlPreview, previewer.ActionName())
+
+			payload := action.CreatePayload(octant.ActionApplyYamlPreview, map[string]interface{}{
+				"namespace": "default",
+				"update":    applyYamlPreviewUpdate,
+			})
+			require.NoError(t, previewer.Handle(ctx, alerter, payload))
+
+			preview := previews.Get(session)
+			if !test.expectPreview {
+				require.Nil(t, preview)
+				return
+			}
+			require.NotNil(t, preview)
+			assert.Equal(t, "default", preview.Namespace)
+			assert.Equal(t, applyYamlPreviewUpdate, preview.Update)
+			require.Len(t, preview.Results, 1)
+			assert.Equal(t, key, preview.Results[0].Key)
+		})
+	}
+}
+
+func TestApplyYamlPreviewer_Handle_invalid(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	doc, err := openapi_v2.ParseDocument([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "Kubernetes", "version": "v1.18.0"},
+  "paths": {},
+  "definitions": {
+    "io.k8s.api.core.v1.ConfigMap": {
+      "type": "object",
+      "properties": {
+        "apiVersion": {"type": "string"},
+        "kind": {"type": "string"},
+        "metadata": {"type": "object"}
+      },
+      "x-kubernetes-group-version-kind": [{"group": "", "kind": "ConfigMap", "version": "v1"}]
+    }
+  }
+}`))
+	require.NoError(t, err)
+	resources, err := openapi.NewResources(doc)
+	require.NoError(t, err)
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().OpenAPIResources().Return(resources, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+	dashConfig.EXPECT().ContextName().Return("cluster")
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, "YAML has 1 validation errors", alert.Message)
+		})
+
+	previews := NewApplyYamlPreviews()
+	previewer := NewApplyYamlPreviewer(log.NopLogger(), dashConfig, previews)
+
+	payload := action.CreatePayload(octant.ActionApplyYamlPreview, map[string]interface{}{
+		"namespace": "default",
+		"update":    applyYamlPreviewUpdate,
+	})
+	require.NoError(t, previewer.Handle(context.Background(), alerter, payload))
+
+	preview := previews.Get(octant.Session{ContextName: "cluster"})
+	require.NotNil(t, preview)
+	assert.Empty(t, preview.Results)
+	assert.Equal(t, openapi.ValidationErrors{{Line: 6, Path: "data", Message: "unknown field"}}, preview.Errors)
+
+	expected := component.NewTableWithRows("Validation Errors", "The YAML is valid!", applyYamlValidationColumns,
+		[]component.TableRow{
+			{
+				"Line":  component.NewText("6"),
+				"Field": component.NewText("data"),
+				"Error": component.NewText("unknown field"),
+			},
+		})
+	component.AssertEqual(t, expected, applyYamlValidationTable(preview))
+}
+
+func TestApplyYamlPreviewTable(t *testing.T) {
+	configMap := func(hello string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "greeting", "namespace": "default"},
+			"data":       map[string]interface{}{"hello": hello},
+		}}
+	}
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+
+	preview := &ApplyYamlPreview{
+		Namespace: "default",
+		Update:    applyYamlPreviewUpdate,
+		Results: []objectstore.DryRunResult{
+			{
+				Key:    key,
+				Live:   configMap("there"),
+				Result: configMap("world"),
+				Conflicts: []objectstore.FieldConflict{
+					{Field: ".data.hello", Message: `conflict with "kubectl" using v1`},
+				},
+			},
+			{
+				Key:    key,
+				Live:   configMap("world"),
+				Result: configMap("world"),
+			},
+		},
+	}
+
+	got, err := applyYamlPreviewTable(preview)
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Preview", "The YAML does not contain any resources!", applyYamlPreviewColumns,
+		[]component.TableRow{
+			{
+				"Object":    component.NewText("ConfigMap (v1) greeting in default"),
+				"Change":    component.NewText("Update"),
+				"Conflicts": component.NewMarkdownText("* `.data.hello`: conflict with \"kubectl\" using v1"),
+				"Diff": component.NewCodeBlock("--- live\n" +
+					"+++ dry-run\n" +
+					"@@ -1,6 +1,6 @@\n" +
+					" apiVersion: v1\n" +
+					" data:\n" +
+					"-  hello: there\n" +
+					"+  hello: world\n" +
+					" kind: ConfigMap\n" +
+					" metadata:\n" +
+					"   name: greeting\n"),
+			},
+			{
+				"Object":    component.NewText("ConfigMap (v1) greeting in default"),
+				"Change":    component.NewText("No changes"),
+				"Conflicts": component.NewText("<none>"),
+				"Diff":      component.NewText(""),
+			},
+		})
+	component.AssertEqual(t, expected, got)
+
+	buttons := applyYamlPreviewButtons(preview)
+	require.Len(t, buttons.Config.Buttons, 1)
+	button := buttons.Config.Buttons[0]
+	assert.Equal(t, "Apply", button.Name)
+	assert.Equal(t, action.Payload{
+		"action":    octant.ActionApplyYaml,
+		"namespace": "default",
+		"update":    applyYamlPreviewUpdate,
+	}, button.Payload)
+	require.NotNil(t, button.Confirmation)
+	assert.Equal(t, "Are you sure you want to apply 2 resources? Octant will take ownership of 1 fields which are managed by other field managers.",
+		button.Confirmation.Body)
+}
+
+func TestApplyYamlPreviews_RemoveClient(t *testing.T) {
+	previews := NewApplyYamlPreviews()
+	previews.Set(octant.Session{ClientID: "client", ContextName: "cluster"}, &ApplyYamlPreview{})
+	previews.Set(octant.Session{ClientID: "client", ContextName: "other-cluster"}, &ApplyYamlPreview{})
+	previews.Set(octant.Session{ClientID: "other", ContextName: "cluster"}, &ApplyYamlPreview{})
+
+	previews.RemoveClient("client")
+
+	assert.Nil(t, previews.Get(octant.Session{ClientID: "client", ContextName: "cluster"}))
+	assert.Nil(t, previews.Get(octant.Session{ClientID: "client", ContextName: "other-cluster"}))
+	assert.NotNil(t, previews.Get(octant.Session{ClientID: "other", ContextName: "cluster"}))
+}
@@ -39,6 +39,7 @@ type Configuration struct {
 
 var _ module.Module = (*Configuration)(nil)
 var _ module.ActionReceiver = (*Configuration)(nil)
+var _ module.ClientStateHolder = (*Configuration)(nil)
 
 func New(ctx context.Context, options Options) *Configuration {
 	pm := describer.NewPathMatcher("configuration")
@@ -61,8 +62,9 @@ func (c Configuration) ClientRequestHandlers() []octant.ClientRequestHandler {
 	return nil
 }
 
+// SetContext resets the describers, which clears the previews of the previous context.
 func (c *Configuration) SetContext(ctx context.Context, contextName string) error {
-	return nil
+	return rootDescriber.Reset(ctx)
 }
 
 func (c *Configuration) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
@@ -100,6 +102,12 @@ func (c *Configuration) Navigation(ctx context.Context, namespace, root string)
 			Path:     path.Join(c.ContentPath(), "plugins"),
 			IconName: icon.ConfigurationPlugin,
 		},
+		{
+			Module:   "Configuration",
+			Title:    "Diagnostics",
+			Path:     path.Join(c.ContentPath(), "diagnostics"),
+			IconName: icon.ConfigurationDiagnostics,
+		},
 	}, nil
 }
 
@@ -141,10 +149,26 @@ func (c Configuration) Generators() []octant.Generator {
 	}
 }
 
+// RemoveClient drops the previews of a client which disconnected.
+func (c *Configuration) RemoveClient(clientID string) {
+	applyYamlDescriber.Previews().RemoveClient(clientID)
+	createDescriber.Previews().RemoveClient(clientID)
+}
+
 func (c *Configuration) ActionPaths() map[string]action.DispatcherFunc {
 	objectDeleter := NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore())
+	pluginConfigurationUpdater := NewPluginConfigurationUpdater(c.DashConfig.Logger(), c.DashConfig.PluginManager())
+	snapshotCapturer := NewSnapshotCapturer(c.DashConfig.Logger(), c.DashConfig)
+	applyYamlPreviewer := NewApplyYamlPreviewer(c.DashConfig.Logger(), c.DashConfig, applyYamlDescriber.Previews())
+	templatePreviewer := NewTemplatePreviewer(c.DashConfig.Logger(), c.DashConfig, createDescriber.Loader(), createDescriber.Previews())
+	templateCreator := NewTemplateCreator(c.DashConfig.Logger(), c.DashConfig, createDescriber.Loader(), createDescriber.Previews())
 
 	return map[string]action.DispatcherFunc{
-		objectDeleter.ActionName(): objectDeleter.Handle,
+		objectDeleter.ActionName():              objectDeleter.Handle,
+		pluginConfigurationUpdater.ActionName(): pluginConfigurationUpdater.Handle,
+		snapshotCapturer.ActionName():           snapshotCapturer.Handle,
+		applyYamlPreviewer.ActionName():         applyYamlPreviewer.Handle,
+		templatePreviewer.ActionName():          templatePreviewer.Handle,
+		templateCreator.ActionName():            templateCreator.Handle,
 	}
 }
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/templates"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// CreateDescriber describes the templates resources can be created from.
+type CreateDescriber struct {
+	loader   *templates.Loader
+	previews *TemplatePreviews
+}
+
+var _ describer.Describer = (*CreateDescriber)(nil)
+
+// NewCreateDescriber creates an instance of CreateDescriber.
+func NewCreateDescriber(loader *templates.Loader) *CreateDescriber {
+	return &CreateDescriber{
+		loader:   loader,
+		previews: NewTemplatePreviews(),
+	}
+}
+
+// Describe describes the templates. Each template is configured with a form,
+// and the client's latest preview is shown with a button which creates it.
+func (d *CreateDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := append([]component.TitleComponent{}, component.NewText("Create"))
+	list := component.NewList(title, nil)
+
+	if preview := d.previews.Get(octant.NewSession(ctx, options.ContextName())); preview != nil {
+		list.Add(templatePreviewCard(preview))
+	}
+
+	if namespace == "" {
+		namespace = options.DefaultNamespace()
+	}
+
+	loaded, errs := d.loader.Load()
+	for _, template := range loaded {
+		list.Add(templateCard(template, namespace))
+	}
+
+	if len(errs) > 0 {
+		var messages []string
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		list.Add(component.NewText(fmt.Sprintf("Unable to load templates: %s", strings.Join(messages, "; "))))
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// Loader returns the loader the describer loads templates with.
+func (d *CreateDescriber) Loader() *templates.Loader {
+	return d.loader
+}
+
+// Previews returns the previews the describer shows.
+func (d *CreateDescriber) Previews() *TemplatePreviews {
+	return d.previews
+}
+
+func (d *CreateDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/create", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset clears the previews.
+func (d *CreateDescriber) Reset(ctx context.Context) error {
+	d.previews.Clear()
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/templates"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestCreateDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().DefaultNamespace().Return("default").AnyTimes()
+	dashConfig.EXPECT().ContextName().Return("cluster").AnyTimes()
+
+	loader := templateLoader(t)
+	d := NewCreateDescriber(loader)
+
+	options := describer.Options{
+		Dash: dashConfig,
+	}
+
+	loaded, errs := loader.Load()
+	require.Empty(t, errs)
+
+	expected := component.NewList(append([]component.TitleComponent{}, component.NewText("Create")), nil)
+	for _, template := range loaded {
+		expected.Add(templateCard(template, "default"))
+	}
+
+	ctx := octant.WithClientID(context.TODO(), "client")
+
+	cResponse, err := d.Describe(ctx, "", options)
+	require.NoError(t, err)
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, expected, cResponse.Components[0])
+
+	preview := &TemplatePreview{
+		Template:  "Greeting",
+		Namespace: "default",
+		Values:    map[string]string{"name": "greeting"},
+		YAML:      "kind: ConfigMap\n",
+		Objects:   1,
+	}
+	d.Previews().Set(octant.Session{ClientID: "client", ContextName: "cluster"}, preview)
+
+	expected = component.NewList(append([]component.TitleComponent{}, component.NewText("Create")), nil)
+	expected.Add(templatePreviewCard(preview))
+	for _, template := range loaded {
+		expected.Add(templateCard(template, "default"))
+	}
+
+	cResponse, err = d.Describe(ctx, "", options)
+	require.NoError(t, err)
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, expected, cResponse.Components[0])
+
+	cResponse, err = d.Describe(octant.WithClientID(context.TODO(), "other"), "", options)
+	require.NoError(t, err)
+	require.Len(t, cResponse.Components, 1)
+	require.Len(t, cResponse.Components[0].(*component.List).Config.Items, len(loaded))
+
+	require.NoError(t, d.Reset(ctx))
+	require.Nil(t, d.Previews().Get(octant.NewSession(ctx, "cluster")))
+}
+
+func Test_templateCard(t *testing.T) {
+	template := templates.Template{
+		Name:        "Greeting",
+		Description: "A greeting.",
+		Namespaced:  true,
+		Fields: []templates.Field{
+			{Name: "name", Required: true},
+			{Name: "greeting", Type: templates.FieldSelect, Choices: []string{"hello", "hi"}, Default: "hi"},
+		},
+	}
+
+	card := templateCard(template, "default")
+	require.Len(t, card.Config.Actions, 1)
+
+	fields := card.Config.Actions[0].Form.Fields
+	require.Len(t, fields, 5)
+	require.Equal(t, "template", fields[1].Name())
+	require.Equal(t, "namespace", fields[2].Name())
+	require.Equal(t, "name (required)", fields[3].Label())
+	require.Equal(t, component.NewFormFieldSelect("greeting", "value:greeting", []component.InputChoice{
+		{Label: "hello", Value: "hello"},
+		{Label: "hi", Value: "hi", Checked: true},
+	}, false), fields[4])
+}
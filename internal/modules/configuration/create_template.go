@@ -0,0 +1,387 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/templates"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	createTemplateField  = "template"
+	createNamespaceField = "namespace"
+	// createValueFieldPrefix prefixes the names of template fields, so they
+	// can't clash with the fields which identify the template.
+	createValueFieldPrefix = "value:"
+
+	redactedSecretValue = "<redacted>"
+)
+
+// TemplatePreview is a template which has been rendered but not created yet.
+// Values stay in Octant, and the values of secrets are redacted from YAML, so
+// passwords and keys aren't sent back to the browser.
+type TemplatePreview struct {
+	Template  string
+	Namespace string
+	Values    map[string]string
+	YAML      string
+	Objects   int
+}
+
+// TemplatePreviews holds the latest preview of each session, which is shown
+// until a template is previewed again or created in the session.
+type TemplatePreviews struct {
+	previews map[octant.Session]*TemplatePreview
+	mu       sync.RWMutex
+}
+
+// NewTemplatePreviews creates an instance of TemplatePreviews.
+func NewTemplatePreviews() *TemplatePreviews {
+	return &TemplatePreviews{
+		previews: map[octant.Session]*TemplatePreview{},
+	}
+}
+
+// Get returns the latest preview of a session. It returns nil if there is no preview.
+func (p *TemplatePreviews) Get(session octant.Session) *TemplatePreview {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.previews[session]
+}
+
+// Set sets the latest preview of a session. A nil preview clears it.
+func (p *TemplatePreviews) Set(session octant.Session, preview *TemplatePreview) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if preview == nil {
+		delete(p.previews, session)
+		return
+	}
+	p.previews[session] = preview
+}
+
+// Clear clears the previews of every session.
+func (p *TemplatePreviews) Clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.previews = map[octant.Session]*TemplatePreview{}
+}
+
+// RemoveClient clears the previews of a client's sessions.
+func (p *TemplatePreviews) RemoveClient(clientID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for session := range p.previews {
+		if session.ClientID == clientID {
+			delete(p.previews, session)
+		}
+	}
+}
+
+// TemplatePreviewer renders a template with the values of its form, so the
+// generated YAML can be reviewed before it is created.
+type TemplatePreviewer struct {
+	logger     log.Logger
+	dashConfig config.Dash
+	loader     *templates.Loader
+	previews   *TemplatePreviews
+}
+
+var _ action.Dispatcher = (*TemplatePreviewer)(nil)
+
+// NewTemplatePreviewer creates an instance of TemplatePreviewer.
+func NewTemplatePreviewer(logger log.Logger, dashConfig config.Dash, loader *templates.Loader, previews *TemplatePreviews) *TemplatePreviewer {
+	return &TemplatePreviewer{
+		logger:     logger.With("action", octant.ActionCreateTemplatePreview),
+		dashConfig: dashConfig,
+		loader:     loader,
+		previews:   previews,
+	}
+}
+
+// ActionName returns the name of the action.
+func (p *TemplatePreviewer) ActionName() string {
+	return octant.ActionCreateTemplatePreview
+}
+
+// Handle renders the template in the payload and stores it as the latest
+// preview of the client's session.
+func (p *TemplatePreviewer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.Debugf("received action payload")
+
+	session := octant.NewSession(ctx, p.dashConfig.ContextName())
+
+	name, err := payload.String(createTemplateField)
+	if err != nil {
+		return errors.Wrap(err, "convert payload to template preview")
+	}
+
+	template, ok := p.loader.Get(name)
+	if !ok {
+		message := fmt.Sprintf("Template %q does not exist", name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	namespace := payloadValue(payload, createNamespaceField)
+
+	values := map[string]string{}
+	for _, field := range template.Fields {
+		if _, ok := payload[createValueFieldPrefix+field.Name]; ok {
+			values[field.Name] = payloadValue(payload, createValueFieldPrefix+field.Name)
+		}
+	}
+
+	objects, rendered, err := template.Objects(namespace, values)
+	if err != nil {
+		p.previews.Set(session, nil)
+		message := fmt.Sprintf("Unable to render template %q: %s", name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	previewYAML, err := redactSecrets(rendered, objects)
+	if err != nil {
+		p.previews.Set(session, nil)
+		message := fmt.Sprintf("Unable to preview template %q: %s", name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	p.previews.Set(session, &TemplatePreview{
+		Template:  name,
+		Namespace: namespace,
+		Values:    values,
+		YAML:      previewYAML,
+		Objects:   len(objects),
+	})
+
+	message := fmt.Sprintf("Previewed %d resources from template %q", len(objects), name)
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// TemplateCreator creates the objects a template renders.
+type TemplateCreator struct {
+	logger     log.Logger
+	dashConfig config.Dash
+	loader     *templates.Loader
+	previews   *TemplatePreviews
+}
+
+var _ action.Dispatcher = (*TemplateCreator)(nil)
+
+// NewTemplateCreator creates an instance of TemplateCreator.
+func NewTemplateCreator(logger log.Logger, dashConfig config.Dash, loader *templates.Loader, previews *TemplatePreviews) *TemplateCreator {
+	return &TemplateCreator{
+		logger:     logger.With("action", octant.ActionCreateFromTemplate),
+		dashConfig: dashConfig,
+		loader:     loader,
+		previews:   previews,
+	}
+}
+
+// ActionName returns the name of the action.
+func (c *TemplateCreator) ActionName() string {
+	return octant.ActionCreateFromTemplate
+}
+
+// Handle renders the client's preview of the template in the payload again and
+// creates its objects. Objects which were created before an error are not removed.
+func (c *TemplateCreator) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	c.logger.Debugf("received action payload")
+
+	name, err := payload.String(createTemplateField)
+	if err != nil {
+		return errors.Wrap(err, "convert payload to template")
+	}
+
+	template, ok := c.loader.Get(name)
+	if !ok {
+		message := fmt.Sprintf("Template %q does not exist", name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	session := octant.NewSession(ctx, c.dashConfig.ContextName())
+
+	preview := c.previews.Get(session)
+	if preview == nil || preview.Template != name {
+		message := fmt.Sprintf("Template %q has not been previewed", name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	objects, _, err := template.Objects(preview.Namespace, preview.Values)
+	if err != nil {
+		message := fmt.Sprintf("Unable to render template %q: %s", name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	var created []string
+	for _, object := range objects {
+		if err := c.dashConfig.ObjectStore().Create(ctx, object); err != nil {
+			message := fmt.Sprintf("Unable to create %s %q: %s", object.GetKind(), object.GetName(), err)
+			if len(created) > 0 {
+				message = fmt.Sprintf("%s. Created %s.", message, strings.Join(created, ", "))
+			}
+			alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+			return nil
+		}
+		created = append(created, fmt.Sprintf("%s %q", object.GetKind(), object.GetName()))
+	}
+
+	c.previews.Set(session, nil)
+
+	message := fmt.Sprintf("Created %s", strings.Join(created, ", "))
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// payloadValue returns a form value from a payload as a string. Selects send
+// their initial value as a list.
+func payloadValue(payload action.Payload, key string) string {
+	switch v := payload[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		if len(v) == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%v", v[0])
+	case float64:
+		return fmt.Sprintf("%v", int64(v))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// redactSecrets returns the YAML of rendered objects with the values of
+// secrets replaced. Templates which don't render secrets are returned as they
+// were rendered.
+func redactSecrets(rendered string, objects []*unstructured.Unstructured) (string, error) {
+	hasSecret := false
+	for _, object := range objects {
+		if object.GetAPIVersion() == "v1" && object.GetKind() == "Secret" {
+			hasSecret = true
+		}
+	}
+	if !hasSecret {
+		return rendered, nil
+	}
+
+	var docs []string
+	for _, object := range objects {
+		if object.GetAPIVersion() == "v1" && object.GetKind() == "Secret" {
+			object = object.DeepCopy()
+			for _, field := range []string{"data", "stringData"} {
+				values, ok := object.Object[field].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for key := range values {
+					values[key] = redactedSecretValue
+				}
+			}
+		}
+
+		data, err := yaml.Marshal(object.Object)
+		if err != nil {
+			return "", fmt.Errorf("marshal %s %q: %w", object.GetKind(), object.GetName(), err)
+		}
+		docs = append(docs, string(data))
+	}
+
+	return strings.Join(docs, "---\n"), nil
+}
+
+// templateCard describes a template, and has an action which previews it with
+// the values of its form.
+func templateCard(template templates.Template, namespace string) *component.Card {
+	card := component.NewCard(component.TitleFromString(template.Name))
+	card.SetBody(component.NewText(template.Description))
+
+	fields := []component.FormField{
+		component.NewFormFieldHidden("action", octant.ActionCreateTemplatePreview),
+		component.NewFormFieldHidden(createTemplateField, template.Name),
+	}
+	if template.Namespaced {
+		fields = append(fields, component.NewFormFieldText("Namespace", createNamespaceField, namespace))
+	}
+
+	for _, field := range template.Fields {
+		fields = append(fields, templateFormField(field))
+	}
+
+	card.AddAction(component.Action{
+		Name:  "Configure",
+		Title: fmt.Sprintf("Create %s", template.Name),
+		Form:  component.Form{Fields: fields},
+	})
+
+	return card
+}
+
+func templateFormField(field templates.Field) component.FormField {
+	label := field.DisplayLabel()
+	if field.Required {
+		label += " (required)"
+	}
+	name := createValueFieldPrefix + field.Name
+
+	switch field.Type {
+	case templates.FieldNumber:
+		return component.NewFormFieldNumber(label, name, field.Default)
+	case templates.FieldTextarea:
+		return component.NewFormFieldTextarea(label, name, field.Default)
+	case templates.FieldPassword:
+		return component.NewFormFieldPassword(label, name, field.Default)
+	case templates.FieldSelect:
+		var choices []component.InputChoice
+		for _, choice := range field.Choices {
+			choices = append(choices, component.InputChoice{Label: choice, Value: choice, Checked: choice == field.Default})
+		}
+		return component.NewFormFieldSelect(label, name, choices, false)
+	default:
+		return component.NewFormFieldText(label, name, field.Default)
+	}
+}
+
+// templatePreviewCard shows the YAML a preview renders, and has a button which
+// creates it after it is confirmed.
+func templatePreviewCard(preview *TemplatePreview) *component.Card {
+	card := component.NewCard(component.TitleFromString(fmt.Sprintf("Preview: %s", preview.Template)))
+
+	buttonGroup := component.NewButtonGroup()
+	buttonGroup.AddButton(component.NewButton("Create",
+		action.Payload{
+			"action":            octant.ActionCreateFromTemplate,
+			createTemplateField: preview.Template,
+		},
+		component.WithButtonConfirmation("Create Resources",
+			fmt.Sprintf("Are you sure you want to create %d resources from template **%s**?", preview.Objects, preview.Template))))
+
+	list := component.NewList(nil, []component.Component{component.NewCodeBlock(preview.YAML), buttonGroup})
+	card.SetBody(list)
+
+	return card
+}
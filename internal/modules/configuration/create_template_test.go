@@ -0,0 +1,284 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/templates"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func templateLoader(t *testing.T) *templates.Loader {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/templates/greeting.yaml", []byte(`name: Greeting
+namespaced: true
+fields:
+- name: name
+  required: true
+- name: greeting
+  type: select
+  choices: [hello, hi]
+  default: hello
+template: |
+  apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: {{ quote .Values.name }}
+    namespace: {{ quote .Namespace }}
+  data:
+    greeting: {{ quote .Values.greeting }}
+`), 0644))
+
+	return templates.NewLoader(fs, func() string { return "/templates" })
+}
+
+func TestTemplatePreviewer_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		payload         map[string]interface{}
+		expectedType    action.AlertType
+		expectedMessage string
+		expected        *TemplatePreview
+	}{
+		{
+			name: "preview",
+			payload: map[string]interface{}{
+				"template":       "Greeting",
+				"namespace":      "default",
+				"value:name":     "greeting",
+				"value:greeting": []interface{}{"hi"},
+			},
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Previewed 1 resources from template "Greeting"`,
+			expected: &TemplatePreview{
+				Template:  "Greeting",
+				Namespace: "default",
+				Values:    map[string]string{"name": "greeting", "greeting": "hi"},
+				YAML: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: "greeting"
+  namespace: "default"
+data:
+  greeting: "hi"
+`,
+				Objects: 1,
+			},
+		},
+		{
+			name: "missing required field",
+			payload: map[string]interface{}{
+				"template":  "Greeting",
+				"namespace": "default",
+			},
+			expectedType:    action.AlertTypeError,
+			expectedMessage: `Unable to render template "Greeting": name is required`,
+		},
+		{
+			name: "unknown template",
+			payload: map[string]interface{}{
+				"template": "Unknown",
+			},
+			expectedType:    action.AlertTypeError,
+			expectedMessage: `Template "Unknown" does not exist`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ContextName().Return("cluster")
+
+			ctx := octant.WithClientID(context.Background(), "client")
+			session := octant.Session{ClientID: "client", ContextName: "cluster"}
+
+			previews := NewTemplatePreviews()
+			if test.expected == nil {
+				previews.Set(session, &TemplatePreview{Template: "stale"})
+			}
+
+			previewer := NewTemplatePreviewer(log.NopLogger(), dashConfig, templateLoader(t), previews)
+			assert.Equal(t, octant.ActionCreateTemplatePreview, previewer.ActionName())
+
+			payload := action.CreatePayload(octant.ActionCreateTemplatePreview, test.payload)
+			require.NoError(t, previewer.Handle(ctx, alerter, payload))
+
+			if test.name == "unknown template" {
+				return
+			}
+			assert.Equal(t, test.expected, previews.Get(session))
+		})
+	}
+}
+
+func TestTemplateCreator_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		createErr       error
+		expectedType    action.AlertType
+		expectedMessage string
+		expectPreview   bool
+	}{
+		{
+			name:            "create",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Created ConfigMap "greeting"`,
+		},
+		{
+			name:            "create failed",
+			createErr:       fmt.Errorf("already exists"),
+			expectedType:    action.AlertTypeError,
+			expectedMessage: `Unable to create ConfigMap "greeting": already exists`,
+			expectPreview:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := storeFake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured) error {
+					assert.Equal(t, "ConfigMap", object.GetKind())
+					assert.Equal(t, "default", object.GetNamespace())
+					data, _, err := unstructured.NestedStringMap(object.Object, "data")
+					require.NoError(t, err)
+					assert.Equal(t, map[string]string{"greeting": "hello"}, data)
+					return test.createErr
+				})
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ObjectStore().Return(objectStore)
+			dashConfig.EXPECT().ContextName().Return("cluster")
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			ctx := octant.WithClientID(context.Background(), "client")
+			session := octant.Session{ClientID: "client", ContextName: "cluster"}
+
+			previews := NewTemplatePreviews()
+			previews.Set(session, &TemplatePreview{
+				Template:  "Greeting",
+				Namespace: "default",
+				Values:    map[string]string{"name": "greeting"},
+			})
+
+			creator := NewTemplateCreator(log.NopLogger(), dashConfig, templateLoader(t), previews)
+			assert.Equal(t, octant.ActionCreateFromTemplate, creator.ActionName())
+
+			payload := action.CreatePayload(octant.ActionCreateFromTemplate, map[string]interface{}{
+				"template": "Greeting",
+			})
+			require.NoError(t, creator.Handle(ctx, alerter, payload))
+
+			if test.expectPreview {
+				assert.NotNil(t, previews.Get(session))
+			} else {
+				assert.Nil(t, previews.Get(session))
+			}
+		})
+	}
+}
+
+func TestTemplateCreator_Handle_not_previewed(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ContextName().Return("cluster")
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeError, alert.Type)
+			assert.Equal(t, `Template "Greeting" has not been previewed`, alert.Message)
+		})
+
+	previews := NewTemplatePreviews()
+	previews.Set(octant.Session{ClientID: "other", ContextName: "cluster"}, &TemplatePreview{Template: "Greeting"})
+
+	creator := NewTemplateCreator(log.NopLogger(), dashConfig, templateLoader(t), previews)
+
+	payload := action.CreatePayload(octant.ActionCreateFromTemplate, map[string]interface{}{
+		"template": "Greeting",
+	})
+	require.NoError(t, creator.Handle(octant.WithClientID(context.Background(), "client"), alerter, payload))
+}
+
+func TestTemplatePreviews_RemoveClient(t *testing.T) {
+	previews := NewTemplatePreviews()
+	previews.Set(octant.Session{ClientID: "client", ContextName: "cluster"}, &TemplatePreview{})
+	previews.Set(octant.Session{ClientID: "client", ContextName: "other-cluster"}, &TemplatePreview{})
+	previews.Set(octant.Session{ClientID: "other", ContextName: "cluster"}, &TemplatePreview{})
+
+	previews.RemoveClient("client")
+
+	assert.Nil(t, previews.Get(octant.Session{ClientID: "client", ContextName: "cluster"}))
+	assert.Nil(t, previews.Get(octant.Session{ClientID: "client", ContextName: "other-cluster"}))
+	assert.NotNil(t, previews.Get(octant.Session{ClientID: "other", ContextName: "cluster"}))
+}
+
+func Test_redactSecrets(t *testing.T) {
+	template, ok := templates.NewLoader(afero.NewMemMapFs(), func() string { return "/templates" }).Get("TLS Secret")
+	require.True(t, ok)
+
+	objects, rendered, err := template.Objects("default", map[string]string{
+		"name":        "tls",
+		"certificate": "certificate",
+		"key":         "private key",
+	})
+	require.NoError(t, err)
+
+	got, err := redactSecrets(rendered, objects)
+	require.NoError(t, err)
+
+	expected := `apiVersion: v1
+data:
+  tls.crt: <redacted>
+  tls.key: <redacted>
+kind: Secret
+metadata:
+  name: tls
+  namespace: default
+type: kubernetes.io/tls
+`
+	assert.Equal(t, expected, got)
+}
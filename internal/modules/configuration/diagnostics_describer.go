@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// DiagnosticsDescriber describes the informers the object store has started, and
+// captures snapshots of the current context.
+type DiagnosticsDescriber struct {
+}
+
+var _ describer.Describer = (*DiagnosticsDescriber)(nil)
+
+// NewDiagnosticsDescriber creates an instance of DiagnosticsDescriber.
+func NewDiagnosticsDescriber() *DiagnosticsDescriber {
+	return &DiagnosticsDescriber{}
+}
+
+// Describe describes the object store's informers.
+func (d *DiagnosticsDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := append([]component.TitleComponent{}, component.NewText("Diagnostics"))
+	list := component.NewList(title, nil)
+
+	provider, ok := options.ObjectStore().(objectstore.InformerStatsProvider)
+	if !ok {
+		card := component.NewCard(component.TitleFromString("Informers"))
+		card.SetBody(component.NewText("The object store does not report informer statistics."))
+		list.Add(card, snapshotCard(options.ContextName()))
+		return component.ContentResponse{Components: []component.Component{list}}, nil
+	}
+
+	stats := provider.InformerStats()
+
+	list.Add(informerSummary(stats), informerTable(stats), snapshotCard(options.ContextName()))
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// PathFilters returns the paths the describer handles.
+func (d *DiagnosticsDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/diagnostics", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset resets the describer.
+func (d *DiagnosticsDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func informerSummary(stats objectstore.InformerStats) *component.Summary {
+	idleTTL := "Disabled"
+	if stats.IdleTTL > 0 {
+		idleTTL = stats.IdleTTL.String()
+	}
+
+	objectBudget := "Unlimited"
+	if stats.MaxObjects > 0 {
+		objectBudget = fmt.Sprintf("%d", stats.MaxObjects)
+	}
+
+	memoryBudget := "Unlimited"
+	if stats.MaxBytes > 0 {
+		memoryBudget = formatBytes(stats.MaxBytes)
+	}
+
+	return component.NewSummary("Informers",
+		component.SummarySection{Header: "Idle TTL", Content: component.NewText(idleTTL)},
+		component.SummarySection{Header: "Object Budget", Content: component.NewText(objectBudget)},
+		component.SummarySection{Header: "Memory Budget", Content: component.NewText(memoryBudget)},
+		component.SummarySection{Header: "Informers", Content: component.NewText(fmt.Sprintf("%d", len(stats.Informers)))},
+		component.SummarySection{Header: "Informer Factories", Content: component.NewText(fmt.Sprintf("%d", stats.Factories))},
+		component.SummarySection{Header: "Cached Objects", Content: component.NewText(fmt.Sprintf("%d", stats.Objects()))},
+		component.SummarySection{Header: "Estimated Memory", Content: component.NewText(formatBytes(stats.EstimatedBytes()))},
+		component.SummarySection{Header: "Evictions", Content: component.NewText(fmt.Sprintf("%d", stats.Evictions))},
+	)
+}
+
+func informerTable(stats objectstore.InformerStats) *component.Table {
+	cols := component.NewTableCols("Resource", "Namespaces", "Cache", "Watched", "Objects", "Estimated Memory", "Last Access")
+	table := component.NewTable("Informers", "There are no informers!", cols)
+
+	for _, stat := range stats.Informers {
+		namespaces := make([]string, len(stat.Namespaces))
+		for i, namespace := range stat.Namespaces {
+			if namespace == "" {
+				namespace = "(all)"
+			}
+			namespaces[i] = namespace
+		}
+
+		cache := "Objects"
+		if stat.MetadataOnly {
+			cache = "Metadata"
+		}
+
+		watched := "No"
+		if stat.Watched {
+			watched = "Yes"
+		}
+
+		table.Add(component.TableRow{
+			"Resource":         component.NewText(stat.GroupVersionKind.String()),
+			"Namespaces":       component.NewText(strings.Join(namespaces, ", ")),
+			"Cache":            component.NewText(cache),
+			"Watched":          component.NewText(watched),
+			"Objects":          component.NewText(fmt.Sprintf("%d", stat.Objects)),
+			"Estimated Memory": component.NewText(formatBytes(stat.EstimatedBytes)),
+			"Last Access":      component.NewTimestamp(stat.LastAccess),
+		})
+	}
+
+	return table
+}
+
+func formatBytes(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestDiagnosticsDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	lastAccess := time.Now()
+
+	objectStore := &statsStore{
+		MockStore: storeFake.NewMockStore(controller),
+		stats: objectstore.InformerStats{
+			Informers: []objectstore.InformerStat{
+				{
+					GroupVersionKind: gvk.Secret,
+					Namespaces:       []string{"", "default"},
+					MetadataOnly:     true,
+					Watched:          true,
+					Objects:          2,
+					EstimatedBytes:   2048,
+					LastAccess:       lastAccess,
+				},
+			},
+			Evictions:  3,
+			Factories:  1,
+			IdleTTL:    10 * time.Minute,
+			MaxObjects: 100,
+		},
+	}
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+	dashConfig.EXPECT().ContextName().Return("test-context")
+
+	d := NewDiagnosticsDescriber()
+
+	response, err := d.Describe(context.Background(), "default", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	summary := component.NewSummary("Informers",
+		component.SummarySection{Header: "Idle TTL", Content: component.NewText("10m0s")},
+		component.SummarySection{Header: "Object Budget", Content: component.NewText("100")},
+		component.SummarySection{Header: "Memory Budget", Content: component.NewText("Unlimited")},
+		component.SummarySection{Header: "Informers", Content: component.NewText("1")},
+		component.SummarySection{Header: "Informer Factories", Content: component.NewText("1")},
+		component.SummarySection{Header: "Cached Objects", Content: component.NewText("2")},
+		component.SummarySection{Header: "Estimated Memory", Content: component.NewText("2Ki")},
+		component.SummarySection{Header: "Evictions", Content: component.NewText("3")},
+	)
+
+	cols := component.NewTableCols("Resource", "Namespaces", "Cache", "Watched", "Objects", "Estimated Memory", "Last Access")
+	table := component.NewTable("Informers", "There are no informers!", cols)
+	table.Add(component.TableRow{
+		"Resource":         component.NewText("/v1, Kind=Secret"),
+		"Namespaces":       component.NewText("(all), default"),
+		"Cache":            component.NewText("Metadata"),
+		"Watched":          component.NewText("Yes"),
+		"Objects":          component.NewText("2"),
+		"Estimated Memory": component.NewText("2Ki"),
+		"Last Access":      component.NewTimestamp(lastAccess),
+	})
+
+	list := component.NewList(append([]component.TitleComponent{}, component.NewText("Diagnostics")), nil)
+	list.Add(summary, table, snapshotCard("test-context"))
+
+	require.Len(t, response.Components, 1)
+	component.AssertEqual(t, list, response.Components[0])
+}
+
+type statsStore struct {
+	*storeFake.MockStore
+	stats objectstore.InformerStats
+}
+
+var _ objectstore.InformerStatsProvider = (*statsStore)(nil)
+
+func (s *statsStore) InformerStats() objectstore.InformerStats {
+	return s.stats
+}
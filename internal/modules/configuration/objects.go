@@ -5,17 +5,28 @@ SPDX-License-Identifier: Apache-2.0
 
 package configuration
 
-import "github.com/vmware-tanzu/octant/internal/describer"
+import (
+	"github.com/spf13/afero"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/templates"
+)
 
 var (
 	pluginDescriber = NewPluginListDescriber()
 
 	applyYamlDescriber = NewApplyYamlDescriber()
 
+	createDescriber = NewCreateDescriber(templates.NewLoader(afero.NewOsFs(), templates.DefaultDir))
+
+	diagnosticsDescriber = NewDiagnosticsDescriber()
+
 	rootDescriber = describer.NewSection(
 		"/",
 		"Configuration",
 		pluginDescriber,
 		applyYamlDescriber,
+		createDescriber,
+		diagnosticsDescriber,
 	)
 )
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+const pluginNameField = "pluginName"
+
+// PluginConfigurationUpdater updates the configuration of a plugin.
+type PluginConfigurationUpdater struct {
+	logger        log.Logger
+	pluginManager plugin.ManagerInterface
+}
+
+var _ action.Dispatcher = (*PluginConfigurationUpdater)(nil)
+
+// NewPluginConfigurationUpdater creates an instance of PluginConfigurationUpdater.
+func NewPluginConfigurationUpdater(logger log.Logger, pluginManager plugin.ManagerInterface) *PluginConfigurationUpdater {
+	return &PluginConfigurationUpdater{
+		logger:        logger.With("action", octant.ActionUpdatePluginConfig),
+		pluginManager: pluginManager,
+	}
+}
+
+// ActionName returns the name of the action.
+func (u *PluginConfigurationUpdater) ActionName() string {
+	return octant.ActionUpdatePluginConfig
+}
+
+// Handle updates the configuration of the plugin named in the payload. Every
+// other string field in the payload is a configuration value.
+func (u *PluginConfigurationUpdater) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	u.logger.With("payload", payload).Debugf("updating plugin configuration")
+
+	pluginName, err := payload.String(pluginNameField)
+	if err != nil {
+		return err
+	}
+
+	configuration := plugin.Configuration{}
+	for k, v := range payload {
+		if k == pluginNameField || k == "action" {
+			continue
+		}
+
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		configuration[k] = s
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Updated configuration for plugin %q", plugin.ConfigurationKey(pluginName))
+	if err := u.pluginManager.UpdateConfiguration(ctx, pluginName, configuration); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update configuration for plugin %q: %s", plugin.ConfigurationKey(pluginName), err)
+	}
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
+)
+
+func TestPluginConfigurationUpdater_ActionName(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+
+	u := NewPluginConfigurationUpdater(log.NopLogger(), pluginManager)
+	require.Equal(t, octant.ActionUpdatePluginConfig, u.ActionName())
+}
+
+func TestPluginConfigurationUpdater_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	pluginManager.EXPECT().
+		UpdateConfiguration(gomock.Any(), "plugin-test", plugin.Configuration{"endpoint": "https://example.com"}).
+		Return(nil)
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Updated configuration for plugin "plugin-test"`, alert.Message)
+		})
+
+	u := NewPluginConfigurationUpdater(log.NopLogger(), pluginManager)
+
+	payload := action.Payload{
+		"action":     octant.ActionUpdatePluginConfig,
+		"pluginName": "plugin-test",
+		"endpoint":   "https://example.com",
+	}
+
+	ctx := context.Background()
+	require.NoError(t, u.Handle(ctx, alerter, payload))
+}
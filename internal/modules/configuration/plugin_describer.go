@@ -8,11 +8,11 @@ package configuration
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
-	"k8s.io/apimachinery/pkg/runtime/schema"
-
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -25,21 +25,28 @@ var _ describer.Describer = (*PluginListDescriber)(nil)
 
 // Describe describes a list of plugins
 func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
-	pluginStore := options.PluginManager().Store()
+	pluginManager := options.PluginManager()
+	pluginStore := pluginManager.Store()
 	title := append([]component.TitleComponent{}, component.NewText("Plugins"))
 	list := component.NewList(title, nil)
 	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
 	tbl := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	list.Add(tbl)
 
+	type settingsCard struct {
+		name string
+		card *component.Card
+	}
+	var settingsCards []settingsCard
+
 	for _, n := range pluginStore.ClientNames() {
 		var metadata *plugin.Metadata
-		if plugin.IsJavaScriptPlugin(n) {
-			jsPlugin, ok := pluginStore.GetJS(n)
+		if plugin.IsInProcessPlugin(n) {
+			inProcessPlugin, ok := pluginStore.GetInProcess(n)
 			if !ok {
 				return component.EmptyContentResponse, fmt.Errorf("plugin %s not found", n)
 			}
-			metadata = jsPlugin.Metadata()
+			metadata = inProcessPlugin.Metadata()
 		} else {
 			var err error
 			metadata, err = pluginStore.GetMetadata(n)
@@ -48,33 +55,7 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 			}
 		}
 
-		var summaryItems []string
-		if metadata.Capabilities.IsModule {
-			summaryItems = append(summaryItems, "Module")
-		}
-
-		if actionNames := metadata.Capabilities.ActionNames; len(actionNames) > 0 {
-			summaryItems = append(summaryItems, fmt.Sprintf("Actions: %s",
-				strings.Join(actionNames, ", ")))
-		}
-
-		in := []struct {
-			name string
-			list []schema.GroupVersionKind
-		}{
-			{name: "Object Status", list: metadata.Capabilities.SupportsObjectStatus},
-			{name: "Printer Config", list: metadata.Capabilities.SupportsPrinterConfig},
-			{name: "Printer Items", list: metadata.Capabilities.SupportsPrinterItems},
-			{name: "Printer Status", list: metadata.Capabilities.SupportsPrinterStatus},
-			{name: "Tab", list: metadata.Capabilities.SupportsTab},
-		}
-
-		for _, item := range in {
-			support, ok := summarizeSupports(item.name, item.list)
-			if ok {
-				summaryItems = append(summaryItems, support)
-			}
-		}
+		summaryItems := metadata.Capabilities.Summary()
 
 		var sb strings.Builder
 		for i := range summaryItems {
@@ -90,10 +71,21 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 			"Capabilities": component.NewText(sb.String()),
 		}
 		tbl.Add(row)
+
+		card := pluginSettingsCard(n, metadata.Name, pluginManager.Configuration(n))
+		settingsCards = append(settingsCards, settingsCard{name: metadata.Name, card: card})
 	}
 
 	tbl.Sort("Name", false)
 
+	sort.Slice(settingsCards, func(i, j int) bool {
+		return settingsCards[i].name < settingsCards[j].name
+	})
+
+	for _, sc := range settingsCards {
+		list.Add(sc.card)
+	}
+
 	return component.ContentResponse{
 		Components: []component.Component{list},
 	}, nil
@@ -112,18 +104,40 @@ func NewPluginListDescriber() *PluginListDescriber {
 	return &PluginListDescriber{}
 }
 
-func summarizeSupports(name string, list []schema.GroupVersionKind) (string, bool) {
-	if len(list) < 1 {
-		return "", false
+// pluginSettingsCard creates a card that shows a plugin's configuration with a form
+// for updating it.
+func pluginSettingsCard(pluginName, displayName string, configuration plugin.Configuration) *component.Card {
+	card := component.NewCard(component.TitleFromString(fmt.Sprintf("%s Settings", displayName)))
+
+	if len(configuration) == 0 {
+		card.SetBody(component.NewText(fmt.Sprintf("There are no settings. Add settings for %q to the plugins section of Octant's configuration file.",
+			plugin.ConfigurationKey(pluginName))))
+		return card
 	}
 
-	var items []string
-	for _, groupVersionKind := range list {
-		apiVersion, kind := groupVersionKind.ToAPIVersionAndKind()
-		items = append(items, fmt.Sprintf("%s %s", apiVersion, kind))
+	tbl := component.NewTable("Settings", "There are no settings!", component.NewTableCols("Name", "Value"))
+
+	var fields []component.FormField
+	for _, name := range configuration.Names() {
+		value := configuration[name]
+		tbl.Add(component.TableRow{
+			"Name":  component.NewText(name),
+			"Value": component.NewText(value),
+		})
+		fields = append(fields, component.NewFormFieldText(name, name, value))
 	}
 
-	return fmt.Sprintf("%s: %s",
-		name, strings.Join(items, ", "),
-	), true
+	fields = append(fields,
+		component.NewFormFieldHidden(pluginNameField, pluginName),
+		component.NewFormFieldHidden("action", octant.ActionUpdatePluginConfig),
+	)
+
+	card.SetBody(tbl)
+	card.AddAction(component.Action{
+		Name:  "Edit",
+		Title: fmt.Sprintf("%s Settings", displayName),
+		Form:  component.Form{Fields: fields},
+	})
+
+	return card
 }
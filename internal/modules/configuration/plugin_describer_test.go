@@ -17,6 +17,7 @@ import (
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	dashPlugin "github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
 	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
@@ -49,6 +50,9 @@ func TestPluginDescriber(t *testing.T) {
 
 	pluginManager := pluginFake.NewMockManagerInterface(controller)
 	pluginManager.EXPECT().Store().Return(store).AnyTimes()
+	pluginManager.EXPECT().
+		Configuration(name).
+		Return(dashPlugin.Configuration{"endpoint": "https://example.com"})
 
 	dashConfig := configFake.NewMockDash(controller)
 	dashConfig.EXPECT().PluginManager().Return(pluginManager)
@@ -76,6 +80,27 @@ func TestPluginDescriber(t *testing.T) {
 
 	list.Add(table)
 
+	settingsTable := component.NewTable("Settings", "There are no settings!", component.NewTableCols("Name", "Value"))
+	settingsTable.Add(component.TableRow{
+		"Name":  component.NewText("endpoint"),
+		"Value": component.NewText("https://example.com"),
+	})
+
+	card := component.NewCard(component.TitleFromString("plugin-test Settings"))
+	card.SetBody(settingsTable)
+	card.AddAction(component.Action{
+		Name:  "Edit",
+		Title: "plugin-test Settings",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldText("endpoint", "endpoint", "https://example.com"),
+				component.NewFormFieldHidden("pluginName", name),
+				component.NewFormFieldHidden("action", octant.ActionUpdatePluginConfig),
+			},
+		},
+	})
+	list.Add(card)
+
 	require.Len(t, cResponse.Components, 1)
 	component.AssertEqual(t, list, cResponse.Components[0])
 }
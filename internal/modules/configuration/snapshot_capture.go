@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/snapshot"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	snapshotNamespacesField    = "namespaces"
+	snapshotIncludeLogsField   = "includeLogs"
+	snapshotRevealSecretsField = "revealSecrets"
+	snapshotPathField          = "path"
+)
+
+type captureFileFunc func(ctx context.Context, client cluster.ClientInterface, objectStore store.Store, path string, options snapshot.CaptureOptions) (*snapshot.Manifest, error)
+
+// SnapshotCapturer captures snapshots of the current context.
+type SnapshotCapturer struct {
+	logger      log.Logger
+	dashConfig  config.Dash
+	captureFile captureFileFunc
+	now         func() time.Time
+}
+
+var _ action.Dispatcher = (*SnapshotCapturer)(nil)
+
+// NewSnapshotCapturer creates an instance of SnapshotCapturer.
+func NewSnapshotCapturer(logger log.Logger, dashConfig config.Dash) *SnapshotCapturer {
+	return &SnapshotCapturer{
+		logger:      logger.With("action", octant.ActionCaptureSnapshot),
+		dashConfig:  dashConfig,
+		captureFile: snapshot.CaptureFile,
+		now:         time.Now,
+	}
+}
+
+// ActionName returns the name of the action.
+func (s *SnapshotCapturer) ActionName() string {
+	return octant.ActionCaptureSnapshot
+}
+
+// Handle starts capturing a snapshot. Captures can take a while for large
+// clusters, so an alert is sent when the capture starts and when it finishes.
+func (s *SnapshotCapturer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	s.logger.With("payload", payload).Debugf("capturing snapshot")
+
+	namespacesList, err := payload.OptionalString(snapshotNamespacesField)
+	if err != nil {
+		return err
+	}
+
+	path, err := payload.OptionalString(snapshotPathField)
+	if err != nil {
+		return err
+	}
+
+	contextName := s.dashConfig.ContextName()
+	now := s.now()
+	if path == "" {
+		path = snapshot.FileName(contextName, now)
+	}
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+
+	version, _, _ := s.dashConfig.BuildInfo()
+
+	options := snapshot.CaptureOptions{
+		Namespaces:    splitNamespaces(namespacesList),
+		IncludeLogs:   optionalBool(payload, snapshotIncludeLogsField),
+		RevealSecrets: optionalBool(payload, snapshotRevealSecretsField),
+		OctantVersion: version,
+		Context:       contextName,
+		Now:           func() time.Time { return now },
+	}
+
+	client := s.dashConfig.ClusterClient()
+	objectStore := s.dashConfig.ObjectStore()
+
+	message := fmt.Sprintf("Capturing snapshot of %s to %s", contextName, path)
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+
+	go func() {
+		// the capture outlives the request, so it is not canceled with the request's context.
+		manifest, err := s.captureFile(context.Background(), client, objectStore, path, options)
+		if err != nil {
+			s.logger.WithErr(err).Errorf("capture snapshot")
+			message := fmt.Sprintf("Unable to capture snapshot of %s: %s", contextName, err)
+			alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+			return
+		}
+
+		alertType := action.AlertTypeSuccess
+		message := fmt.Sprintf("Captured snapshot of %s to %s", contextName, path)
+		if len(manifest.Errors) > 0 {
+			for _, captureErr := range manifest.Errors {
+				s.logger.Warnf("capture snapshot: %s", captureErr)
+			}
+			alertType = action.AlertTypeWarning
+			message = fmt.Sprintf("%s with %d errors. They are listed in the snapshot's manifest.", message, len(manifest.Errors))
+		}
+		alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	}()
+
+	return nil
+}
+
+// splitNamespaces splits a comma or space separated list of namespaces. It
+// returns nil, i.e. all namespaces, if the list is empty.
+func splitNamespaces(s string) []string {
+	namespaces := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(namespaces) == 0 {
+		return nil
+	}
+	return namespaces
+}
+
+// snapshotCard returns a card with an action which captures a snapshot of the current context.
+func snapshotCard(contextName string) *component.Card {
+	card := component.NewCard(component.TitleFromString("Snapshot"))
+	card.SetBody(component.NewText(fmt.Sprintf(
+		"Capture the objects in %s to a tarball which can be browsed with octant --snapshot. Secret data is redacted unless it is revealed.",
+		contextName)))
+
+	card.AddAction(component.Action{
+		Name:  "Capture",
+		Title: "Capture Snapshot",
+		Form: component.Form{Fields: []component.FormField{
+			component.NewFormFieldText("Namespaces (comma separated, all if empty)", snapshotNamespacesField, ""),
+			component.NewFormFieldCheckBox("Logs", snapshotIncludeLogsField, []component.InputChoice{
+				{Label: "Include recent container logs", Value: "true"},
+			}),
+			component.NewFormFieldCheckBox("Secrets", snapshotRevealSecretsField, []component.InputChoice{
+				{Label: "Reveal secret data", Value: "true"},
+			}),
+			component.NewFormFieldText("Path (defaults to the working directory)", snapshotPathField, ""),
+			component.NewFormFieldHidden("action", octant.ActionCaptureSnapshot),
+		}},
+	})
+
+	return card
+}
+
+// optionalBool returns a boolean from the payload. Unchecked checkboxes are sent
+// as empty lists or are not sent at all, so both are false.
+func optionalBool(payload action.Payload, key string) bool {
+	b, err := payload.Bool(key)
+	if err != nil {
+		return false
+	}
+	return b
+}
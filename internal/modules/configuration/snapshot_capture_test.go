@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/snapshot"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestSnapshotCapturer_Handle(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	defaultPath, err := filepath.Abs(snapshot.FileName("test-context", now))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		payload           action.Payload
+		captureErr        error
+		manifestErrors    []string
+		expectedPath      string
+		expectedOptions   snapshot.CaptureOptions
+		expectedAlertType action.AlertType
+	}{
+		{
+			name:         "defaults",
+			payload:      action.CreatePayload(octant.ActionCaptureSnapshot, nil),
+			expectedPath: defaultPath,
+			expectedOptions: snapshot.CaptureOptions{
+				OctantVersion: "v0.13.0",
+				Context:       "test-context",
+			},
+			expectedAlertType: action.AlertTypeSuccess,
+		},
+		{
+			name: "form",
+			payload: action.CreatePayload(octant.ActionCaptureSnapshot, map[string]interface{}{
+				snapshotNamespacesField:    "default, kube-system",
+				snapshotIncludeLogsField:   []interface{}{"true"},
+				snapshotRevealSecretsField: []interface{}{},
+				snapshotPathField:          "/tmp/snapshot.tar.gz",
+			}),
+			expectedPath: "/tmp/snapshot.tar.gz",
+			expectedOptions: snapshot.CaptureOptions{
+				Namespaces:    []string{"default", "kube-system"},
+				IncludeLogs:   true,
+				OctantVersion: "v0.13.0",
+				Context:       "test-context",
+			},
+			expectedAlertType: action.AlertTypeSuccess,
+		},
+		{
+			name:           "capture errors",
+			payload:        action.CreatePayload(octant.ActionCaptureSnapshot, nil),
+			manifestErrors: []string{"list widgets: forbidden"},
+			expectedPath:   defaultPath,
+			expectedOptions: snapshot.CaptureOptions{
+				OctantVersion: "v0.13.0",
+				Context:       "test-context",
+			},
+			expectedAlertType: action.AlertTypeWarning,
+		},
+		{
+			name:         "failed",
+			payload:      action.CreatePayload(octant.ActionCaptureSnapshot, nil),
+			captureErr:   fmt.Errorf("failed"),
+			expectedPath: defaultPath,
+			expectedOptions: snapshot.CaptureOptions{
+				OctantVersion: "v0.13.0",
+				Context:       "test-context",
+			},
+			expectedAlertType: action.AlertTypeError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			client := clusterFake.NewMockClientInterface(controller)
+			objectStore := storeFake.NewMockStore(controller)
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ContextName().Return("test-context")
+			dashConfig.EXPECT().BuildInfo().Return("v0.13.0", "", "")
+			dashConfig.EXPECT().ClusterClient().Return(client)
+			dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+			done := make(chan action.Alert, 1)
+			alerter := actionFake.NewMockAlerter(controller)
+			gomock.InOrder(
+				alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+				}),
+				alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+					done <- alert
+				}),
+			)
+
+			capturer := NewSnapshotCapturer(log.NopLogger(), dashConfig)
+			capturer.now = func() time.Time { return now }
+			capturer.captureFile = func(ctx context.Context, gotClient cluster.ClientInterface, gotStore store.Store, path string, options snapshot.CaptureOptions) (*snapshot.Manifest, error) {
+				assert.Equal(t, client, gotClient)
+				assert.Equal(t, objectStore, gotStore)
+				assert.Equal(t, test.expectedPath, path)
+				assert.Equal(t, now, options.Now())
+
+				options.Now = nil
+				assert.Equal(t, test.expectedOptions, options)
+
+				if test.captureErr != nil {
+					return nil, test.captureErr
+				}
+				return &snapshot.Manifest{Errors: test.manifestErrors}, nil
+			}
+
+			require.NoError(t, capturer.Handle(context.Background(), alerter, test.payload))
+
+			select {
+			case alert := <-done:
+				assert.Equal(t, test.expectedAlertType, alert.Type)
+			case <-time.After(wait.ForeverTestTimeout):
+				t.Fatal("capture did not finish")
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ package overview
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -18,6 +19,7 @@ import (
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/printer"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/icon"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -29,6 +31,9 @@ import (
 type Options struct {
 	Namespace  string
 	DashConfig config.Dash
+	// CertificateExpiryWindow is how long before a certificate expires it is
+	// shown as expiring.
+	CertificateExpiryWindow time.Duration
 }
 
 // Overview is an API for generating a cluster overview.
@@ -41,6 +46,14 @@ type Overview struct {
 	pathMatcher *describer.PathMatcher
 	logger      log.Logger
 
+	// secretReveals are the secret values revealed by each client.
+	secretReveals *octant.SecretReveals
+	// configMapChanges are the config maps changed by each client.
+	configMapChanges *octant.ConfigMapChanges
+	// certificateExpiryWindow is how long before a certificate expires it is
+	// shown as expiring.
+	certificateExpiryWindow time.Duration
+
 	watchedCRDs []*unstructured.Unstructured
 
 	mu sync.Mutex
@@ -48,6 +61,7 @@ type Overview struct {
 
 var _ module.Module = (*Overview)(nil)
 var _ module.ActionReceiver = (*Overview)(nil)
+var _ module.ClientStateHolder = (*Overview)(nil)
 
 // New creates an instance of Overview.
 func New(ctx context.Context, options Options) (*Overview, error) {
@@ -60,8 +74,12 @@ func New(ctx context.Context, options Options) (*Overview, error) {
 	}
 
 	co := &Overview{
-		dashConfig: options.DashConfig,
-		logger:     options.DashConfig.Logger().With("module", "overview"),
+		dashConfig:       options.DashConfig,
+		logger:           options.DashConfig.Logger().With("module", "overview"),
+		secretReveals:    octant.NewSecretReveals(),
+		configMapChanges: octant.NewConfigMapChanges(),
+
+		certificateExpiryWindow: options.CertificateExpiryWindow,
 	}
 
 	if err := co.bootstrap(ctx); err != nil {
@@ -109,7 +127,10 @@ func (co *Overview) bootstrap(ctx context.Context) error {
 		pathMatcher.Register(ctx, pf)
 	}
 
-	g, err := generator.NewGenerator(pathMatcher, co.dashConfig)
+	g, err := generator.NewGenerator(pathMatcher, co.dashConfig,
+		printer.WithSecretReveals(co.secretReveals),
+		printer.WithConfigMapChanges(co.configMapChanges),
+		printer.WithCertificateExpiryWindow(co.certificateExpiryWindow))
 	if err != nil {
 		return errors.Wrap(err, "create overview generator")
 	}
@@ -260,15 +281,31 @@ func (co *Overview) Stop() {
 func (co *Overview) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
 	ctx = internalLog.WithLoggerContext(ctx, co.dashConfig.Logger())
 	genOpts := generator.Options{
-		LabelSet: opts.LabelSet,
+		LabelSet:                opts.LabelSet,
+		CertificateExpiryWindow: co.certificateExpiryWindow,
 	}
 	return co.generator.Generate(ctx, contentPath, genOpts)
 }
 
+// RemoveClient forgets the secret values revealed and the config maps changed
+// by a client which disconnected.
+func (co *Overview) RemoveClient(clientID string) {
+	co.secretReveals.RemoveClient(clientID)
+	co.configMapChanges.RemoveClient(clientID)
+}
+
 // ActionPaths contain the actions this module is responsible for.
 func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
 	dispatchers := action.Dispatchers{
 		octant.NewDeploymentConfigurationEditor(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewDeploymentRestart(co.dashConfig.ObjectStore()),
+		octant.NewDeploymentPause(co.dashConfig.ObjectStore()),
+		octant.NewDeploymentResume(co.dashConfig.ObjectStore()),
+		octant.NewDeploymentRollback(co.dashConfig.ObjectStore()),
+		octant.NewRolloutRestart(co.dashConfig.ObjectStore()),
+		octant.NewStatefulSetPartition(co.dashConfig.ObjectStore()),
+		octant.NewControllerRevisionRollback(co.dashConfig.ObjectStore()),
+		octant.NewScale(co.dashConfig.ClusterClient()),
 		octant.NewContainerEditor(co.dashConfig.ObjectStore()),
 		octant.NewServiceConfigurationEditor(co.dashConfig.ObjectStore()),
 		octant.NewPortForward(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.PortForwarder()),
@@ -278,8 +315,17 @@ func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
 		octant.NewCronJobTrigger(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobSuspend(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobResume(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
-		octant.NewObjectUpdaterDispatcher(co.dashConfig.ObjectStore()),
-		octant.NewApplyYaml(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewObjectUpdaterDispatcher(co.dashConfig.ObjectStore(),
+			octant.WithObjectUpdaterValidator(octant.NewSchemaValidator(co.dashConfig.ClusterClient()))),
+		octant.NewApplyYaml(co.logger, co.dashConfig.ObjectStore(),
+			octant.WithApplyYamlValidator(octant.NewSchemaValidator(co.dashConfig.ClusterClient()))),
+		octant.NewSchemaFormEditor(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
+		octant.NewSecretRevealer(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.ContextName, co.secretReveals),
+		octant.NewSecretDataEditor(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewSecretDataDeleter(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewConfigMapDataEditor(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.ContextName, co.configMapChanges),
+		octant.NewConfigMapDataDeleter(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.ContextName, co.configMapChanges),
+		octant.NewConfigMapConsumerRestart(co.dashConfig.ObjectStore(), co.dashConfig.ContextName, co.configMapChanges),
 	}
 
 	return dispatchers.ToActionPaths()
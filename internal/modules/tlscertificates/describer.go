@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tlscertificates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/certificates"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/link"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Describer describes the certificates of a cluster.
+type Describer struct {
+	now    func() time.Time
+	window time.Duration
+}
+
+var _ describer.Describer = (*Describer)(nil)
+
+// NewDescriber creates an instance of Describer. Certificates which expire
+// within window are flagged. certificates.DefaultExpiryWindow is used if window
+// is not positive.
+func NewDescriber(window time.Duration) *Describer {
+	if window <= 0 {
+		window = certificates.DefaultExpiryWindow
+	}
+
+	return &Describer{
+		now:    time.Now,
+		window: window,
+	}
+}
+
+// Describe lists the certificates of the cluster by expiry.
+func (d *Describer) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	report, err := Collect(ctx, options.ObjectStore(), options.ClusterClient())
+	if err != nil {
+		return component.EmptyContentResponse, fmt.Errorf("collect certificates: %w", err)
+	}
+
+	now := d.now()
+
+	title := component.TitleFromString("TLS Certificates")
+	list := component.NewList(title, nil)
+
+	list.Add(component.NewText(fmt.Sprintf("Certificates which expire within %s are flagged.",
+		windowText(d.window))))
+
+	cols := component.NewTableCols("Status", "Not After", "Subject", "Issuer", "Subject Alternative Names", "Source", "Consumers")
+	table := component.NewTable("Certificates", "There are no certificates", cols)
+
+	for _, entry := range report.Entries {
+		row := component.TableRow{
+			"Status":                    certificateStatusText(entry.Certificate, now, d.window),
+			"Not After":                 component.NewTimestamp(entry.Certificate.NotAfter),
+			"Subject":                   component.NewText(entry.Certificate.Subject),
+			"Issuer":                    component.NewText(entry.Certificate.Issuer),
+			"Subject Alternative Names": component.NewText(strings.Join(entry.Certificate.SubjectAlternativeNames(), ", ")),
+			"Source":                    sourceText(options.Link, entry),
+			"Consumers":                 consumersText(options.Link, entry.Consumers),
+		}
+		table.Add(row)
+	}
+
+	list.Add(table)
+
+	if len(report.Errors) > 0 {
+		list.Add(component.NewText(strings.Join(report.Errors, "\n")))
+	}
+
+	return component.ContentResponse{
+		Title:      title,
+		Components: []component.Component{list},
+	}, nil
+}
+
+// PathFilters returns a path filter for the root path.
+func (d *Describer) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter("/", d),
+	}
+}
+
+// Reset is a no-op.
+func (d *Describer) Reset(ctx context.Context) error {
+	return nil
+}
+
+func certificateStatusText(cert certificates.Certificate, now time.Time, window time.Duration) *component.Text {
+	text := component.NewText(cert.Message(now))
+	switch cert.Status(now, window) {
+	case certificates.StatusValid:
+		text.SetStatus(component.TextStatusOK)
+	case certificates.StatusExpiring:
+		text.SetStatus(component.TextStatusWarning)
+	default:
+		text.SetStatus(component.TextStatusError)
+	}
+	return text
+}
+
+func sourceText(linker link.Interface, entry Entry) *component.Text {
+	return component.NewMarkdownText(fmt.Sprintf("%s %s", keyMarkdown(linker, entry.Source), entry.Field))
+}
+
+func consumersText(linker link.Interface, consumers []Consumer) *component.Text {
+	var lines []string
+	for _, consumer := range consumers {
+		lines = append(lines, fmt.Sprintf("%s %s", keyMarkdown(linker, consumer.Key), consumer.Detail))
+	}
+	return component.NewMarkdownText(strings.Join(lines, "\n\n"))
+}
+
+// keyMarkdown links to an object, or names it if it can't be linked to.
+func keyMarkdown(linker link.Interface, key store.Key) string {
+	name := fmt.Sprintf("%s %s", key.Kind, key.Name)
+	if key.Namespace != "" {
+		name = fmt.Sprintf("%s %s/%s", key.Kind, key.Namespace, key.Name)
+	}
+
+	if linker == nil {
+		return name
+	}
+
+	l, err := linker.ForGVK(key.Namespace, key.APIVersion, key.Kind, key.Name, name)
+	if err != nil || l.Ref() == "" {
+		return name
+	}
+	return fmt.Sprintf("[%s](%s)", name, l.Ref())
+}
+
+// windowText formats the expiry window in days.
+func windowText(window time.Duration) string {
+	days := int(window / (24 * time.Hour))
+	if days == 1 {
+		return "1 day"
+	}
+	if days > 1 {
+		return fmt.Sprintf("%d days", days)
+	}
+	return window.String()
+}
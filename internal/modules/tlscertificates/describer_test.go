@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tlscertificates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/certificates"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	mockCertificateSources(t, objectStore, clusterClient)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+
+	linker := linkFake.NewMockInterface(controller)
+	linker.EXPECT().
+		ForGVK(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(namespace, apiVersion, kind, name, text string) (*component.Link, error) {
+			return component.NewLink("", text, "/"+kind+"/"+name), nil
+		}).
+		AnyTimes()
+
+	d := NewDescriber(certificates.DefaultExpiryWindow)
+	d.now = func() time.Time { return time.Date(2020, 3, 22, 0, 0, 0, 0, time.UTC) }
+
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{
+		Dash: dashConfig,
+		Link: linker,
+	})
+	require.NoError(t, err)
+
+	title := component.TitleFromString("TLS Certificates")
+	expected := component.NewList(title, nil)
+	expected.Add(component.NewText("Certificates which expire within 30 days are flagged."))
+
+	cols := component.NewTableCols("Status", "Not After", "Subject", "Issuer", "Subject Alternative Names", "Source", "Consumers")
+	table := component.NewTable("Certificates", "There are no certificates", cols)
+
+	expiring := component.NewText("expires in 10 days")
+	expiring.SetStatus(component.TextStatusWarning)
+	notYetValid := component.NewText("not valid until 2029-01-01T00:00:00Z")
+	notYetValid.SetStatus(component.TextStatusError)
+	notYetValidCA := component.NewText("not valid until 2028-01-01T00:00:00Z")
+	notYetValidCA.SetStatus(component.TextStatusError)
+
+	table.Add(
+		component.TableRow{
+			"Status":                    expiring,
+			"Not After":                 component.NewTimestamp(time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)),
+			"Subject":                   component.NewText("CN=example.com"),
+			"Issuer":                    component.NewText("Issuer letsencrypt"),
+			"Subject Alternative Names": component.NewText(""),
+			"Source":                    component.NewMarkdownText("[Certificate default/web](/Certificate/web) status.notAfter"),
+			"Consumers": component.NewMarkdownText("[Secret default/web-tls](/Secret/web-tls) spec.secretName\n\n" +
+				"[Ingress default/web](/Ingress/web) tls.secretName"),
+		},
+		component.TableRow{
+			"Status":                    notYetValid,
+			"Not After":                 component.NewTimestamp(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+			"Subject":                   component.NewText("CN=example.com"),
+			"Issuer":                    component.NewText("CN=Test CA"),
+			"Subject Alternative Names": component.NewText("example.com, www.example.com, 10.0.0.1"),
+			"Source":                    component.NewMarkdownText("[Secret default/web-tls](/Secret/web-tls) tls.crt"),
+			"Consumers":                 component.NewMarkdownText("[Ingress default/web](/Ingress/web) tls.secretName"),
+		},
+		component.TableRow{
+			"Status":                    notYetValidCA,
+			"Not After":                 component.NewTimestamp(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+			"Subject":                   component.NewText("CN=Test CA"),
+			"Issuer":                    component.NewText("CN=Test CA"),
+			"Subject Alternative Names": component.NewText(""),
+			"Source":                    component.NewMarkdownText("[ValidatingWebhookConfiguration validator](/ValidatingWebhookConfiguration/validator) webhook validate.example.com caBundle"),
+			"Consumers":                 component.NewMarkdownText("[ValidatingWebhookConfiguration validator](/ValidatingWebhookConfiguration/validator) webhook validate.example.com"),
+		},
+	)
+
+	expected.Add(table)
+	expected.Add(component.NewText("Unable to list APIService: forbidden"))
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, expected, cResponse.Components[0])
+}
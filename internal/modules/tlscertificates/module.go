@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tlscertificates
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/generator"
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/pkg/icon"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Options for configuring Module.
+type Options struct {
+	DashConfig config.Dash
+	// ExpiryWindow is how long before a certificate expires it is flagged.
+	// certificates.DefaultExpiryWindow is used if it is not positive.
+	ExpiryWindow time.Duration
+}
+
+// Module contains the implementation for the TLS certificates module.
+type Module struct {
+	Options
+	pathMatcher *describer.PathMatcher
+}
+
+var _ module.Module = (*Module)(nil)
+
+// New creates an instance of Module.
+func New(ctx context.Context, options Options) (*Module, error) {
+	pm := describer.NewPathMatcher("tls-certificates")
+
+	for _, pf := range NewDescriber(options.ExpiryWindow).PathFilters() {
+		pm.Register(ctx, pf)
+	}
+
+	m := &Module{
+		Options:     options,
+		pathMatcher: pm,
+	}
+
+	return m, nil
+}
+
+// Name returns the module name.
+func (m *Module) Name() string {
+	return "tls-certificates"
+}
+
+// ClientRequestHandlers returns nil.
+func (m *Module) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content handles content for the module.
+func (m *Module) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+	g, err := generator.NewGenerator(m.pathMatcher, m.DashConfig,
+		printer.WithCertificateExpiryWindow(m.ExpiryWindow))
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	return g.Generate(ctx, contentPath, generator.Options{})
+}
+
+// ContentPath returns the content path for this module.
+func (m *Module) ContentPath() string {
+	return m.Name()
+}
+
+// Navigation returns navigation entries for the module.
+func (m *Module) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	rootNav := navigation.Navigation{
+		Title:    "TLS Certificates",
+		Path:     m.ContentPath(),
+		IconName: icon.TLSCertificates,
+	}
+
+	return []navigation.Navigation{rootNav}, nil
+}
+
+// SetNamespace is a no-op.
+func (m Module) SetNamespace(namespace string) error {
+	return nil
+}
+
+// Start is a no-op.
+func (m Module) Start() error {
+	return nil
+}
+
+// Stop is a no-op.
+func (m Module) Stop() {
+}
+
+// SetContext is a no-op.
+func (m Module) SetContext(ctx context.Context, contextName string) error {
+	return nil
+}
+
+// Generators returns nil.
+func (m Module) Generators() []octant.Generator {
+	return nil
+}
+
+// SupportedGroupVersionKind returns nil.
+func (m Module) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return nil
+}
+
+// GroupVersionKindPath returns an error as this module does not support it.
+func (m Module) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", fmt.Errorf("not supported")
+}
+
+// AddCRD is a no-op.
+func (m Module) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD is a no-op.
+func (m Module) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs is a no-op.
+func (m Module) ResetCRDs(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,264 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tlscertificates
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/certificates"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// secretPageSize is the number of TLS secrets listed at a time.
+const secretPageSize = 250
+
+// Entry is a certificate in the report.
+type Entry struct {
+	Certificate certificates.Certificate
+	// Source is the object the certificate is stored in.
+	Source store.Key
+	// Field is where the certificate is stored in its source.
+	Field string
+	// Consumers are the objects which use the certificate.
+	Consumers []Consumer
+}
+
+// Consumer is an object which uses a certificate.
+type Consumer struct {
+	Key store.Key
+	// Detail describes how the object uses the certificate, e.g. the name of
+	// a webhook.
+	Detail string
+}
+
+// Report lists the certificates of a cluster.
+type Report struct {
+	// Entries are sorted by expiry.
+	Entries []Entry
+	// Errors are sources which could not be read.
+	Errors []string
+}
+
+// Collect scans TLS secrets, webhook configurations, APIServices, and
+// cert-manager certificates if cert-manager is installed.
+func Collect(ctx context.Context, objectStore store.Store, clusterClient cluster.ClientInterface) (*Report, error) {
+	if objectStore == nil {
+		return nil, fmt.Errorf("object store is nil")
+	}
+
+	c := collector{
+		report:         &Report{},
+		objectStore:    objectStore,
+		ingressSecrets: map[store.Key][]Consumer{},
+	}
+
+	c.collectIngresses(ctx)
+	c.collectSecrets(ctx)
+	c.collectWebhooks(ctx, gvk.MutatingWebhookConfiguration)
+	c.collectWebhooks(ctx, gvk.ValidatingWebhookConfiguration)
+	c.collectAPIServices(ctx)
+	if clusterClient != nil {
+		c.collectCertManager(ctx, clusterClient)
+	}
+
+	sort.SliceStable(c.report.Entries, func(i, j int) bool {
+		a, b := c.report.Entries[i], c.report.Entries[j]
+		if !a.Certificate.NotAfter.Equal(b.Certificate.NotAfter) {
+			return a.Certificate.NotAfter.Before(b.Certificate.NotAfter)
+		}
+		return a.Source.String() < b.Source.String()
+	})
+
+	return c.report, nil
+}
+
+type collector struct {
+	report      *Report
+	objectStore store.Store
+	// ingressSecrets are the ingresses which use each secret.
+	ingressSecrets map[store.Key][]Consumer
+}
+
+func (c *collector) list(ctx context.Context, key store.Key) []unstructured.Unstructured {
+	list, _, err := c.objectStore.List(ctx, key)
+	if err != nil {
+		c.report.Errors = append(c.report.Errors, fmt.Sprintf("Unable to list %s: %s", key.Kind, err))
+		return nil
+	}
+	if list == nil {
+		return nil
+	}
+	return list.Items
+}
+
+func (c *collector) collectIngresses(ctx context.Context) {
+	for _, ingress := range c.list(ctx, store.KeyFromGroupVersionKind(gvk.Ingress)) {
+		tlsList, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "tls")
+		for _, item := range tlsList {
+			tls, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			secretName, _, _ := unstructured.NestedString(tls, "secretName")
+			if secretName == "" {
+				continue
+			}
+
+			secretKey := secretStoreKey(ingress.GetNamespace(), secretName)
+			c.ingressSecrets[secretKey] = append(c.ingressSecrets[secretKey], Consumer{
+				Key:    objectKey(&ingress),
+				Detail: "tls.secretName",
+			})
+		}
+	}
+}
+
+// collectSecrets lists TLS secrets a page at a time. The type is selected by
+// the cluster, and paged lists are not cached, so the secrets of the cluster
+// are not loaded into an informer just to find their certificates.
+func (c *collector) collectSecrets(ctx context.Context) {
+	key := store.Key{
+		APIVersion:    "v1",
+		Kind:          "Secret",
+		FieldSelector: &fields.Set{"type": "kubernetes.io/tls"},
+		Limit:         secretPageSize,
+	}
+
+	for {
+		list, _, err := c.objectStore.List(ctx, key)
+		if err != nil {
+			c.report.Errors = append(c.report.Errors, fmt.Sprintf("Unable to list %s: %s", key.Kind, err))
+			return
+		}
+		if list == nil {
+			return
+		}
+
+		for i := range list.Items {
+			secret := &list.Items[i]
+			c.addBundles(secret, c.ingressSecrets[objectKey(secret)], nil)
+		}
+
+		key.Continue = list.GetContinue()
+		if key.Continue == "" {
+			return
+		}
+	}
+}
+
+func (c *collector) collectWebhooks(ctx context.Context, groupVersionKind schema.GroupVersionKind) {
+	for _, object := range c.list(ctx, store.KeyFromGroupVersionKind(groupVersionKind)) {
+		object := object
+		c.addBundles(&object, nil, func(bundle certificates.Bundle) []Consumer {
+			return []Consumer{{Key: objectKey(&object), Detail: "webhook " + bundle.Webhook}}
+		})
+	}
+}
+
+func (c *collector) collectAPIServices(ctx context.Context) {
+	for _, object := range c.list(ctx, store.KeyFromGroupVersionKind(gvk.APIService)) {
+		object := object
+		c.addBundles(&object, []Consumer{{Key: objectKey(&object), Detail: "caBundle"}}, nil)
+	}
+}
+
+// collectCertManager adds the expiry cert-manager reports for its certificates.
+// It does nothing if cert-manager is not installed.
+func (c *collector) collectCertManager(ctx context.Context, clusterClient cluster.ClientInterface) {
+	gvr, _, err := clusterClient.Resource(schema.GroupKind{Group: certificates.CertManagerGroup, Kind: "Certificate"})
+	if err != nil {
+		return
+	}
+
+	key := store.Key{
+		APIVersion: schema.GroupVersion{Group: certificates.CertManagerGroup, Version: gvr.Version}.String(),
+		Kind:       "Certificate",
+	}
+
+	for _, object := range c.list(ctx, key) {
+		cert, ok := certificates.FromCertManager(&object)
+		if !ok {
+			continue
+		}
+
+		var consumers []Consumer
+		if secretName, _, _ := unstructured.NestedString(object.Object, "spec", "secretName"); secretName != "" {
+			secretKey := secretStoreKey(object.GetNamespace(), secretName)
+			consumers = append(consumers, Consumer{Key: secretKey, Detail: "spec.secretName"})
+			consumers = append(consumers, c.ingressSecrets[secretKey]...)
+		}
+
+		c.report.Entries = append(c.report.Entries, Entry{
+			Certificate: cert,
+			Source:      objectKey(&object),
+			Field:       "status.notAfter",
+			Consumers:   consumers,
+		})
+	}
+}
+
+// addBundles adds the certificates an object stores. Consumers are the
+// consumers of every certificate, and bundleConsumers returns the consumers of
+// the certificates in a bundle.
+func (c *collector) addBundles(object *unstructured.Unstructured, consumers []Consumer, bundleConsumers func(certificates.Bundle) []Consumer) {
+	bundles, err := certificates.Bundles(object)
+	if err != nil {
+		c.report.Errors = append(c.report.Errors,
+			fmt.Sprintf("Unable to read certificates of %s %s: %s", object.GetKind(), objectName(object), err))
+		return
+	}
+
+	for _, bundle := range bundles {
+		list, err := certificates.ParsePEM(bundle.Data)
+		if err != nil {
+			c.report.Errors = append(c.report.Errors,
+				fmt.Sprintf("Unable to parse %s of %s %s: %s", bundle.Field, object.GetKind(), objectName(object), err))
+			continue
+		}
+
+		entryConsumers := consumers
+		if bundleConsumers != nil {
+			entryConsumers = append(append([]Consumer{}, consumers...), bundleConsumers(bundle)...)
+		}
+
+		for _, cert := range list {
+			c.report.Entries = append(c.report.Entries, Entry{
+				Certificate: cert,
+				Source:      objectKey(object),
+				Field:       bundle.Field,
+				Consumers:   entryConsumers,
+			})
+		}
+	}
+}
+
+func objectKey(object *unstructured.Unstructured) store.Key {
+	return store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+	}
+}
+
+func secretStoreKey(namespace, name string) store.Key {
+	return store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Secret", Name: name}
+}
+
+func objectName(object *unstructured.Unstructured) string {
+	if object.GetNamespace() == "" {
+		return object.GetName()
+	}
+	return object.GetNamespace() + "/" + object.GetName()
+}
@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tlscertificates
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+var (
+	ingressKey     = store.Key{Namespace: "default", APIVersion: "extensions/v1beta1", Kind: "Ingress", Name: "web"}
+	secretKey      = store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "web-tls"}
+	webhookKey     = store.Key{APIVersion: "admissionregistration.k8s.io/v1", Kind: "ValidatingWebhookConfiguration", Name: "validator"}
+	certManagerKey = store.Key{Namespace: "default", APIVersion: "cert-manager.io/v1", Kind: "Certificate", Name: "web"}
+)
+
+func unstructuredList(objects ...map[string]interface{}) *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	for _, object := range objects {
+		list.Items = append(list.Items, unstructured.Unstructured{Object: object})
+	}
+	return list
+}
+
+func metadata(key store.Key) map[string]interface{} {
+	m := map[string]interface{}{"name": key.Name}
+	if key.Namespace != "" {
+		m["namespace"] = key.Namespace
+	}
+	return m
+}
+
+// mockCertificateSources sets up a store with an ingress using a TLS secret
+// issued by cert-manager, and a webhook configuration. Listing APIServices
+// fails.
+func mockCertificateSources(t *testing.T, objectStore *storeFake.MockStore, clusterClient *clusterFake.MockClientInterface) {
+	leaf, ca := testutil.CreateCertificateChain(t, "example.com", time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	objectStore.EXPECT().
+		List(gomock.Any(), store.KeyFromGroupVersionKind(gvk.Ingress)).
+		Return(unstructuredList(map[string]interface{}{
+			"apiVersion": ingressKey.APIVersion,
+			"kind":       ingressKey.Kind,
+			"metadata":   metadata(ingressKey),
+			"spec": map[string]interface{}{
+				"tls": []interface{}{
+					map[string]interface{}{"secretName": secretKey.Name},
+				},
+			},
+		}), false, nil)
+
+	secretsKey := store.Key{
+		APIVersion:    "v1",
+		Kind:          "Secret",
+		FieldSelector: &fields.Set{"type": "kubernetes.io/tls"},
+		Limit:         secretPageSize,
+	}
+	firstSecrets := unstructuredList()
+	firstSecrets.SetContinue("next")
+	objectStore.EXPECT().
+		List(gomock.Any(), secretsKey).
+		Return(firstSecrets, false, nil)
+	secretsKey.Continue = "next"
+	objectStore.EXPECT().
+		List(gomock.Any(), secretsKey).
+		Return(unstructuredList(map[string]interface{}{
+			"apiVersion": secretKey.APIVersion,
+			"kind":       secretKey.Kind,
+			"metadata":   metadata(secretKey),
+			"type":       "kubernetes.io/tls",
+			"data": map[string]interface{}{
+				"tls.crt": base64.StdEncoding.EncodeToString(leaf),
+			},
+		}), false, nil)
+
+	objectStore.EXPECT().
+		List(gomock.Any(), store.KeyFromGroupVersionKind(gvk.MutatingWebhookConfiguration)).
+		Return(unstructuredList(), false, nil)
+
+	objectStore.EXPECT().
+		List(gomock.Any(), store.KeyFromGroupVersionKind(gvk.ValidatingWebhookConfiguration)).
+		Return(unstructuredList(map[string]interface{}{
+			"apiVersion": webhookKey.APIVersion,
+			"kind":       webhookKey.Kind,
+			"metadata":   metadata(webhookKey),
+			"webhooks": []interface{}{
+				map[string]interface{}{
+					"name":         "validate.example.com",
+					"clientConfig": map[string]interface{}{"caBundle": base64.StdEncoding.EncodeToString(ca)},
+				},
+			},
+		}), false, nil)
+
+	objectStore.EXPECT().
+		List(gomock.Any(), store.KeyFromGroupVersionKind(gvk.APIService)).
+		Return(nil, false, errors.New("forbidden"))
+
+	clusterClient.EXPECT().
+		Resource(schema.GroupKind{Group: "cert-manager.io", Kind: "Certificate"}).
+		Return(schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}, true, nil)
+
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "cert-manager.io/v1", Kind: "Certificate"}).
+		Return(unstructuredList(map[string]interface{}{
+			"apiVersion": certManagerKey.APIVersion,
+			"kind":       certManagerKey.Kind,
+			"metadata":   metadata(certManagerKey),
+			"spec": map[string]interface{}{
+				"commonName": "example.com",
+				"secretName": secretKey.Name,
+				"issuerRef":  map[string]interface{}{"name": "letsencrypt"},
+			},
+			"status": map[string]interface{}{
+				"notAfter": "2020-04-01T00:00:00Z",
+			},
+		}), false, nil)
+}
+
+func TestCollect(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	mockCertificateSources(t, objectStore, clusterClient)
+
+	report, err := Collect(context.Background(), objectStore, clusterClient)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Unable to list APIService: forbidden"}, report.Errors)
+
+	require.Len(t, report.Entries, 3)
+
+	assert.Equal(t, certManagerKey, report.Entries[0].Source)
+	assert.Equal(t, "status.notAfter", report.Entries[0].Field)
+	assert.Equal(t, "Issuer letsencrypt", report.Entries[0].Certificate.Issuer)
+	assert.Equal(t, []Consumer{
+		{Key: secretKey, Detail: "spec.secretName"},
+		{Key: ingressKey, Detail: "tls.secretName"},
+	}, report.Entries[0].Consumers)
+
+	assert.Equal(t, secretKey, report.Entries[1].Source)
+	assert.Equal(t, "tls.crt", report.Entries[1].Field)
+	assert.Equal(t, "CN=example.com", report.Entries[1].Certificate.Subject)
+	assert.Equal(t, []Consumer{
+		{Key: ingressKey, Detail: "tls.secretName"},
+	}, report.Entries[1].Consumers)
+
+	assert.Equal(t, webhookKey, report.Entries[2].Source)
+	assert.Equal(t, "webhook validate.example.com caBundle", report.Entries[2].Field)
+	assert.Equal(t, "CN=Test CA", report.Entries[2].Certificate.Subject)
+	assert.Equal(t, []Consumer{
+		{Key: webhookKey, Detail: "webhook validate.example.com"},
+	}, report.Entries[2].Consumers)
+}
+
+func TestCollect_withoutCertManager(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(unstructuredList(), false, nil).
+		Times(5)
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().
+		Resource(schema.GroupKind{Group: "cert-manager.io", Kind: "Certificate"}).
+		Return(schema.GroupVersionResource{}, false, errors.New("not found"))
+
+	report, err := Collect(context.Background(), objectStore, clusterClient)
+	require.NoError(t, err)
+	assert.Empty(t, report.Entries)
+	assert.Empty(t, report.Errors)
+}
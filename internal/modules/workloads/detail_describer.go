@@ -124,7 +124,7 @@ _%s_
 		objects = append(objects, &pod)
 	}
 
-	rv, err := resourceviewer.Create(ctx, options.Dash, options.Queryer, objects...)
+	rv, err := resourceviewer.Create(ctx, options.Dash, options.Queryer, objects)
 	if err != nil {
 		cr := d.createResponse(
 			component.NewError(component.TitleFromString("Unable to create resource viewer"), err),
@@ -7,6 +7,7 @@ package objectstatus
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -17,9 +18,16 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
-// apiService creates status for an apiregistration.k8s.io/v1 apiservice.
+// apiService creates a status func for an apiregistration.k8s.io/v1
+// apiservice. Certificates which expire within window are expiring.
 // This is not the final implementation. It is included to generate output.
-func apiService(_ context.Context, object runtime.Object, _ store.Store) (ObjectStatus, error) {
+func apiService(window time.Duration) statusFunc {
+	return func(_ context.Context, object runtime.Object, _ store.Store) (ObjectStatus, error) {
+		return apiServiceStatus(object, window)
+	}
+}
+
+func apiServiceStatus(object runtime.Object, window time.Duration) (ObjectStatus, error) {
 	if object == nil {
 		return ObjectStatus{}, errors.Errorf("apiservice is nil")
 	}
@@ -38,27 +46,33 @@ func apiService(_ context.Context, object runtime.Object, _ store.Store) (Object
 		}
 	}
 
+	var status ObjectStatus
+
 	switch {
 	case availableCondition == nil:
-		return ObjectStatus{
+		status = ObjectStatus{
 			nodeStatus: component.NodeStatusWarning,
 			Details:    []component.Component{component.NewText("No available condition for this apiservice")},
-		}, nil
+		}
 	case availableCondition.Status == apiregistrationv1.ConditionFalse:
-		return ObjectStatus{
+		status = ObjectStatus{
 			nodeStatus: component.NodeStatusError,
 			Details:    []component.Component{component.NewTextf("Not available: (%s) %s", availableCondition.Reason, availableCondition.Message)},
-		}, nil
+		}
 	case availableCondition.Status == apiregistrationv1.ConditionTrue:
-		return ObjectStatus{
+		status = ObjectStatus{
 			nodeStatus: component.NodeStatusOK,
 			Details:    []component.Component{component.NewText("API Service is OK")},
-		}, nil
+		}
 	default:
-		return ObjectStatus{
+		status = ObjectStatus{
 			nodeStatus: component.NodeStatusWarning,
 			Details: []component.Component{
 				component.NewTextf("Unknown availability for apiservice")},
-		}, nil
+		}
 	}
+
+	addCertificateStatus(&status, object, time.Now(), window)
+
+	return status, nil
 }
@@ -17,6 +17,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 
+	"github.com/vmware-tanzu/octant/internal/certificates"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -91,7 +92,7 @@ func Test_apiService(t *testing.T) {
 			object := tc.init(t, o)
 
 			ctx := context.Background()
-			status, err := apiService(ctx, object, o)
+			status, err := apiService(certificates.DefaultExpiryWindow)(ctx, object, o)
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/certificates"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// addCertificateStatus adds the status of the certificates an object stores.
+// Certificates which expire within window are expiring. Expiring
+// certificates are warnings, and expired certificates are errors.
+func addCertificateStatus(status *ObjectStatus, object runtime.Object, now time.Time, window time.Duration) {
+	bundles, err := certificates.Bundles(object)
+	if err != nil {
+		status.SetError()
+		status.AddDetailf("Unable to read certificates: %s", err)
+		return
+	}
+
+	check := certificates.CheckBundles(bundles, now, window)
+	switch check.Status {
+	case certificates.StatusValid:
+		return
+	case certificates.StatusExpiring:
+		status.SetWarning()
+	default:
+		status.SetError()
+	}
+
+	for _, message := range check.Messages {
+		status.AddDetail(message)
+	}
+}
+
+// certificateStatus creates a status func for objects which store
+// certificates, e.g. TLS secrets and webhook configurations. Certificates
+// which expire within window are expiring.
+func certificateStatus(window time.Duration) statusFunc {
+	return func(_ context.Context, object runtime.Object, _ store.Store) (ObjectStatus, error) {
+		return storedCertificateStatus(object, window)
+	}
+}
+
+func storedCertificateStatus(object runtime.Object, window time.Duration) (ObjectStatus, error) {
+	if object == nil {
+		return ObjectStatus{}, errors.Errorf("object is nil")
+	}
+
+	status := ObjectStatus{}
+	addCertificateStatus(&status, object, time.Now(), window)
+
+	if len(status.Details) == 0 {
+		apiVersion, kind := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+		status.AddDetailf("%s %s is OK", apiVersion, kind)
+	}
+
+	return status, nil
+}
+
+// certManagerCertificate creates a status func for a cert-manager certificate
+// from the expiry cert-manager reports. The certificate itself is in its
+// secret. Certificates which expire within window are expiring.
+func certManagerCertificate(window time.Duration) statusFunc {
+	return func(_ context.Context, object runtime.Object, _ store.Store) (ObjectStatus, error) {
+		return certManagerCertificateStatus(object, window)
+	}
+}
+
+func certManagerCertificateStatus(object runtime.Object, window time.Duration) (ObjectStatus, error) {
+	if object == nil {
+		return ObjectStatus{}, errors.Errorf("certificate is nil")
+	}
+
+	u, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return ObjectStatus{}, errors.Errorf("certificate is not unstructured")
+	}
+
+	status := ObjectStatus{}
+
+	cert, ok := certificates.FromCertManager(u)
+	if !ok {
+		status.SetWarning()
+		status.AddDetail("Certificate has not been issued")
+		return status, nil
+	}
+
+	now := time.Now()
+	switch cert.Status(now, window) {
+	case certificates.StatusValid:
+		status.AddDetailf("Certificate %s", cert.Message(now))
+	case certificates.StatusExpiring:
+		status.SetWarning()
+		status.AddDetailf("Certificate %s", cert.Message(now))
+	default:
+		status.SetError()
+		status.AddDetailf("Certificate %s", cert.Message(now))
+	}
+
+	return status, nil
+}
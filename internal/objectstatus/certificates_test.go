@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/certificates"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func tlsSecret(t *testing.T, notAfter time.Time) *corev1.Secret {
+	leaf, _ := testutil.CreateCertificateChain(t, "example.com", notAfter)
+	return testutil.CreateSecret("secret", func(secret *corev1.Secret) {
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       leaf,
+			corev1.TLSPrivateKeyKey: []byte("key"),
+		}
+	})
+}
+
+func Test_certificateStatus(t *testing.T) {
+	now := time.Now()
+
+	cases := []struct {
+		name     string
+		object   runtime.Object
+		expected ObjectStatus
+		isErr    bool
+	}{
+		{
+			name:   "valid",
+			object: tlsSecret(t, now.AddDate(1, 0, 0)),
+			expected: ObjectStatus{
+				Details: []component.Component{component.NewText("v1 Secret is OK")},
+			},
+		},
+		{
+			name:   "expiring",
+			object: tlsSecret(t, now.Add(10*24*time.Hour+time.Hour)),
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details: []component.Component{
+					component.NewText("Certificate CN=example.com in tls.crt expires in 10 days"),
+				},
+			},
+		},
+		{
+			name:   "expired",
+			object: tlsSecret(t, now.Add(-2*24*time.Hour-time.Hour)),
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details: []component.Component{
+					component.NewText("Certificate CN=example.com in tls.crt expired 2 days ago"),
+				},
+			},
+		},
+		{
+			name:   "not a tls secret",
+			object: testutil.CreateSecret("secret"),
+			expected: ObjectStatus{
+				Details: []component.Component{component.NewText("v1 Secret is OK")},
+			},
+		},
+		{
+			name:  "object is nil",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := certificateStatus(certificates.DefaultExpiryWindow)(context.Background(), tc.object, nil)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+func Test_certManagerCertificate(t *testing.T) {
+	now := time.Now()
+
+	certificate := func(notAfter *time.Time) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"spec": map[string]interface{}{
+				"commonName": "example.com",
+			},
+		}}
+		if notAfter != nil {
+			require.NoError(t, unstructured.SetNestedField(u.Object, notAfter.UTC().Format(time.RFC3339), "status", "notAfter"))
+		}
+		return u
+	}
+
+	valid := now.Add(100*24*time.Hour + time.Hour)
+	expiring := now.Add(10*24*time.Hour + time.Hour)
+	expired := now.Add(-2*24*time.Hour - time.Hour)
+
+	cases := []struct {
+		name     string
+		object   runtime.Object
+		expected ObjectStatus
+		isErr    bool
+	}{
+		{
+			name:   "valid",
+			object: certificate(&valid),
+			expected: ObjectStatus{
+				Details: []component.Component{component.NewText("Certificate expires in 100 days")},
+			},
+		},
+		{
+			name:   "expiring",
+			object: certificate(&expiring),
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details:    []component.Component{component.NewText("Certificate expires in 10 days")},
+			},
+		},
+		{
+			name:   "expired",
+			object: certificate(&expired),
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details:    []component.Component{component.NewText("Certificate expired 2 days ago")},
+			},
+		},
+		{
+			name:   "not issued",
+			object: certificate(nil),
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details:    []component.Component{component.NewText("Certificate has not been issued")},
+			},
+		},
+		{
+			name:   "object is not unstructured",
+			object: testutil.CreateSecret("secret"),
+			isErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := certManagerCertificate(certificates.DefaultExpiryWindow)(context.Background(), tc.object, nil)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+func TestStatus_certificateExpiryWindow(t *testing.T) {
+	secret := tlsSecret(t, time.Now().AddDate(0, 0, 60))
+
+	status, err := Status(context.Background(), secret, nil)
+	require.NoError(t, err)
+	assert.Equal(t, component.NodeStatusOK, status.Status())
+
+	status, err = Status(context.Background(), secret, nil, WithCertificateExpiryWindow(90*24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, component.NodeStatusWarning, status.Status())
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
@@ -26,7 +27,15 @@ const (
 	ingressAlbActionAnnotation = "alb.ingress.kubernetes.io/actions."
 )
 
-func runIngressStatus(ctx context.Context, object runtime.Object, o store.Store) (ObjectStatus, error) {
+// runIngressStatus creates a status func for an ingress. Certificates which
+// expire within window are expiring.
+func runIngressStatus(window time.Duration) statusFunc {
+	return func(ctx context.Context, object runtime.Object, o store.Store) (ObjectStatus, error) {
+		return ingressObjectStatus(ctx, object, o, window)
+	}
+}
+
+func ingressObjectStatus(ctx context.Context, object runtime.Object, o store.Store, window time.Duration) (ObjectStatus, error) {
 	if object == nil {
 		return ObjectStatus{}, errors.Errorf("ingress is nil")
 	}
@@ -40,6 +49,7 @@ func runIngressStatus(ctx context.Context, object runtime.Object, o store.Store)
 	is := ingressStatus{
 		ingress:     *ingress,
 		objectStore: o,
+		window:      window,
 	}
 	status, err := is.run(ctx)
 	if err != nil {
@@ -56,6 +66,7 @@ func runIngressStatus(ctx context.Context, object runtime.Object, o store.Store)
 type ingressStatus struct {
 	ingress     extv1beta1.Ingress
 	objectStore store.Store
+	window      time.Duration
 }
 
 func (is *ingressStatus) run(ctx context.Context) (ObjectStatus, error) {
@@ -163,7 +174,10 @@ func (is *ingressStatus) run(ctx context.Context) (ObjectStatus, error) {
 		if u == nil {
 			status.SetError()
 			status.AddDetailf("Secret %q does not exist", tls.SecretName)
+			continue
 		}
+
+		addCertificateStatus(&status, u, time.Now(), is.window)
 	}
 
 	return status, nil
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/certificates"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	storefake "github.com/vmware-tanzu/octant/pkg/store/fake"
@@ -139,7 +140,7 @@ func Test_runIngressStatus(t *testing.T) {
 			object := tc.init(t, o)
 
 			ctx := context.Background()
-			status, err := runIngressStatus(ctx, object, o)
+			status, err := runIngressStatus(certificates.DefaultExpiryWindow)(ctx, object, o)
 			if tc.isErr {
 				require.Error(t, err)
 				return
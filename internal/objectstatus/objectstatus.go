@@ -9,10 +9,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/certificates"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -26,8 +28,12 @@ type statusFunc func(context.Context, runtime.Object, store.Store) (ObjectStatus
 
 type statusLookup map[statusKey]statusFunc
 
-var (
-	defaultStatusLookup = statusLookup{
+var defaultStatusLookup = newStatusLookup(certificates.DefaultExpiryWindow)
+
+// newStatusLookup creates a status lookup. Certificates which expire within
+// window are expiring.
+func newStatusLookup(window time.Duration) statusLookup {
+	lookup := statusLookup{
 		{apiVersion: "batch/v1beta1", kind: "CronJob"}:                cronJob,
 		{apiVersion: "apps/v1", kind: "DaemonSet"}:                    daemonSet,
 		{apiVersion: "apps/v1", kind: "Deployment"}:                   deploymentAppsV1,
@@ -37,10 +43,40 @@ var (
 		{apiVersion: "v1", kind: "Pod"}:                               pod,
 		{apiVersion: "v1", kind: "ReplicationController"}:             replicationController,
 		{apiVersion: "v1", kind: "Service"}:                           service,
-		{apiVersion: "extensions/v1beta1", kind: "Ingress"}:           runIngressStatus,
-		{apiVersion: "apiregistration.k8s.io/v1", kind: "APIService"}: apiService,
+		{apiVersion: "extensions/v1beta1", kind: "Ingress"}:           runIngressStatus(window),
+		{apiVersion: "apiregistration.k8s.io/v1", kind: "APIService"}: apiService(window),
+		{apiVersion: "v1", kind: "Secret"}:                            certificateStatus(window),
+
+		{apiVersion: "admissionregistration.k8s.io/v1", kind: "MutatingWebhookConfiguration"}:        certificateStatus(window),
+		{apiVersion: "admissionregistration.k8s.io/v1beta1", kind: "MutatingWebhookConfiguration"}:   certificateStatus(window),
+		{apiVersion: "admissionregistration.k8s.io/v1", kind: "ValidatingWebhookConfiguration"}:      certificateStatus(window),
+		{apiVersion: "admissionregistration.k8s.io/v1beta1", kind: "ValidatingWebhookConfiguration"}: certificateStatus(window),
 	}
-)
+
+	for _, version := range certificates.CertManagerVersions {
+		key := statusKey{apiVersion: certificates.CertManagerGroup + "/" + version, kind: "Certificate"}
+		lookup[key] = certManagerCertificate(window)
+	}
+
+	return lookup
+}
+
+// Option configures Status.
+type Option func(*options)
+
+type options struct {
+	certificateExpiryWindow time.Duration
+}
+
+// WithCertificateExpiryWindow sets how long before a certificate expires it is
+// reported as expiring. Windows which are not positive are ignored.
+func WithCertificateExpiryWindow(window time.Duration) Option {
+	return func(o *options) {
+		if window > 0 {
+			o.certificateExpiryWindow = window
+		}
+	}
+}
 
 type ObjectStatus struct {
 	nodeStatus component.NodeStatus
@@ -77,8 +113,23 @@ func (os *ObjectStatus) Status() component.NodeStatus {
 }
 
 // Status creates an ObjectStatus for an object.
-func Status(ctx context.Context, object runtime.Object, o store.Store) (ObjectStatus, error) {
-	return status(ctx, object, o, defaultStatusLookup)
+func Status(ctx context.Context, object runtime.Object, o store.Store, opts ...Option) (ObjectStatus, error) {
+	so := statusOptions(opts...)
+
+	lookup := defaultStatusLookup
+	if so.certificateExpiryWindow != certificates.DefaultExpiryWindow {
+		lookup = newStatusLookup(so.certificateExpiryWindow)
+	}
+
+	return status(ctx, object, o, lookup)
+}
+
+func statusOptions(opts ...Option) options {
+	so := options{certificateExpiryWindow: certificates.DefaultExpiryWindow}
+	for _, opt := range opts {
+		opt(&so)
+	}
+	return so
 }
 
 func status(ctx context.Context, object runtime.Object, o store.Store, lookup statusLookup) (ObjectStatus, error) {
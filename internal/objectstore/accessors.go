@@ -40,10 +40,20 @@ func (c *informerSynced) hasSynced(key store.Key) bool {
 }
 
 func (c *informerSynced) hasSeen(key store.Key) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	_, ok := c.status[key.String()]
 	return ok
 }
 
+func (c *informerSynced) remove(key store.Key) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.status, key.String())
+}
+
 func (c *informerSynced) reset() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
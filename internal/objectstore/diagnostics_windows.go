@@ -1,11 +0,0 @@
-// +build windows
-
-package objectstore
-
-import (
-	"github.com/vmware-tanzu/octant/pkg/log"
-)
-
-func initStatusCheck(stopCh <-chan struct{}, logger log.Logger, factories *factoriesCache) {
-
-}
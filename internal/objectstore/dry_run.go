@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	sigyaml "sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// FieldConflict is a field owned by another field manager. Applying takes
+// ownership of the field.
+type FieldConflict struct {
+	Field   string
+	Message string
+}
+
+// DryRunResult is the result of a server-side dry-run of one YAML document.
+type DryRunResult struct {
+	Key store.Key
+	// Live is the object in the cluster. It is nil if the object will be created.
+	Live *unstructured.Unstructured
+	// Result is the object the API server would persist, including defaulted
+	// fields and changes made by admission webhooks.
+	Result *unstructured.Unstructured
+	// Conflicts are the fields which are owned by other field managers.
+	Conflicts []FieldConflict
+}
+
+// Created returns true if the dry-run created the object.
+func (r DryRunResult) Created() bool {
+	return r.Live == nil
+}
+
+// Diff returns a unified diff of the live object and the dry-run result.
+// Fields which change on every write, like the managed fields and the resource
+// version, are not compared.
+func (r DryRunResult) Diff() (string, error) {
+	var live interface{}
+	if r.Live != nil && r.Live.Object != nil {
+		live = dryRunComparable(r.Live)
+	}
+
+	return kubernetes.DiffYAML("live", live, "dry-run", dryRunComparable(r.Result))
+}
+
+func dryRunComparable(object *unstructured.Unstructured) map[string]interface{} {
+	object = object.DeepCopy()
+	unstructured.RemoveNestedField(object.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(object.Object, "metadata", "resourceVersion")
+	return object.Object
+}
+
+// DryRunFromYAML performs a server-side dry-run of each document in the YAML
+// input, as CreateOrUpdateFromHandler would apply them. Objects which exist are
+// applied without forcing ownership, so conflicts with other field managers are
+// reported. The object is then applied again with force, so the result can be
+// previewed anyway. A parse or API error halts the dry-run.
+func DryRunFromYAML(
+	ctx context.Context, namespace, input string,
+	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
+	clusterClient cluster.ClientInterface,
+) ([]DryRunResult, error) {
+	var results []DryRunResult
+	err := withYAMLDocuments(input, func(doc map[string]interface{}) error {
+		object := &unstructured.Unstructured{Object: doc}
+		key, err := store.KeyFromObject(object)
+		if err != nil {
+			return err
+		}
+		gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+		if err != nil {
+			return fmt.Errorf("unable to discover resource: %w", err)
+		}
+		if namespaced && key.Namespace == "" {
+			object.SetNamespace(namespace)
+			key.Namespace = namespace
+		}
+
+		dynamicClient, err := clusterClient.DynamicClient()
+		if err != nil {
+			return fmt.Errorf("unable to get dynamic client: %w", err)
+		}
+		resourceClient := dynamicClient.Resource(gvr)
+		var client dynamic.ResourceInterface = resourceClient
+		if namespaced {
+			client = resourceClient.Namespace(key.Namespace)
+		}
+
+		result := DryRunResult{Key: key}
+
+		live, err := get(ctx, key)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return fmt.Errorf("unable to get resource: %w", err)
+			}
+
+			result.Result, err = client.Create(ctx, object, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+			if err != nil {
+				return fmt.Errorf("unable to dry-run create of %s %q: %w", key.Kind, key.Name, err)
+			}
+			results = append(results, result)
+			return nil
+		}
+		if live == nil {
+			live = &unstructured.Unstructured{}
+		}
+		result.Live = live
+
+		data, err := sigyaml.Marshal(doc)
+		if err != nil {
+			return fmt.Errorf("unable to marshal resource as yaml: %w", err)
+		}
+
+		apply := func(force bool) (*unstructured.Unstructured, error) {
+			return client.Patch(ctx, key.Name, types.ApplyPatchType, data, metav1.PatchOptions{
+				DryRun:       []string{metav1.DryRunAll},
+				FieldManager: "octant",
+				Force:        &force,
+			})
+		}
+
+		result.Result, err = apply(false)
+		if err != nil {
+			result.Conflicts = fieldConflicts(err)
+			if len(result.Conflicts) == 0 {
+				return fmt.Errorf("unable to dry-run apply of %s %q: %w", key.Kind, key.Name, err)
+			}
+
+			result.Result, err = apply(true)
+			if err != nil {
+				return fmt.Errorf("unable to dry-run apply of %s %q: %w", key.Kind, key.Name, err)
+			}
+		}
+
+		results = append(results, result)
+		return nil
+	})
+
+	return results, err
+}
+
+// fieldConflicts returns the field manager conflicts in an apply error.
+func fieldConflicts(err error) []FieldConflict {
+	var statusErr *kerrors.StatusError
+	if !errors.As(err, &statusErr) || !kerrors.IsConflict(statusErr) {
+		return nil
+	}
+
+	details := statusErr.Status().Details
+	if details == nil {
+		return nil
+	}
+
+	var conflicts []FieldConflict
+	for _, cause := range details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		conflicts = append(conflicts, FieldConflict{
+			Field:   cause.Field,
+			Message: cause.Message,
+		})
+	}
+
+	return conflicts
+}
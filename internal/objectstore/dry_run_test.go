@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterfake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestDryRunFromYAML(t *testing.T) {
+	input := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: greeting
+data:
+  hello: world
+`
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	configMap := func(hello string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":            "greeting",
+				"namespace":       "default",
+				"resourceVersion": hello,
+				"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			},
+			"data": map[string]interface{}{"hello": hello},
+		}}
+	}
+
+	conflict := kerrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "kubectl" using v1`,
+			Field:   ".data.hello",
+		},
+	}, "Apply failed with 1 conflict")
+
+	dryRun := metav1.PatchOptions{DryRun: []string{metav1.DryRunAll}, FieldManager: "octant"}
+	withForce := func(force bool) metav1.PatchOptions {
+		options := dryRun
+		options.Force = &force
+		return options
+	}
+
+	tests := []struct {
+		name     string
+		live     *unstructured.Unstructured
+		getErr   error
+		expect   func(client *clusterfake.MockNamespaceableResourceInterface)
+		expected []DryRunResult
+		wantErr  bool
+	}{
+		{
+			name:   "create",
+			getErr: kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "greeting"),
+			expect: func(client *clusterfake.MockNamespaceableResourceInterface) {
+				client.EXPECT().
+					Create(gomock.Any(), gomock.Any(), metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}).
+					Return(configMap("world"), nil)
+			},
+			expected: []DryRunResult{{Key: key, Result: configMap("world")}},
+		},
+		{
+			name: "update",
+			live: configMap("there"),
+			expect: func(client *clusterfake.MockNamespaceableResourceInterface) {
+				client.EXPECT().
+					Patch(gomock.Any(), "greeting", types.ApplyPatchType, gomock.Any(), withForce(false)).
+					Return(configMap("world"), nil)
+			},
+			expected: []DryRunResult{{Key: key, Live: configMap("there"), Result: configMap("world")}},
+		},
+		{
+			name: "conflict",
+			live: configMap("there"),
+			expect: func(client *clusterfake.MockNamespaceableResourceInterface) {
+				client.EXPECT().
+					Patch(gomock.Any(), "greeting", types.ApplyPatchType, gomock.Any(), withForce(false)).
+					Return(nil, conflict)
+				client.EXPECT().
+					Patch(gomock.Any(), "greeting", types.ApplyPatchType, gomock.Any(), withForce(true)).
+					Return(configMap("world"), nil)
+			},
+			expected: []DryRunResult{
+				{
+					Key:    key,
+					Live:   configMap("there"),
+					Result: configMap("world"),
+					Conflicts: []FieldConflict{
+						{Field: ".data.hello", Message: `conflict with "kubectl" using v1`},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid",
+			live: configMap("there"),
+			expect: func(client *clusterfake.MockNamespaceableResourceInterface) {
+				client.EXPECT().
+					Patch(gomock.Any(), "greeting", types.ApplyPatchType, gomock.Any(), withForce(false)).
+					Return(nil, kerrors.NewBadRequest("invalid"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clusterClient := clusterfake.NewMockClientInterface(controller)
+			dynamicClient := clusterfake.NewMockDynamicInterface(controller)
+			resourceClient := clusterfake.NewMockNamespaceableResourceInterface(controller)
+
+			clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(gvr, true, nil)
+			clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+			dynamicClient.EXPECT().Resource(gvr).Return(resourceClient)
+			resourceClient.EXPECT().Namespace("default").Return(resourceClient)
+			test.expect(resourceClient)
+
+			get := func(ctx context.Context, got store.Key) (*unstructured.Unstructured, error) {
+				assert.Equal(t, key, got)
+				return test.live, test.getErr
+			}
+
+			results, err := DryRunFromYAML(context.Background(), "default", input, get, clusterClient)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, results)
+		})
+	}
+}
+
+func TestDryRunResult_Diff(t *testing.T) {
+	object := func(replicas int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind": "Deployment",
+			"metadata": map[string]interface{}{
+				"name":            "web",
+				"resourceVersion": fmt.Sprintf("%d", replicas),
+				"managedFields":   []interface{}{map[string]interface{}{"manager": fmt.Sprintf("manager-%d", replicas)}},
+			},
+			"spec": map[string]interface{}{"replicas": replicas},
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		result   DryRunResult
+		expected string
+	}{
+		{
+			name:   "created",
+			result: DryRunResult{Result: object(1)},
+			expected: "--- live\n" +
+				"+++ dry-run\n" +
+				"@@ -0,0 +1,5 @@\n" +
+				"+kind: Deployment\n" +
+				"+metadata:\n" +
+				"+  name: web\n" +
+				"+spec:\n" +
+				"+  replicas: 1\n",
+		},
+		{
+			name:   "updated",
+			result: DryRunResult{Live: object(1), Result: object(2)},
+			expected: "--- live\n" +
+				"+++ dry-run\n" +
+				"@@ -2,4 +2,4 @@\n" +
+				" metadata:\n" +
+				"   name: web\n" +
+				" spec:\n" +
+				"-  replicas: 1\n" +
+				"+  replicas: 2\n",
+		},
+		{
+			name:   "unchanged",
+			result: DryRunResult{Live: object(1), Result: object(1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.name == "created", test.result.Created())
+
+			got, err := test.result.Diff()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
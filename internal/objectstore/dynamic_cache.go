@@ -47,6 +47,10 @@ func initInformerFactory(ctx context.Context, client cluster.ClientInterface, na
 	return newInformerFactory(ctx.Done(), client, defaultInformerResync, namespace), nil
 }
 
+func initMetadataInformerFactory(ctx context.Context, client cluster.ClientInterface, namespace string) (InformerFactory, error) {
+	return newMetadataInformerFactory(ctx.Done(), client, defaultInformerResync, namespace), nil
+}
+
 // DynamicCacheOpt is an option for configuration DynamicCache.
 type DynamicCacheOpt func(*DynamicCache)
 
@@ -57,17 +61,36 @@ func Access(resourceAccess ResourceAccess) DynamicCacheOpt {
 	}
 }
 
+// MetadataOnly sets GVKs the DynamicCache does not cache full objects for. Lists and
+// gets for these GVKs are fetched from the cluster when they are requested, and their
+// metadata is cached by metadata informers.
+func MetadataOnly(groupVersionKinds ...schema.GroupVersionKind) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		for _, groupVersionKind := range groupVersionKinds {
+			dc.metadataOnly[groupVersionKind] = true
+		}
+	}
+}
+
 // DynamicCache is a cache based on the dynamic shared informer factory.
 type DynamicCache struct {
-	initFactoryFunc func(context.Context, cluster.ClientInterface, string) (InformerFactory, error)
-	factories       *factoriesCache
-	informerSynced  *informerSynced
-	backoffMap      sync.Map
-	client          cluster.ClientInterface
-	seenGVKs        *seenGVKsCache
-	access          ResourceAccess
-	updateFns       []store.UpdateFn
-	updateMu        sync.Mutex
+	initFactoryFunc         func(context.Context, cluster.ClientInterface, string) (InformerFactory, error)
+	initMetadataFactoryFunc func(context.Context, cluster.ClientInterface, string) (InformerFactory, error)
+	factories               *factoriesCache
+	metadataFactories       *factoriesCache
+	informerSynced          *informerSynced
+	metadataSynced          *informerSynced
+	metadataOnly            map[schema.GroupVersionKind]bool
+	informers               *informerTracker
+	idleTTL                 time.Duration
+	maxObjects              int
+	maxBytes                int64
+	backoffMap              sync.Map
+	client                  cluster.ClientInterface
+	seenGVKs                *seenGVKsCache
+	access                  ResourceAccess
+	updateFns               []store.UpdateFn
+	updateMu                sync.Mutex
 
 	syncTimeoutFunc func(context.Context, store.Key, chan bool)
 	waitForSyncFunc func(context.Context, store.Key, *DynamicCache, informers.GenericInformer, chan bool)
@@ -101,22 +124,25 @@ var _ store.Store = (*DynamicCache)(nil)
 // NewDynamicCache creates an instance of DynamicCache.
 func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, options ...DynamicCacheOpt) (*DynamicCache, error) {
 	c := &DynamicCache{
-		initFactoryFunc: initInformerFactory,
-		syncTimeoutFunc: syncTimeout,
-		waitForSyncFunc: waitForSync,
-		client:          client,
-		seenGVKs:        initSeenGVKsCache(),
-		informerSynced:  initInformerSynced(),
+		initFactoryFunc:         initInformerFactory,
+		initMetadataFactoryFunc: initMetadataInformerFactory,
+		syncTimeoutFunc:         syncTimeout,
+		waitForSyncFunc:         waitForSync,
+		client:                  client,
+		seenGVKs:                initSeenGVKsCache(),
+		informerSynced:          initInformerSynced(),
+		metadataFactories:       initFactoriesCache(),
+		metadataSynced:          initInformerSynced(),
+		metadataOnly:            make(map[schema.GroupVersionKind]bool),
+		informers:               initInformerTracker(),
 	}
 
 	for _, option := range options {
 		option(c)
 	}
 
-	logger := log.From(ctx).With("component", "DynamicCache")
-
 	c.factories = initFactoriesCache()
-	go initStatusCheck(ctx.Done(), logger, c.factories)
+	go c.runInformerSweeper(ctx)
 
 	factory, err := c.initFactoryFunc(context.Background(), client, "")
 	if err != nil {
@@ -166,6 +192,7 @@ func (dc *DynamicCache) currentInformer(ctx context.Context, key store.Key) (inf
 
 	dc.checkKeySynced(ctx, informer, key)
 	dc.seenGVKs.setSeen(key.Namespace, gvk, true)
+	dc.trackInformer(factory, informer, key, false)
 
 	return informer, dc.informerSynced.hasSynced(key), nil
 }
@@ -179,6 +206,9 @@ func (dc *DynamicCache) checkKeySynced(ctx context.Context, informer informers.G
 		return
 	}
 
+	// lists use the cluster until the informer has synced
+	dc.informerSynced.setSynced(key, false)
+
 	done := make(chan bool, 1)
 	go dc.waitForSyncFunc(ctx, key, dc, informer, done)
 	go dc.syncTimeoutFunc(ctx, key, done)
@@ -254,9 +284,53 @@ func (dc *DynamicCache) List(ctx context.Context, key store.Key) (*unstructured.
 		trace.StringAttribute("kind", key.Kind),
 	}, "list key")
 
+	if dc.isMetadataOnly(key) {
+		list, err := dc.listFromDynamicClient(ctx, key)
+		return list, false, err
+	}
+
+	if key.IsPaged() {
+		return dc.listPage(ctx, key)
+	}
+
 	return dc.listFromInformer(ctx, key)
 }
 
+// listPage lists a page of objects. Pages are listed from an informer if one has
+// already synced for the key's GVK. Otherwise they are listed from the cluster, so
+// large collections can be paged through without caching them.
+func (dc *DynamicCache) listPage(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "dynamicCache:list:page")
+	defer span.End()
+
+	unpagedKey := key
+	unpagedKey.Limit = 0
+	unpagedKey.Continue = ""
+
+	cached := dc.seenGVKs.hasSeen(key.Namespace, key.GroupVersionKind()) && dc.informerSynced.hasSynced(unpagedKey)
+
+	if (key.Continue == "" && !cached) || (key.Continue != "" && !store.IsCacheContinueToken(key.Continue)) {
+		list, err := dc.listFromDynamicClient(ctx, key)
+		return list, false, err
+	}
+
+	if !cached {
+		return nil, false, fmt.Errorf("list %s: continue token has expired", key)
+	}
+
+	list, loading, err := dc.listFromInformer(ctx, unpagedKey)
+	if err != nil {
+		return nil, false, err
+	}
+
+	page, err := store.Paginate(list, key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return page, loading, nil
+}
+
 func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:list:informer")
 	defer span.End()
@@ -290,7 +364,11 @@ func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*u
 
 	list := &unstructured.UnstructuredList{}
 	for i := range objects {
-		list.Items = append(list.Items, *objects[i].(*unstructured.Unstructured))
+		object := objects[i].(*unstructured.Unstructured)
+		if !store.MatchesFieldSelector(object, key.FieldSelector) {
+			continue
+		}
+		list.Items = append(list.Items, *object)
 	}
 
 	return list, !dc.informerSynced.hasSynced(key), nil
@@ -300,11 +378,6 @@ func (dc *DynamicCache) listFromDynamicClient(ctx context.Context, key store.Key
 	_, span := trace.StartSpan(ctx, "dynamicCache:list:informer")
 	defer span.End()
 
-	var selector = kLabels.Everything()
-	if key.Selector != nil {
-		selector = key.Selector.AsSelector()
-	}
-
 	dynamicClient, err := dc.client.DynamicClient()
 	if err != nil {
 		return nil, err
@@ -315,9 +388,7 @@ func (dc *DynamicCache) listFromDynamicClient(ctx context.Context, key store.Key
 		return nil, err
 	}
 
-	listOptions := metav1.ListOptions{
-		LabelSelector: selector.String(),
-	}
+	listOptions := key.ListOptions()
 	if key.Namespace == "" {
 		return dynamicClient.Resource(gvr).List(ctx, listOptions)
 	}
@@ -355,6 +426,10 @@ func (dc *DynamicCache) Get(ctx context.Context, key store.Key) (*unstructured.U
 		trace.StringAttribute("name", key.Name),
 	}, "get key")
 
+	if dc.isMetadataOnly(key) {
+		return dc.getFromDynamicClient(ctx, key)
+	}
+
 	object, err := dc.getFromInformer(ctx, key)
 	if err != nil {
 		if kerrors.IsNotFound(err) {
@@ -442,6 +517,7 @@ func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache
 	}
 
 	informer.Informer().AddEventHandler(handler)
+	dc.informers.setWatched(informer)
 	return nil
 }
 
@@ -510,6 +586,9 @@ func (dc *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.
 	dc.factories.reset()
 	dc.seenGVKs.reset()
 	dc.informerSynced.reset()
+	dc.metadataFactories.reset()
+	dc.metadataSynced.reset()
+	dc.informers.reset()
 	dc.access = NewResourceAccess(client)
 	dc.updateMu.Unlock()
 
@@ -581,6 +660,10 @@ func (dc *DynamicCache) Update(ctx context.Context, key store.Key, updater func(
 }
 
 func (dc *DynamicCache) IsLoading(ctx context.Context, key store.Key) bool {
+	if dc.isMetadataOnly(key) {
+		return !dc.metadataSynced.hasSynced(key)
+	}
+
 	return !dc.informerSynced.hasSynced(key)
 }
 
@@ -630,35 +713,37 @@ func (dc *DynamicCache) Create(ctx context.Context, object *unstructured.Unstruc
 	return err
 }
 
+// withYAMLDocuments calls cb with each document in a YAML or JSON stream. Empty
+// documents are skipped.
+func withYAMLDocuments(input string, cb func(doc map[string]interface{}) error) error {
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
+	for {
+		doc := map[string]interface{}{}
+		if err := d.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("unable to parse yaml: %w", err)
+		}
+		if len(doc) == 0 {
+			// skip empty documents
+			continue
+		}
+		if err := cb(doc); err != nil {
+			return err
+		}
+	}
+}
+
 func CreateOrUpdateFromHandler(
 	ctx context.Context, namespace, input string,
 	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
 	create func(context.Context, *unstructured.Unstructured) error,
 	clusterClient cluster.ClientInterface,
 ) ([]string, error) {
-	withDoc := func(cb func(doc map[string]interface{}) error) error {
-		d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
-		for {
-			doc := map[string]interface{}{}
-			if err := d.Decode(&doc); err != nil {
-				if err == io.EOF {
-					return nil
-				}
-				return fmt.Errorf("unable to parse yaml: %w", err)
-			}
-			if len(doc) == 0 {
-				// skip empty documents
-				continue
-			}
-			if err := cb(doc); err != nil {
-				return err
-			}
-		}
-	}
-
 	logger := log.From(ctx)
 	results := []string{}
-	err := withDoc(func(doc map[string]interface{}) error {
+	err := withYAMLDocuments(input, func(doc map[string]interface{}) error {
 		logger.Debugf("apply resource %#v", doc)
 
 		unstructuredObj := &unstructured.Unstructured{Object: doc}
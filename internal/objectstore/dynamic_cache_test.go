@@ -5,10 +5,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
 
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	clusterfake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
@@ -25,3 +34,125 @@ func TestDynamicCache_backoff(t *testing.T) {
 	<-time.After(tD + (time.Millisecond * 250))
 	assert.False(t, d.isBackingOff(ctx, key))
 }
+
+func TestDynamicCache_ListMetadata(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scheme := runtime.NewScheme()
+	metav1.AddMetaToScheme(scheme)
+
+	secret := &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "secret"},
+	}
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, secret)
+
+	secretsGVR := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+	client := clusterfake.NewMockClientInterface(controller)
+	client.EXPECT().Resource(gvk.Secret.GroupKind()).Return(secretsGVR, true, nil).AnyTimes()
+	client.EXPECT().MetadataClient().Return(metadataClient, nil).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dc, err := NewDynamicCache(ctx, client, Access(allowAccess{}), MetadataOnly(gvk.Secret))
+	require.NoError(t, err)
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret"}
+
+	list, _, err := dc.ListMetadata(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "secret", list.Items[0].Name)
+
+	require.Eventually(t, func() bool {
+		return !dc.IsLoading(ctx, key)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, dc.metadataSynced.hasSynced(key))
+
+	list, _, err = dc.ListMetadata(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "secret", list.Items[0].Name)
+
+	assert.False(t, dc.seenGVKs.hasSeen("default", gvk.Secret), "full object informer was started")
+}
+
+func TestDynamicCache_List_metadataOnly(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	secret := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), secret)
+
+	secretsGVR := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+	client := clusterfake.NewMockClientInterface(controller)
+	client.EXPECT().Resource(gvk.Secret.GroupKind()).Return(secretsGVR, true, nil).AnyTimes()
+	client.EXPECT().DynamicClient().Return(dynamicClient, nil).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dc, err := NewDynamicCache(ctx, client, Access(allowAccess{}), MetadataOnly(gvk.Secret))
+	require.NoError(t, err)
+
+	key := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Secret"}
+
+	list, loading, err := dc.List(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, loading)
+	require.Len(t, list.Items, 1)
+
+	key.Name = "secret"
+	object, err := dc.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", object.GetName())
+
+	assert.False(t, dc.seenGVKs.hasSeen(testutil.DefaultNamespace, gvk.Secret), "full object informer was started")
+}
+
+type allowAccess struct{}
+
+var _ ResourceAccess = allowAccess{}
+
+func (allowAccess) HasAccess(context.Context, store.Key, string) error { return nil }
+func (allowAccess) Reset()                                             {}
+func (allowAccess) Get(AccessKey) (bool, bool)                         { return true, true }
+func (allowAccess) Set(AccessKey, bool)                                {}
+func (allowAccess) UpdateClient(cluster.ClientInterface)               {}
+
+func TestDynamicCache_List_paged(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), pod)
+
+	podsGVR := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+	client := clusterfake.NewMockClientInterface(controller)
+	client.EXPECT().Resource(gvk.Pod.GroupKind()).Return(podsGVR, true, nil).AnyTimes()
+	client.EXPECT().DynamicClient().Return(dynamicClient, nil).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dc, err := NewDynamicCache(ctx, client, Access(allowAccess{}))
+	require.NoError(t, err)
+
+	key := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Pod", Limit: 10}
+
+	list, loading, err := dc.List(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, loading)
+	require.Len(t, list.Items, 1)
+
+	assert.False(t, dc.seenGVKs.hasSeen(testutil.DefaultNamespace, gvk.Pod), "informer was started")
+
+	key.Continue = "cache:expired"
+	_, _, err = dc.List(ctx, key)
+	require.Error(t, err)
+}
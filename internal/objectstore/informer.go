@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
@@ -26,6 +27,7 @@ type informerFactory struct {
 	client        cluster.ClientInterface
 	defaultResync time.Duration
 	namespace     string
+	metadataOnly  bool
 
 	lock                 sync.Mutex
 	informers            map[schema.GroupVersionKind]informers.GenericInformer
@@ -49,6 +51,13 @@ func newInformerFactory(stopCh <-chan struct{}, client cluster.ClientInterface,
 	}
 }
 
+// newMetadataInformerFactory creates a factory for informers that only cache object metadata.
+func newMetadataInformerFactory(stopCh <-chan struct{}, client cluster.ClientInterface, defaultResync time.Duration, namespace string) *informerFactory {
+	f := newInformerFactory(stopCh, client, defaultResync, namespace)
+	f.metadataOnly = true
+	return f
+}
+
 func (f *informerFactory) watchErrorHandler(gvk schema.GroupVersionKind, stopCh chan struct{}) cache.WatchErrorHandler {
 	return func(r *cache.Reflector, err error) {
 		f.lock.Lock()
@@ -77,18 +86,10 @@ func (f *informerFactory) ForResource(groupVersionKind schema.GroupVersionKind)
 			groupVersionKind.GroupKind(), err)
 	}
 
-	dynamicClient, err := f.client.DynamicClient()
+	genericInformer, err := f.newInformer(gvr)
 	if err != nil {
-		return nil, fmt.Errorf("get dynamic client: %w", err)
+		return nil, err
 	}
-
-	genericInformer := dynamicinformer.NewFilteredDynamicInformer(
-		dynamicClient,
-		gvr,
-		f.namespace,
-		f.defaultResync,
-		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
-		f.tweakListOptions)
 	f.informers[groupVersionKind] = genericInformer
 
 	genericInformer.Informer().SetWatchErrorHandler(f.watchErrorHandler(groupVersionKind, stopCh))
@@ -102,6 +103,38 @@ func (f *informerFactory) ForResource(groupVersionKind schema.GroupVersionKind)
 	return genericInformer, nil
 }
 
+func (f *informerFactory) newInformer(gvr schema.GroupVersionResource) (informers.GenericInformer, error) {
+	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+
+	if f.metadataOnly {
+		metadataClient, err := f.client.MetadataClient()
+		if err != nil {
+			return nil, fmt.Errorf("get metadata client: %w", err)
+		}
+
+		return metadatainformer.NewFilteredMetadataInformer(
+			metadataClient,
+			gvr,
+			f.namespace,
+			f.defaultResync,
+			indexers,
+			metadatainformer.TweakListOptionsFunc(f.tweakListOptions)), nil
+	}
+
+	dynamicClient, err := f.client.DynamicClient()
+	if err != nil {
+		return nil, fmt.Errorf("get dynamic client: %w", err)
+	}
+
+	return dynamicinformer.NewFilteredDynamicInformer(
+		dynamicClient,
+		gvr,
+		f.namespace,
+		f.defaultResync,
+		indexers,
+		f.tweakListOptions), nil
+}
+
 // Delete deletes an informer given a a group/version/resource.
 func (f *informerFactory) Delete(groupVersionKind schema.GroupVersionKind) {
 	f.lock.Lock()
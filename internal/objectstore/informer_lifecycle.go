@@ -0,0 +1,371 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// informerSweepInterval is how often idle informers and the informer budget are checked.
+	informerSweepInterval = time.Minute
+
+	// budgetGracePeriod is how long an informer is protected from budget eviction after
+	// it is used, so the informers behind the current view are not evicted.
+	budgetGracePeriod = time.Minute
+
+	// sizeSampleCount is the number of objects sampled to estimate an informer's memory use.
+	sizeSampleCount = 20
+)
+
+// InformerIdleTTL stops informers that have not been used for the duration. Informers
+// with watchers are not stopped. A duration of 0 disables idle eviction.
+func InformerIdleTTL(ttl time.Duration) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		dc.idleTTL = ttl
+	}
+}
+
+// InformerBudget limits the objects cached by informers. When cached objects or their
+// estimated size exceed the budget, the least recently used informers are stopped.
+// A limit of 0 is unlimited.
+func InformerBudget(maxObjects int, maxBytes int64) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		dc.maxObjects = maxObjects
+		dc.maxBytes = maxBytes
+	}
+}
+
+// InformerStatsProvider provides statistics about informers.
+type InformerStatsProvider interface {
+	InformerStats() InformerStats
+}
+
+// InformerStats are statistics about the informers a DynamicCache has started.
+type InformerStats struct {
+	Informers  []InformerStat
+	Evictions  int
+	Factories  int
+	IdleTTL    time.Duration
+	MaxObjects int
+	MaxBytes   int64
+}
+
+// Objects returns the number of objects cached by all informers.
+func (s InformerStats) Objects() int {
+	total := 0
+	for _, stat := range s.Informers {
+		total += stat.Objects
+	}
+	return total
+}
+
+// EstimatedBytes returns the estimated size of objects cached by all informers.
+func (s InformerStats) EstimatedBytes() int64 {
+	var total int64
+	for _, stat := range s.Informers {
+		total += stat.EstimatedBytes
+	}
+	return total
+}
+
+// InformerStat are statistics about an informer.
+type InformerStat struct {
+	GroupVersionKind schema.GroupVersionKind
+	Namespaces       []string
+	MetadataOnly     bool
+	Watched          bool
+	Objects          int
+	EstimatedBytes   int64
+	LastAccess       time.Time
+}
+
+type informerKey struct {
+	factory          InformerFactory
+	groupVersionKind schema.GroupVersionKind
+	metadataOnly     bool
+}
+
+type trackedInformer struct {
+	informer   informers.GenericInformer
+	namespaces map[string]bool
+	keys       map[string]store.Key
+	lastAccess time.Time
+	watched    bool
+}
+
+// informerTracker tracks use of the informers a DynamicCache starts.
+type informerTracker struct {
+	informers map[informerKey]*trackedInformer
+	evictions int
+
+	mu sync.Mutex
+}
+
+func initInformerTracker() *informerTracker {
+	return &informerTracker{
+		informers: make(map[informerKey]*trackedInformer),
+	}
+}
+
+func (t *informerTracker) touch(key informerKey, informer informers.GenericInformer, storeKey store.Key, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tracked, ok := t.informers[key]
+	if !ok || tracked.informer != informer {
+		tracked = &trackedInformer{
+			informer:   informer,
+			namespaces: make(map[string]bool),
+			keys:       make(map[string]store.Key),
+		}
+		t.informers[key] = tracked
+	}
+
+	tracked.namespaces[storeKey.Namespace] = true
+	tracked.keys[storeKey.String()] = storeKey
+	tracked.lastAccess = now
+}
+
+// setWatched marks an informer as having watchers, so it is never evicted.
+func (t *informerTracker) setWatched(informer informers.GenericInformer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, tracked := range t.informers {
+		if tracked.informer == informer {
+			tracked.watched = true
+		}
+	}
+}
+
+func (t *informerTracker) remove(key informerKey) (*trackedInformer, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tracked, ok := t.informers[key]
+	if !ok {
+		return nil, false
+	}
+
+	delete(t.informers, key)
+	t.evictions++
+	return tracked, true
+}
+
+func (t *informerTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key := range t.informers {
+		delete(t.informers, key)
+	}
+}
+
+type informerUsage struct {
+	key     informerKey
+	stat    InformerStat
+	tracked *trackedInformer
+}
+
+// usage measures the informers being tracked. It is sorted from least to most
+// recently used.
+func (t *informerTracker) usage() ([]informerUsage, int) {
+	t.mu.Lock()
+	var list []informerUsage
+	for key, tracked := range t.informers {
+		var namespaces []string
+		for namespace := range tracked.namespaces {
+			namespaces = append(namespaces, namespace)
+		}
+		sort.Strings(namespaces)
+
+		list = append(list, informerUsage{
+			key:     key,
+			tracked: tracked,
+			stat: InformerStat{
+				GroupVersionKind: key.groupVersionKind,
+				Namespaces:       namespaces,
+				MetadataOnly:     key.metadataOnly,
+				Watched:          tracked.watched,
+				LastAccess:       tracked.lastAccess,
+			},
+		})
+	}
+	evictions := t.evictions
+	t.mu.Unlock()
+
+	for i := range list {
+		list[i].stat.Objects, list[i].stat.EstimatedBytes = measureInformer(list[i].tracked.informer)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].stat.LastAccess.Before(list[j].stat.LastAccess)
+	})
+
+	return list, evictions
+}
+
+// measureInformer returns the number of objects an informer caches and an estimate
+// of their size. The size is estimated from the encoded size of a sample of objects.
+func measureInformer(informer informers.GenericInformer) (int, int64) {
+	objects := informer.Informer().GetStore().List()
+	if len(objects) == 0 {
+		return 0, 0
+	}
+
+	step := len(objects) / sizeSampleCount
+	if step < 1 {
+		step = 1
+	}
+
+	var sampled, sampleBytes int64
+	for i := 0; i < len(objects); i += step {
+		data, err := json.Marshal(objects[i])
+		if err != nil {
+			continue
+		}
+		sampled++
+		sampleBytes += int64(len(data))
+	}
+
+	if sampled == 0 {
+		return len(objects), 0
+	}
+
+	return len(objects), sampleBytes / sampled * int64(len(objects))
+}
+
+// InformerStats returns statistics about the informers the cache has started.
+func (dc *DynamicCache) InformerStats() InformerStats {
+	usage, evictions := dc.informers.usage()
+
+	stats := InformerStats{
+		Evictions:  evictions,
+		Factories:  len(dc.factories.keys()) + len(dc.metadataFactories.keys()),
+		IdleTTL:    dc.idleTTL,
+		MaxObjects: dc.maxObjects,
+		MaxBytes:   dc.maxBytes,
+	}
+
+	for i := range usage {
+		stats.Informers = append(stats.Informers, usage[i].stat)
+	}
+
+	return stats
+}
+
+func (dc *DynamicCache) trackInformer(factory InformerFactory, informer informers.GenericInformer, key store.Key, metadataOnly bool) {
+	dc.informers.touch(informerKey{
+		factory:          factory,
+		groupVersionKind: key.GroupVersionKind(),
+		metadataOnly:     metadataOnly,
+	}, informer, key, time.Now())
+}
+
+// runInformerSweeper periodically stops idle informers and enforces the informer budget.
+func (dc *DynamicCache) runInformerSweeper(ctx context.Context) {
+	if dc.idleTTL == 0 && dc.maxObjects == 0 && dc.maxBytes == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(informerSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			dc.sweepInformers(ctx, now)
+		}
+	}
+}
+
+// sweepInformers stops informers that have been idle past the TTL. Then, while the
+// cached objects exceed the budget, it stops the least recently used informers.
+func (dc *DynamicCache) sweepInformers(ctx context.Context, now time.Time) {
+	logger := log.From(ctx).With("component", "DynamicCache")
+
+	usage, _ := dc.informers.usage()
+
+	var objects int
+	var bytes int64
+	var remaining []informerUsage
+
+	for _, u := range usage {
+		if dc.idleTTL > 0 && !u.stat.Watched && now.Sub(u.stat.LastAccess) > dc.idleTTL {
+			logger.With("gvk", u.key.groupVersionKind, "metadataOnly", u.key.metadataOnly).
+				Debugf("stopping idle informer")
+			dc.evictInformer(u.key)
+			continue
+		}
+
+		objects += u.stat.Objects
+		bytes += u.stat.EstimatedBytes
+		remaining = append(remaining, u)
+	}
+
+	overBudget := func() bool {
+		return (dc.maxObjects > 0 && objects > dc.maxObjects) ||
+			(dc.maxBytes > 0 && bytes > dc.maxBytes)
+	}
+
+	for _, u := range remaining {
+		if !overBudget() {
+			break
+		}
+
+		if u.stat.Watched || now.Sub(u.stat.LastAccess) < budgetGracePeriod {
+			continue
+		}
+
+		logger.With("gvk", u.key.groupVersionKind, "metadataOnly", u.key.metadataOnly, "objects", u.stat.Objects).
+			Debugf("stopping informer to stay within budget")
+		dc.evictInformer(u.key)
+
+		objects -= u.stat.Objects
+		bytes -= u.stat.EstimatedBytes
+	}
+}
+
+// evictInformer stops an informer. It will be started again the next time its GVK
+// is requested.
+func (dc *DynamicCache) evictInformer(key informerKey) {
+	tracked, ok := dc.informers.remove(key)
+	if !ok {
+		return
+	}
+
+	dc.updateMu.Lock()
+	defer dc.updateMu.Unlock()
+
+	key.factory.Delete(key.groupVersionKind)
+
+	synced := dc.informerSynced
+	if key.metadataOnly {
+		synced = dc.metadataSynced
+	}
+	for _, storeKey := range tracked.keys {
+		synced.remove(storeKey)
+	}
+
+	if !key.metadataOnly {
+		for namespace := range tracked.namespaces {
+			dc.seenGVKs.setSeen(namespace, key.groupVersionKind, false)
+		}
+	}
+}
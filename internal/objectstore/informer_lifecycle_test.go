@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/objectstore/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestDynamicCache_sweepInformers(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		idleTTL    time.Duration
+		maxObjects int
+		informers  []testInformer
+		evicted    []schema.GroupVersionKind
+	}{
+		{
+			name:    "idle informers are stopped",
+			idleTTL: 10 * time.Minute,
+			informers: []testInformer{
+				{gvk: gvk.Pod, objects: 1, lastAccess: now.Add(-20 * time.Minute)},
+				{gvk: gvk.Deployment, objects: 1, lastAccess: now.Add(-20 * time.Minute), watched: true},
+				{gvk: gvk.Service, objects: 1, lastAccess: now.Add(-5 * time.Minute)},
+			},
+			evicted: []schema.GroupVersionKind{gvk.Pod},
+		},
+		{
+			name:       "least recently used informers are stopped when over budget",
+			maxObjects: 2,
+			informers: []testInformer{
+				{gvk: gvk.Pod, objects: 2, lastAccess: now.Add(-10 * time.Minute)},
+				{gvk: gvk.Deployment, objects: 1, lastAccess: now.Add(-5 * time.Minute)},
+				{gvk: gvk.Service, objects: 1, lastAccess: now},
+			},
+			evicted: []schema.GroupVersionKind{gvk.Pod},
+		},
+		{
+			name:       "recently used informers are not stopped",
+			maxObjects: 1,
+			informers: []testInformer{
+				{gvk: gvk.Pod, objects: 2, lastAccess: now.Add(-10 * time.Second)},
+				{gvk: gvk.Service, objects: 2, lastAccess: now},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			factory := fake.NewMockInformerFactory(controller)
+			for _, evicted := range test.evicted {
+				factory.EXPECT().Delete(evicted)
+			}
+
+			dc := &DynamicCache{
+				factories:         initFactoriesCache(),
+				metadataFactories: initFactoriesCache(),
+				seenGVKs:          initSeenGVKsCache(),
+				informerSynced:    initInformerSynced(),
+				metadataSynced:    initInformerSynced(),
+				informers:         initInformerTracker(),
+				idleTTL:           test.idleTTL,
+				maxObjects:        test.maxObjects,
+			}
+			dc.factories.set("default", factory)
+
+			for _, ti := range test.informers {
+				key := store.Key{Namespace: "default", APIVersion: ti.gvk.GroupVersion().String(), Kind: ti.gvk.Kind}
+				dc.seenGVKs.setSeen("default", ti.gvk, true)
+				dc.informerSynced.setSynced(key, true)
+
+				informer := newTestGenericInformer(t, ti.objects)
+				dc.informers.touch(informerKey{factory: factory, groupVersionKind: ti.gvk}, informer, key, ti.lastAccess)
+				if ti.watched {
+					dc.informers.setWatched(informer)
+				}
+			}
+
+			dc.sweepInformers(context.Background(), now)
+
+			stats := dc.InformerStats()
+			assert.Equal(t, len(test.evicted), stats.Evictions)
+			require.Len(t, stats.Informers, len(test.informers)-len(test.evicted))
+
+			for _, evicted := range test.evicted {
+				key := store.Key{Namespace: "default", APIVersion: evicted.GroupVersion().String(), Kind: evicted.Kind}
+				assert.False(t, dc.seenGVKs.hasSeen("default", evicted))
+				assert.False(t, dc.informerSynced.hasSeen(key))
+
+				for _, stat := range stats.Informers {
+					assert.NotEqual(t, evicted, stat.GroupVersionKind)
+				}
+			}
+		})
+	}
+}
+
+func TestDynamicCache_InformerStats(t *testing.T) {
+	dc := &DynamicCache{
+		factories:         initFactoriesCache(),
+		metadataFactories: initFactoriesCache(),
+		informers:         initInformerTracker(),
+		idleTTL:           time.Minute,
+		maxObjects:        10,
+		maxBytes:          1024,
+	}
+
+	now := time.Now()
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret"}
+	dc.informers.touch(informerKey{groupVersionKind: gvk.Secret, metadataOnly: true}, newTestGenericInformer(t, 3), key, now)
+
+	stats := dc.InformerStats()
+	assert.Equal(t, time.Minute, stats.IdleTTL)
+	assert.Equal(t, 10, stats.MaxObjects)
+	assert.Equal(t, int64(1024), stats.MaxBytes)
+	assert.Equal(t, 3, stats.Objects())
+	assert.True(t, stats.EstimatedBytes() > 0)
+
+	require.Len(t, stats.Informers, 1)
+	stat := stats.Informers[0]
+	assert.Equal(t, gvk.Secret, stat.GroupVersionKind)
+	assert.Equal(t, []string{"default"}, stat.Namespaces)
+	assert.True(t, stat.MetadataOnly)
+	assert.Equal(t, now, stat.LastAccess)
+}
+
+type testInformer struct {
+	gvk        schema.GroupVersionKind
+	objects    int
+	lastAccess time.Time
+	watched    bool
+}
+
+type testGenericInformer struct {
+	informer kcache.SharedIndexInformer
+}
+
+var _ informers.GenericInformer = (*testGenericInformer)(nil)
+
+func newTestGenericInformer(t *testing.T, objects int) *testGenericInformer {
+	informer := kcache.NewSharedIndexInformer(nil, &unstructured.Unstructured{}, 0, kcache.Indexers{})
+	for i := 0; i < objects; i++ {
+		object := &unstructured.Unstructured{}
+		object.SetNamespace("default")
+		object.SetName(fmt.Sprintf("object-%d", i))
+		require.NoError(t, informer.GetStore().Add(object))
+	}
+
+	return &testGenericInformer{informer: informer}
+}
+
+func (i *testGenericInformer) Informer() kcache.SharedIndexInformer {
+	return i.informer
+}
+
+func (i *testGenericInformer) Lister() kcache.GenericLister {
+	return kcache.NewGenericLister(i.informer.GetIndexer(), schema.GroupResource{})
+}
@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opencensus.io/trace"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+var _ store.MetadataLister = (*DynamicCache)(nil)
+
+// ListMetadata lists metadata for objects. If full objects are already cached for the
+// key's GVK, their metadata is returned. Otherwise a metadata informer is started, so
+// only object metadata is cached. Keys with a field selector or a limit are listed
+// from the cluster.
+func (dc *DynamicCache) ListMetadata(ctx context.Context, key store.Key) (*metav1.PartialObjectMetadataList, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "dynamicCache:listMetadata")
+	defer span.End()
+
+	if dc.isBackingOff(ctx, key) {
+		return &metav1.PartialObjectMetadataList{}, false, nil
+	}
+
+	if err := dc.access.HasAccess(ctx, key, "list"); err != nil {
+		if meta.IsNoMatchError(err) {
+			return &metav1.PartialObjectMetadataList{}, false, nil
+		}
+		if !dc.isBackingOff(ctx, key) {
+			dc.backoff(ctx, key)
+		}
+		return nil, false, fmt.Errorf("check access to list %s: %w", key, err)
+	}
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("namespace", key.Namespace),
+		trace.StringAttribute("apiVersion", key.APIVersion),
+		trace.StringAttribute("kind", key.Kind),
+	}, "list metadata key")
+
+	if key.FieldSelector != nil || key.IsPaged() {
+		list, err := dc.listMetadataFromClient(ctx, key)
+		return list, false, err
+	}
+
+	if dc.seenGVKs.hasSeen(key.Namespace, key.GroupVersionKind()) {
+		list, loading, err := dc.listFromInformer(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+
+		metadataList, err := store.ToPartialObjectMetadataList(list)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return metadataList, loading, nil
+	}
+
+	return dc.listMetadataFromInformer(ctx, key)
+}
+
+func (dc *DynamicCache) listMetadataFromInformer(ctx context.Context, key store.Key) (*metav1.PartialObjectMetadataList, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "dynamicCache:listMetadata:informer")
+	defer span.End()
+
+	informer, hasSynced, err := dc.currentMetadataInformer(ctx, key)
+	if err != nil {
+		return nil, false, fmt.Errorf("retrieving metadata informer for %+v: %w", key, err)
+	}
+
+	if !hasSynced {
+		list, err := dc.listMetadataFromClient(ctx, key)
+		return list, false, err
+	}
+
+	var l lister
+	if key.Namespace == "" {
+		l = informer.Lister()
+	} else {
+		l = informer.Lister().ByNamespace(key.Namespace)
+	}
+
+	var selector = kLabels.Everything()
+	if key.Selector != nil {
+		selector = key.Selector.AsSelector()
+	}
+
+	objects, err := l.List(selector)
+	if err != nil {
+		return nil, false, fmt.Errorf("listing metadata %v: %w", key, err)
+	}
+
+	list := &metav1.PartialObjectMetadataList{}
+	for i := range objects {
+		object, ok := objects[i].(*metav1.PartialObjectMetadata)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected metadata object %T", objects[i])
+		}
+		list.Items = append(list.Items, *object)
+	}
+
+	return list, false, nil
+}
+
+func (dc *DynamicCache) listMetadataFromClient(ctx context.Context, key store.Key) (*metav1.PartialObjectMetadataList, error) {
+	_, span := trace.StartSpan(ctx, "dynamicCache:listMetadata:metadataClient")
+	defer span.End()
+
+	metadataClient, err := dc.client.MetadataClient()
+	if err != nil {
+		return nil, err
+	}
+
+	gvr, _, err := dc.client.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := key.ListOptions()
+	if key.Namespace == "" {
+		return metadataClient.Resource(gvr).List(ctx, listOptions)
+	}
+
+	return metadataClient.Resource(gvr).Namespace(key.Namespace).List(ctx, listOptions)
+}
+
+func (dc *DynamicCache) currentMetadataInformer(ctx context.Context, key store.Key) (informers.GenericInformer, bool, error) {
+	if dc.client == nil {
+		return nil, false, errors.New("cluster client is nil")
+	}
+
+	factory, err := dc.metadataFactory(ctx, key.Namespace)
+	if err != nil {
+		return nil, false, err
+	}
+
+	gvk := key.GroupVersionKind()
+	informer, err := factory.ForResource(gvk)
+	if err != nil {
+		return nil, false, fmt.Errorf("find metadata informer for %s: %w", gvk, err)
+	}
+
+	dc.checkMetadataSynced(informer, key)
+	dc.trackInformer(factory, informer, key, true)
+
+	return informer, dc.metadataSynced.hasSynced(key), nil
+}
+
+// metadataFactory returns the metadata informer factory for a namespace. Like full
+// object informers, a single factory is shared by all namespaces if the user can
+// watch all namespaces.
+func (dc *DynamicCache) metadataFactory(ctx context.Context, namespace string) (InformerFactory, error) {
+	if factory, ok := dc.metadataFactories.get(namespace); ok {
+		return factory, nil
+	}
+
+	factoryNamespace := metav1.NamespaceAll
+	if err := dc.access.HasAccess(ctx, store.Key{Namespace: metav1.NamespaceAll}, "watch"); err != nil {
+		factoryNamespace = namespace
+	}
+
+	factory, ok := dc.metadataFactories.get(factoryNamespace)
+	if !ok {
+		var err error
+		factory, err = dc.initMetadataFactoryFunc(context.Background(), dc.client, factoryNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("initialize metadata informer factory: %w", err)
+		}
+		dc.metadataFactories.set(factoryNamespace, factory)
+	}
+
+	dc.metadataFactories.set(namespace, factory)
+
+	return factory, nil
+}
+
+// checkMetadataSynced waits for a metadata informer to sync in the background the
+// first time it is used for a key. Until it has synced, metadata is listed from the cluster.
+func (dc *DynamicCache) checkMetadataSynced(informer informers.GenericInformer, key store.Key) {
+	dc.updateMu.Lock()
+	defer dc.updateMu.Unlock()
+
+	if dc.metadataSynced.hasSeen(key) {
+		return
+	}
+
+	dc.metadataSynced.setSynced(key, false)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultInformerResync)
+		defer cancel()
+
+		if !kcache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced) {
+			// check again the next time the informer is used
+			dc.metadataSynced.remove(key)
+			return
+		}
+
+		dc.metadataSynced.setSynced(key, true)
+	}()
+}
+
+func (dc *DynamicCache) isMetadataOnly(key store.Key) bool {
+	return dc.metadataOnly[key.GroupVersionKind()] && !dc.seenGVKs.hasSeen(key.Namespace, key.GroupVersionKind())
+}
@@ -47,9 +47,14 @@ func TestDefaultVisitor_Visit_use_typed_visitor(t *testing.T) {
 		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true)
 	tvList := []objectvisitor.TypedVisitor{tv}
 
+	pluginHandler := ovFake.NewMockDefaultTypedVisitor(controller)
+	pluginHandler.EXPECT().
+		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true).Return(nil)
+
 	dv, err := objectvisitor.NewDefaultVisitor(dashConfig, q,
 		objectvisitor.SetDefaultHandler(defaultHandler),
-		objectvisitor.SetTypedVisitors(tvList))
+		objectvisitor.SetTypedVisitors(tvList),
+		objectvisitor.SetPluginHandler(pluginHandler))
 	require.NoError(t, err)
 
 	ctx := context.Background()
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+)
+
+// Plugin is a visitor for objects plugins declare as related to an object.
+type Plugin struct {
+	dashConfig config.Dash
+}
+
+var _ DefaultTypedVisitor = (*Plugin)(nil)
+
+// NewPlugin creates an instance of Plugin.
+func NewPlugin(dashConfig config.Dash) *Plugin {
+	return &Plugin{
+		dashConfig: dashConfig,
+	}
+}
+
+// Visit visits an object. It asks plugins for related objects and adds an
+// edge to each of them. Plugin failures are logged rather than returned, so a
+// failing plugin doesn't prevent the rest of the graph from being shown.
+func (p *Plugin) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitPluginRelatedObjects")
+	defer span.End()
+
+	pluginManager := p.dashConfig.PluginManager()
+	if pluginManager == nil {
+		return nil
+	}
+
+	logger := log.From(ctx).With("object", kubernetes.PrintObject(object))
+
+	resp, err := pluginManager.RelatedObjects(ctx, object)
+	if err != nil {
+		logger.WithErr(err).Errorf("find plugin related objects")
+		return nil
+	}
+
+	objectStore := p.dashConfig.ObjectStore()
+
+	var g errgroup.Group
+
+	for i := range resp.Keys {
+		key := resp.Keys[i]
+		g.Go(func() error {
+			related, err := objectStore.Get(ctx, key)
+			if err != nil {
+				if !kerrors.IsNotFound(err) {
+					logger.With("key", key.String()).WithErr(err).Errorf("get plugin related object")
+				}
+				return nil
+			}
+
+			if related == nil {
+				return nil
+			}
+
+			if err := visitor.Visit(ctx, related, handler, visitDescendants); err != nil {
+				logger.With("related", kubernetes.PrintObject(related)).WithErr(err).Errorf("visit plugin related object")
+				return nil
+			}
+
+			return handler.AddEdge(ctx, object, related)
+		})
+	}
+
+	return g.Wait()
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestPlugin_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	secret := testutil.CreateSecret("secret")
+	missing := store.Key{
+		Namespace:  "namespace",
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Name:       "missing",
+	}
+
+	object := testutil.CreatePod("pod")
+	u := testutil.ToUnstructured(t, object)
+
+	secretKey, err := store.KeyFromObject(secret)
+	require.NoError(t, err)
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		RelatedObjects(gomock.Any(), u).
+		Return(&plugin.RelatedObjectsResponse{Keys: []store.Key{secretKey, missing}}, nil)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), secretKey).
+		Return(testutil.ToUnstructured(t, secret), nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), missing).
+		Return(nil, kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "missing"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, secret)).
+		Return(nil)
+
+	var visited []unstructured.Unstructured
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), gomock.Any(), handler, true).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool) error {
+			visited = append(visited, *object)
+			return nil
+		})
+
+	p := objectvisitor.NewPlugin(dashConfig)
+
+	ctx := context.Background()
+	err = p.Visit(ctx, u, handler, visitor, true)
+	require.NoError(t, err)
+
+	expected := testutil.ToUnstructuredList(t, secret)
+	assert.Equal(t, expected.Items, visited)
+}
+
+func TestPlugin_Visit_plugin_failure(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := testutil.CreatePod("pod")
+	u := testutil.ToUnstructured(t, object)
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		RelatedObjects(gomock.Any(), u).
+		Return(nil, fmt.Errorf("plugin failed"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+
+	p := objectvisitor.NewPlugin(dashConfig)
+
+	ctx := context.Background()
+	require.NoError(t, p.Visit(ctx, u, handler, visitor, true))
+}
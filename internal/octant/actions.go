@@ -12,17 +12,37 @@ import (
 )
 
 const (
-	ActionDeleteObject            = "action.octant.dev/deleteObject"
-	ActionOverviewCordon          = "action.octant.dev/cordon"
-	ActionOverviewUncordon        = "action.octant.dev/uncordon"
-	ActionOverviewContainerEditor = "action.octant.dev/containerEditor"
-	ActionOverviewCronjob         = "action.octant.dev/cronJob"
-	ActionOverviewSuspendCronjob  = "action.octant.dev/suspendCronJob"
-	ActionOverviewResumeCronjob   = "action.octant.dev/resumeCronJob"
-	ActionOverviewServiceEditor   = "action.octant.dev/serviceEditor"
-	ActionDeploymentConfiguration = "action.octant.dev/deploymentConfiguration"
-	ActionUpdateObject            = "action.octant.dev/update"
-	ActionApplyYaml               = "action.octant.dev/apply"
+	ActionDeleteObject               = "action.octant.dev/deleteObject"
+	ActionOverviewCordon             = "action.octant.dev/cordon"
+	ActionOverviewUncordon           = "action.octant.dev/uncordon"
+	ActionOverviewContainerEditor    = "action.octant.dev/containerEditor"
+	ActionOverviewCronjob            = "action.octant.dev/cronJob"
+	ActionOverviewSuspendCronjob     = "action.octant.dev/suspendCronJob"
+	ActionOverviewResumeCronjob      = "action.octant.dev/resumeCronJob"
+	ActionOverviewServiceEditor      = "action.octant.dev/serviceEditor"
+	ActionDeploymentConfiguration    = "action.octant.dev/deploymentConfiguration"
+	ActionDeploymentRestart          = "action.octant.dev/deploymentRestart"
+	ActionDeploymentPause            = "action.octant.dev/deploymentPause"
+	ActionDeploymentResume           = "action.octant.dev/deploymentResume"
+	ActionDeploymentRollback         = "action.octant.dev/deploymentRollback"
+	ActionRolloutRestart             = "action.octant.dev/rolloutRestart"
+	ActionStatefulSetPartition       = "action.octant.dev/statefulSetPartition"
+	ActionControllerRevisionRollback = "action.octant.dev/controllerRevisionRollback"
+	ActionScale                      = "action.octant.dev/scale"
+	ActionEditObjectFields           = "action.octant.dev/editObjectFields"
+	ActionSecretReveal               = "action.octant.dev/secretReveal"
+	ActionSecretDataUpdate           = "action.octant.dev/secretDataUpdate"
+	ActionSecretDataDelete           = "action.octant.dev/secretDataDelete"
+	ActionConfigMapDataUpdate        = "action.octant.dev/configMapDataUpdate"
+	ActionConfigMapDataDelete        = "action.octant.dev/configMapDataDelete"
+	ActionConfigMapRestartConsumers  = "action.octant.dev/configMapRestartConsumers"
+	ActionUpdateObject               = "action.octant.dev/update"
+	ActionApplyYaml                  = "action.octant.dev/apply"
+	ActionApplyYamlPreview           = "action.octant.dev/applyPreview"
+	ActionCreateTemplatePreview      = "action.octant.dev/createTemplatePreview"
+	ActionCreateFromTemplate         = "action.octant.dev/createFromTemplate"
+	ActionUpdatePluginConfig         = "action.octant.dev/updatePluginConfiguration"
+	ActionCaptureSnapshot            = "action.octant.dev/captureSnapshot"
 )
 
 func sendAlert(alerter action.Alerter, alertType action.AlertType, message string, expiration *time.Time) {
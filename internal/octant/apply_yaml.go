@@ -16,20 +16,38 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
+// ApplyYamlOption is an option for configuring ApplyYaml.
+type ApplyYamlOption func(applyYaml *ApplyYaml)
+
+// WithApplyYamlValidator validates yaml against the schemas of its kinds before
+// it is applied.
+func WithApplyYamlValidator(validator *SchemaValidator) ApplyYamlOption {
+	return func(applyYaml *ApplyYaml) {
+		applyYaml.validator = validator
+	}
+}
+
 // ApplyYaml creates a yaml applier
 type ApplyYaml struct {
 	logger      log.Logger
 	objectStore store.Store
+	validator   *SchemaValidator
 }
 
 var _ action.Dispatcher = (*ApplyYaml)(nil)
 
 // NewApplyYaml creates an instance of ApplyYaml
-func NewApplyYaml(logger log.Logger, objectStore store.Store) *ApplyYaml {
-	return &ApplyYaml{
+func NewApplyYaml(logger log.Logger, objectStore store.Store, options ...ApplyYamlOption) *ApplyYaml {
+	applyYaml := &ApplyYaml{
 		logger:      logger,
 		objectStore: objectStore,
 	}
+
+	for _, option := range options {
+		option(applyYaml)
+	}
+
+	return applyYaml
 }
 
 // ActionName returns the name of this action
@@ -47,6 +65,12 @@ func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload
 	}
 	p.logger.Debugf("%s", request)
 
+	if err := p.validator.ValidateYAML(ctx, request.Update); err != nil {
+		message := fmt.Sprintf("Unable to apply yaml: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
 	results, err := p.objectStore.CreateOrUpdateFromYAML(ctx, request.Namespace, request.Update)
 	if err != nil {
 		p.logger.Warnf("unable to apply yaml: %s", err)
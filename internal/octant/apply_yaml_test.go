@@ -333,3 +333,42 @@ data: {
 
 	require.NoError(t, applyYaml.Handle(ctx, alerter, payload))
 }
+
+func TestNewApplyYaml_Invalid(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	logger := log.NopLogger()
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	update := `
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: greeting
+spec:
+  hello: world
+`
+	clusterClient.EXPECT().OpenAPIResources().Return(configMapResources(t), nil)
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeError, alert.Type)
+			assert.Equal(t, "Unable to apply yaml: line 7: spec: unknown field", alert.Message)
+		})
+
+	applyYaml := NewApplyYaml(logger, objectStore, WithApplyYamlValidator(NewSchemaValidator(clusterClient)))
+
+	ctx := context.Background()
+
+	payload := action.CreatePayload(ActionApplyYaml, map[string]interface{}{
+		"update":    update,
+		"namespace": "default",
+	})
+
+	require.NoError(t, applyYaml.Handle(ctx, alerter, payload))
+}
@@ -0,0 +1,510 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// ConfigMapEncodingText and ConfigMapEncodingBase64 are the encodings of
+	// values entered in config map data forms. Text values are stored in data,
+	// and base64 values are stored in binaryData.
+	ConfigMapEncodingText   = "text"
+	ConfigMapEncodingBase64 = "base64"
+)
+
+// ConfigMapChanges tracks which config maps were changed in each session since
+// their consumers were restarted. It is shared by the actions which change
+// config maps and the printer which offers to restart their consumers.
+type ConfigMapChanges struct {
+	changed map[configMapChangeKey]bool
+	mu      sync.RWMutex
+}
+
+type configMapChangeKey struct {
+	session Session
+	key     store.Key
+}
+
+// NewConfigMapChanges creates an instance of ConfigMapChanges.
+func NewConfigMapChanges() *ConfigMapChanges {
+	return &ConfigMapChanges{
+		changed: map[configMapChangeKey]bool{},
+	}
+}
+
+// Change records that a config map was changed in a session.
+func (c *ConfigMapChanges) Change(session Session, key store.Key) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.changed[configMapChangeKey{session: session, key: key}] = true
+}
+
+// Clear records that the consumers of a config map were restarted in a session.
+func (c *ConfigMapChanges) Clear(session Session, key store.Key) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.changed, configMapChangeKey{session: session, key: key})
+}
+
+// IsChanged returns true if a config map was changed in a session since its
+// consumers were restarted.
+func (c *ConfigMapChanges) IsChanged(session Session, key store.Key) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.changed[configMapChangeKey{session: session, key: key}]
+}
+
+// RemoveClient forgets the config maps changed in a client's sessions.
+func (c *ConfigMapChanges) RemoveClient(clientID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for changeKey := range c.changed {
+		if changeKey.session.ClientID == clientID {
+			delete(c.changed, changeKey)
+		}
+	}
+}
+
+// ConfigMapUsage describes how a pod or workload uses a config map.
+type ConfigMapUsage struct {
+	Description string
+	// NeedsRestart is true if the pods do not see changes to the config map
+	// until they are restarted. Environment variables and subPath mounts are
+	// only read when a container starts.
+	NeedsRestart bool
+}
+
+// ConfigMapConsumer is a pod or workload which uses a config map.
+type ConfigMapConsumer struct {
+	Key    store.Key
+	Usages []ConfigMapUsage
+}
+
+// NeedsRestart returns true if any of the consumer's usages need a restart to
+// see changes to the config map.
+func (c ConfigMapConsumer) NeedsRestart() bool {
+	for _, usage := range c.Usages {
+		if usage.NeedsRestart {
+			return true
+		}
+	}
+	return false
+}
+
+// Restartable returns true if the consumer is a workload which can be
+// restarted by annotating its pod template.
+func (c ConfigMapConsumer) Restartable() bool {
+	return restartableKinds[c.Key.Kind]
+}
+
+var (
+	restartableKinds = map[string]bool{
+		gvk.Deployment.Kind:  true,
+		gvk.StatefulSet.Kind: true,
+		gvk.DaemonSet.Kind:   true,
+	}
+
+	configMapConsumerKinds = []schema.GroupVersionKind{
+		gvk.Deployment,
+		gvk.StatefulSet,
+		gvk.DaemonSet,
+		gvk.Pod,
+	}
+)
+
+// ConfigMapConsumers returns the workloads and pods in a namespace which use a
+// config map through env, envFrom, or volumes.
+func ConfigMapConsumers(ctx context.Context, objectStore store.Store, namespace, name string) ([]ConfigMapConsumer, error) {
+	if objectStore == nil {
+		return nil, fmt.Errorf("object store is nil")
+	}
+
+	var consumers []ConfigMapConsumer
+
+	for _, groupVersionKind := range configMapConsumerKinds {
+		key := store.KeyFromGroupVersionKind(groupVersionKind)
+		key.Namespace = namespace
+
+		list, _, err := objectStore.List(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", groupVersionKind.Kind, err)
+		}
+		if list == nil {
+			continue
+		}
+
+		for i := range list.Items {
+			object := &list.Items[i]
+
+			spec, err := consumerPodSpec(object)
+			if err != nil {
+				return nil, fmt.Errorf("read pod spec of %s %q: %w", object.GetKind(), object.GetName(), err)
+			}
+
+			usages := configMapUsages(spec, name)
+			if len(usages) == 0 {
+				continue
+			}
+
+			consumers = append(consumers, ConfigMapConsumer{
+				Key: store.Key{
+					Namespace:  object.GetNamespace(),
+					APIVersion: object.GetAPIVersion(),
+					Kind:       object.GetKind(),
+					Name:       object.GetName(),
+				},
+				Usages: usages,
+			})
+		}
+	}
+
+	return consumers, nil
+}
+
+// consumerPodSpec reads the pod spec of a pod, or the pod template spec of a
+// workload.
+func consumerPodSpec(object *unstructured.Unstructured) (corev1.PodSpec, error) {
+	fields := []string{"spec", "template", "spec"}
+	if object.GetKind() == gvk.Pod.Kind {
+		fields = []string{"spec"}
+	}
+
+	m, found, err := unstructured.NestedMap(object.Object, fields...)
+	if err != nil || !found {
+		return corev1.PodSpec{}, err
+	}
+
+	var spec corev1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &spec); err != nil {
+		return corev1.PodSpec{}, err
+	}
+	return spec, nil
+}
+
+// configMapUsages describes how a pod spec uses a config map.
+func configMapUsages(spec corev1.PodSpec, name string) []ConfigMapUsage {
+	var usages []ConfigMapUsage
+
+	volumes := map[string]bool{}
+	for _, volume := range spec.Volumes {
+		if volumeUsesConfigMap(volume, name) {
+			volumes[volume.Name] = true
+		}
+	}
+	mountedVolumes := map[string]bool{}
+
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.ValueFrom == nil || env.ValueFrom.ConfigMapKeyRef == nil || env.ValueFrom.ConfigMapKeyRef.Name != name {
+				continue
+			}
+			usages = append(usages, ConfigMapUsage{
+				Description: fmt.Sprintf("env %s from key %s in container %s",
+					env.Name, env.ValueFrom.ConfigMapKeyRef.Key, container.Name),
+				NeedsRestart: true,
+			})
+		}
+
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef == nil || envFrom.ConfigMapRef.Name != name {
+				continue
+			}
+			usages = append(usages, ConfigMapUsage{
+				Description:  fmt.Sprintf("envFrom in container %s", container.Name),
+				NeedsRestart: true,
+			})
+		}
+
+		for _, mount := range container.VolumeMounts {
+			if !volumes[mount.Name] {
+				continue
+			}
+			mountedVolumes[mount.Name] = true
+
+			usage := ConfigMapUsage{
+				Description: fmt.Sprintf("volume %s mounted at %s in container %s", mount.Name, mount.MountPath, container.Name),
+			}
+			if mount.SubPath != "" || mount.SubPathExpr != "" {
+				usage.Description += " with subPath"
+				usage.NeedsRestart = true
+			}
+			usages = append(usages, usage)
+		}
+	}
+
+	var unmounted []string
+	for volume := range volumes {
+		if !mountedVolumes[volume] {
+			unmounted = append(unmounted, volume)
+		}
+	}
+	sort.Strings(unmounted)
+	for _, volume := range unmounted {
+		usages = append(usages, ConfigMapUsage{Description: fmt.Sprintf("volume %s", volume)})
+	}
+
+	return usages
+}
+
+func volumeUsesConfigMap(volume corev1.Volume, name string) bool {
+	if volume.ConfigMap != nil && volume.ConfigMap.Name == name {
+		return true
+	}
+	if volume.Projected != nil {
+		for _, source := range volume.Projected.Sources {
+			if source.ConfigMap != nil && source.ConfigMap.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ConfigMapDataEditor adds and updates the values of config maps. Text values
+// are stored in data and base64 values are stored in binaryData.
+type ConfigMapDataEditor struct {
+	logger      log.Logger
+	store       store.Store
+	contextName func() string
+	changes     *ConfigMapChanges
+}
+
+var _ action.Dispatcher = (*ConfigMapDataEditor)(nil)
+
+// NewConfigMapDataEditor creates an instance of ConfigMapDataEditor. contextName returns
+// the name of the current cluster context.
+func NewConfigMapDataEditor(logger log.Logger, objectStore store.Store, contextName func() string, changes *ConfigMapChanges) *ConfigMapDataEditor {
+	return &ConfigMapDataEditor{
+		logger:      logger.With("action", ActionConfigMapDataUpdate),
+		store:       objectStore,
+		contextName: contextName,
+		changes:     changes,
+	}
+}
+
+// ActionName returns the name of the action.
+func (c *ConfigMapDataEditor) ActionName() string {
+	return ActionConfigMapDataUpdate
+}
+
+// Handle sets the config map value in the payload.
+func (c *ConfigMapDataEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	key, dataKey, err := configMapDataKeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	value, err := payload.OptionalString("value")
+	if err != nil {
+		return err
+	}
+
+	if errs := validation.IsConfigMapKey(dataKey); len(errs) > 0 {
+		message := fmt.Sprintf("Unable to update %q in config map %q: %s", dataKey, key.Name, strings.Join(errs, "; "))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	field, removedField := "data", "binaryData"
+	switch encoding := payloadSelectString(payload, "encoding"); encoding {
+	case "", ConfigMapEncodingText:
+	case ConfigMapEncodingBase64:
+		field, removedField = "binaryData", "data"
+		value = strings.Join(strings.Fields(value), "")
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			message := fmt.Sprintf("Unable to update %q in config map %q: value is not valid base64", dataKey, key.Name)
+			alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+			return nil
+		}
+	default:
+		return fmt.Errorf("unknown encoding %q", encoding)
+	}
+
+	err = c.store.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		unstructured.RemoveNestedField(object.Object, removedField, dataKey)
+		return unstructured.SetNestedField(object.Object, value, field, dataKey)
+	})
+	if err == nil {
+		c.changes.Change(NewSession(ctx, c.contextName()), key)
+	}
+	sendConfigMapDataAlert(alerter, c.logger, "Updated", key, dataKey, err)
+
+	return nil
+}
+
+// ConfigMapDataDeleter deletes the values of config maps.
+type ConfigMapDataDeleter struct {
+	logger      log.Logger
+	store       store.Store
+	contextName func() string
+	changes     *ConfigMapChanges
+}
+
+var _ action.Dispatcher = (*ConfigMapDataDeleter)(nil)
+
+// NewConfigMapDataDeleter creates an instance of ConfigMapDataDeleter. contextName returns
+// the name of the current cluster context.
+func NewConfigMapDataDeleter(logger log.Logger, objectStore store.Store, contextName func() string, changes *ConfigMapChanges) *ConfigMapDataDeleter {
+	return &ConfigMapDataDeleter{
+		logger:      logger.With("action", ActionConfigMapDataDelete),
+		store:       objectStore,
+		contextName: contextName,
+		changes:     changes,
+	}
+}
+
+// ActionName returns the name of the action.
+func (c *ConfigMapDataDeleter) ActionName() string {
+	return ActionConfigMapDataDelete
+}
+
+// Handle deletes the config map value in the payload from data and binaryData.
+func (c *ConfigMapDataDeleter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	key, dataKey, err := configMapDataKeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	err = c.store.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		unstructured.RemoveNestedField(object.Object, "data", dataKey)
+		unstructured.RemoveNestedField(object.Object, "binaryData", dataKey)
+		return nil
+	})
+	if err == nil {
+		c.changes.Change(NewSession(ctx, c.contextName()), key)
+	}
+	sendConfigMapDataAlert(alerter, c.logger, "Deleted", key, dataKey, err)
+
+	return nil
+}
+
+// ConfigMapConsumerRestart restarts the workloads which consume a config map by
+// annotating their pod templates, as kubectl rollout restart does.
+type ConfigMapConsumerRestart struct {
+	store       store.Store
+	contextName func() string
+	changes     *ConfigMapChanges
+	now         func() time.Time
+}
+
+var _ action.Dispatcher = (*ConfigMapConsumerRestart)(nil)
+
+// NewConfigMapConsumerRestart creates an instance of ConfigMapConsumerRestart.
+// contextName returns the name of the current cluster context.
+func NewConfigMapConsumerRestart(objectStore store.Store, contextName func() string, changes *ConfigMapChanges) *ConfigMapConsumerRestart {
+	return &ConfigMapConsumerRestart{
+		store:       objectStore,
+		contextName: contextName,
+		changes:     changes,
+		now:         time.Now,
+	}
+}
+
+// ActionName returns the name of the action.
+func (c *ConfigMapConsumerRestart) ActionName() string {
+	return ActionConfigMapRestartConsumers
+}
+
+// Handle restarts the workloads which consume the config map in the payload.
+// Pods which are not managed by a workload can't be restarted.
+func (c *ConfigMapConsumerRestart) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	consumers, err := ConfigMapConsumers(ctx, c.store, key.Namespace, key.Name)
+	if err != nil {
+		message := fmt.Sprintf("Unable to restart consumers of config map %q: %s", key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	restartedAt := c.now().Format(time.RFC3339)
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, restartedAt,
+			"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+	}
+
+	var restarted, failed []string
+	for _, consumer := range consumers {
+		if !consumer.Restartable() {
+			continue
+		}
+
+		name := fmt.Sprintf("%s %q", consumer.Key.Kind, consumer.Key.Name)
+		if err := c.store.Update(ctx, consumer.Key, fn); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", name, err))
+			continue
+		}
+		restarted = append(restarted, name)
+	}
+
+	switch {
+	case len(failed) > 0:
+		message := fmt.Sprintf("Unable to restart consumers of config map %q: %s", key.Name, strings.Join(failed, "; "))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+	case len(restarted) == 0:
+		message := fmt.Sprintf("No workloads consume config map %q", key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+		c.changes.Clear(NewSession(ctx, c.contextName()), key)
+	default:
+		message := fmt.Sprintf("Restarted %s", strings.Join(restarted, ", "))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+		c.changes.Clear(NewSession(ctx, c.contextName()), key)
+	}
+
+	return nil
+}
+
+func sendConfigMapDataAlert(alerter action.Alerter, logger log.Logger, verb string, key store.Key, dataKey string, err error) {
+	message := fmt.Sprintf("%s %q in config map %q", verb, dataKey, key.Name)
+	alertType := action.AlertTypeInfo
+	if err != nil {
+		logger.WithErr(err).Errorf("update config map data")
+		message = fmt.Sprintf("Unable to update %q in config map %q: %s", dataKey, key.Name, err)
+		alertType = action.AlertTypeWarning
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+}
+
+func configMapDataKeyFromPayload(payload action.Payload) (store.Key, string, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return store.Key{}, "", err
+	}
+
+	dataKey, err := payload.String("configMapKey")
+	if err != nil {
+		return store.Key{}, "", err
+	}
+	dataKey = strings.TrimSpace(dataKey)
+	if dataKey == "" {
+		return store.Key{}, "", fmt.Errorf("config map key is blank")
+	}
+
+	return key, dataKey, nil
+}
@@ -0,0 +1,380 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+var configMapStoreKey = store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: "config"}
+
+func configMapPayload(actionName string, fields map[string]interface{}) action.Payload {
+	payload := configMapStoreKey.ToActionPayload()
+	for k, v := range fields {
+		payload[k] = v
+	}
+	return action.CreatePayload(actionName, payload)
+}
+
+// expectConfigMapConsumers sets up a store with a deployment which reads the
+// config map through env, a pod which mounts it, and a statefulset and
+// daemonset which don't use it.
+func expectConfigMapConsumers(t *testing.T, objectStore *storeFake.MockStore) {
+	deployment := testutil.CreateDeployment("web", func(d *appsv1.Deployment) {
+		d.Spec.Template.Spec.Containers = []corev1.Container{
+			{
+				Name: "app",
+				Env: []corev1.EnvVar{
+					{
+						Name: "LOG_LEVEL",
+						ValueFrom: &corev1.EnvVarSource{
+							ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+								Key:                  "log_level",
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	pod := testutil.CreatePod("debug", func(p *corev1.Pod) {
+		p.Spec.Volumes = []corev1.Volume{
+			{
+				Name: "settings",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+					},
+				},
+			},
+		}
+		p.Spec.Containers = []corev1.Container{
+			{
+				Name:         "shell",
+				VolumeMounts: []corev1.VolumeMount{{Name: "settings", MountPath: "/etc/settings"}},
+			},
+		}
+	})
+
+	expectList := func(key store.Key, objects ...runtime.Object) {
+		list := &unstructured.UnstructuredList{}
+		for _, object := range objects {
+			list.Items = append(list.Items, *testutil.ToUnstructured(t, object))
+		}
+		objectStore.EXPECT().List(gomock.Any(), key).Return(list, false, nil)
+	}
+
+	namespacedKey := func(groupVersionKind schema.GroupVersionKind) store.Key {
+		key := store.KeyFromGroupVersionKind(groupVersionKind)
+		key.Namespace = "namespace"
+		return key
+	}
+
+	expectList(namespacedKey(gvk.Deployment), deployment)
+	expectList(namespacedKey(gvk.StatefulSet), testutil.CreateStatefulSet("db"))
+	expectList(namespacedKey(gvk.DaemonSet))
+	expectList(namespacedKey(gvk.Pod), pod)
+}
+
+func TestConfigMapChanges_RemoveClient(t *testing.T) {
+	changes := NewConfigMapChanges()
+
+	session := Session{ClientID: "client", ContextName: "cluster"}
+	other := Session{ClientID: "other", ContextName: "cluster"}
+
+	changes.Change(session, configMapStoreKey)
+	changes.Change(Session{ClientID: "client", ContextName: "other"}, configMapStoreKey)
+	changes.Change(other, configMapStoreKey)
+
+	changes.RemoveClient("client")
+
+	assert.Len(t, changes.changed, 1)
+	assert.False(t, changes.IsChanged(session, configMapStoreKey))
+	assert.True(t, changes.IsChanged(other, configMapStoreKey))
+}
+
+func TestConfigMapConsumers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	expectConfigMapConsumers(t, objectStore)
+
+	consumers, err := ConfigMapConsumers(context.Background(), objectStore, "namespace", "config")
+	require.NoError(t, err)
+
+	expected := []ConfigMapConsumer{
+		{
+			Key: store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+			Usages: []ConfigMapUsage{
+				{Description: "env LOG_LEVEL from key log_level in container app", NeedsRestart: true},
+			},
+		},
+		{
+			Key: store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "debug"},
+			Usages: []ConfigMapUsage{
+				{Description: "volume settings mounted at /etc/settings in container shell"},
+			},
+		},
+	}
+	assert.Equal(t, expected, consumers)
+
+	assert.True(t, consumers[0].NeedsRestart())
+	assert.True(t, consumers[0].Restartable())
+	assert.False(t, consumers[1].NeedsRestart())
+	assert.False(t, consumers[1].Restartable())
+}
+
+func Test_configMapUsages(t *testing.T) {
+	spec := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: "projected",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}},
+						},
+					},
+				},
+			},
+			{
+				Name: "unmounted",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}},
+				},
+			},
+			{
+				Name: "other",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "other"}},
+				},
+			},
+		},
+		InitContainers: []corev1.Container{
+			{
+				Name: "init",
+				EnvFrom: []corev1.EnvFromSource{
+					{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}},
+					{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "other"}}},
+				},
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Name: "app",
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "projected", MountPath: "/etc/app.conf", SubPath: "app.conf"},
+					{Name: "other", MountPath: "/etc/other"},
+				},
+			},
+		},
+	}
+
+	expected := []ConfigMapUsage{
+		{Description: "envFrom in container init", NeedsRestart: true},
+		{Description: "volume projected mounted at /etc/app.conf in container app with subPath", NeedsRestart: true},
+		{Description: "volume unmounted"},
+	}
+	assert.Equal(t, expected, configMapUsages(spec, "config"))
+}
+
+func TestConfigMapDataEditor_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		dataKey         string
+		value           string
+		encoding        interface{}
+		expectedField   string
+		expected        string
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "text",
+			dataKey:         "app.conf",
+			value:           "level=info\n",
+			encoding:        []interface{}{ConfigMapEncodingText},
+			expectedField:   "data",
+			expected:        "level=info\n",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Updated "app.conf" in config map "config"`,
+		},
+		{
+			name:            "base64",
+			dataKey:         "app.conf",
+			value:           "/w\nA=",
+			encoding:        ConfigMapEncodingBase64,
+			expectedField:   "binaryData",
+			expected:        "/wA=",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Updated "app.conf" in config map "config"`,
+		},
+		{
+			name:            "invalid base64",
+			dataKey:         "app.conf",
+			value:           "not base64!",
+			encoding:        ConfigMapEncodingBase64,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to update "app.conf" in config map "config": value is not valid base64`,
+		},
+		{
+			name:            "invalid key",
+			dataKey:         "app/conf",
+			value:           "level=info",
+			encoding:        ConfigMapEncodingText,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to update "app/conf" in config map "config": a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := storeFake.NewMockStore(controller)
+			if test.expected != "" {
+				objectStore.EXPECT().
+					Update(gomock.Any(), configMapStoreKey, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+						configMap := testutil.CreateConfigMap("config")
+						configMap.Data = map[string]string{"app.conf": "old"}
+						configMap.BinaryData = map[string][]byte{"app.conf": []byte("old")}
+						object := testutil.ToUnstructured(t, configMap)
+						require.NoError(t, fn(object))
+
+						value, _, err := unstructured.NestedString(object.Object, test.expectedField, test.dataKey)
+						require.NoError(t, err)
+						assert.Equal(t, test.expected, value)
+
+						removedField := "binaryData"
+						if test.expectedField == "binaryData" {
+							removedField = "data"
+						}
+						_, found, err := unstructured.NestedString(object.Object, removedField, test.dataKey)
+						require.NoError(t, err)
+						assert.False(t, found, "key was not removed from %s", removedField)
+						return nil
+					})
+			}
+
+			alerter := expectAlert(t, controller, test.expectedType, test.expectedMessage)
+
+			changes := NewConfigMapChanges()
+			editor := NewConfigMapDataEditor(log.NopLogger(), objectStore, configMapContextName, changes)
+			assert.Equal(t, ActionConfigMapDataUpdate, editor.ActionName())
+
+			payload := configMapPayload(ActionConfigMapDataUpdate, map[string]interface{}{
+				"configMapKey": test.dataKey,
+				"value":        test.value,
+				"encoding":     test.encoding,
+			})
+			require.NoError(t, editor.Handle(configMapClientContext(), alerter, payload))
+			assert.Equal(t, test.expected != "", changes.IsChanged(configMapSession, configMapStoreKey))
+		})
+	}
+}
+
+func TestConfigMapDataDeleter_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), configMapStoreKey, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+			configMap := testutil.CreateConfigMap("config")
+			configMap.Data = map[string]string{"app.conf": "level=info", "other": "value"}
+			configMap.BinaryData = map[string][]byte{"app.conf": []byte("level=info")}
+			object := testutil.ToUnstructured(t, configMap)
+			require.NoError(t, fn(object))
+
+			data, _, err := unstructured.NestedStringMap(object.Object, "data")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"other": "value"}, data)
+
+			binaryData, _, err := unstructured.NestedMap(object.Object, "binaryData")
+			require.NoError(t, err)
+			assert.Empty(t, binaryData)
+			return nil
+		})
+
+	alerter := expectAlert(t, controller, action.AlertTypeInfo, `Deleted "app.conf" in config map "config"`)
+
+	changes := NewConfigMapChanges()
+	deleter := NewConfigMapDataDeleter(log.NopLogger(), objectStore, configMapContextName, changes)
+	assert.Equal(t, ActionConfigMapDataDelete, deleter.ActionName())
+
+	payload := configMapPayload(ActionConfigMapDataDelete, map[string]interface{}{"configMapKey": "app.conf"})
+	require.NoError(t, deleter.Handle(configMapClientContext(), alerter, payload))
+	assert.True(t, changes.IsChanged(configMapSession, configMapStoreKey))
+	assert.False(t, changes.IsChanged(Session{ClientID: "other", ContextName: "cluster"}, configMapStoreKey))
+}
+
+func TestConfigMapConsumerRestart_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	expectConfigMapConsumers(t, objectStore)
+
+	deploymentKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}
+	objectStore.EXPECT().
+		Update(gomock.Any(), deploymentKey, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+			object := testutil.ToUnstructured(t, testutil.CreateDeployment("web"))
+			require.NoError(t, fn(object))
+
+			restartedAt, _, _ := unstructured.NestedString(object.Object,
+				"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+			assert.Equal(t, "2020-06-01T12:00:00Z", restartedAt)
+			return nil
+		})
+
+	alerter := expectAlert(t, controller, action.AlertTypeInfo, `Restarted Deployment "web"`)
+
+	changes := NewConfigMapChanges()
+	changes.Change(configMapSession, configMapStoreKey)
+
+	restart := NewConfigMapConsumerRestart(objectStore, configMapContextName, changes)
+	restart.now = func() time.Time { return time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC) }
+	assert.Equal(t, ActionConfigMapRestartConsumers, restart.ActionName())
+
+	payload := configMapPayload(ActionConfigMapRestartConsumers, nil)
+	require.NoError(t, restart.Handle(configMapClientContext(), alerter, payload))
+	assert.False(t, changes.IsChanged(configMapSession, configMapStoreKey))
+}
+
+var configMapSession = Session{ClientID: "client", ContextName: "cluster"}
+
+func configMapContextName() string {
+	return "cluster"
+}
+
+func configMapClientContext() context.Context {
+	return WithClientID(context.Background(), "client")
+}
@@ -0,0 +1,340 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// PodTemplateRevision is a revision of a StatefulSet or DaemonSet's pod template.
+type PodTemplateRevision struct {
+	Revision           int64
+	ControllerRevision *appsv1.ControllerRevision
+	Template           corev1.PodTemplateSpec
+}
+
+// ChangeCause returns the revision's change cause.
+func (r PodTemplateRevision) ChangeCause() string {
+	return r.ControllerRevision.Annotations[ChangeCauseAnnotation]
+}
+
+// Hash returns the revision's hash. Pods are labeled with the hash of the
+// revision they were created from.
+func (r PodTemplateRevision) Hash() string {
+	return r.ControllerRevision.Labels[appsv1.ControllerRevisionHashLabelKey]
+}
+
+// Matches returns true if a pod's controller-revision-hash label refers to the
+// revision. StatefulSets label pods with the revision's name and DaemonSets
+// label them with the revision's hash.
+func (r PodTemplateRevision) Matches(podHash string) bool {
+	if podHash == "" {
+		return false
+	}
+	return podHash == r.ControllerRevision.Name || podHash == r.Hash()
+}
+
+// ControllerRevisionOwner returns the kind and metadata of StatefulSets and
+// DaemonSets, whose revisions are stored in ControllerRevisions. It returns false
+// for other objects.
+func ControllerRevisionOwner(object runtime.Object) (string, metav1.Object, bool) {
+	switch o := object.(type) {
+	case *appsv1.StatefulSet:
+		return gvk.StatefulSet.Kind, o, true
+	case *appsv1.DaemonSet:
+		return gvk.DaemonSet.Kind, o, true
+	default:
+		return "", nil, false
+	}
+}
+
+// ControllerRevisions returns the revisions of a StatefulSet or DaemonSet, oldest
+// first. The last revision is the one the controller is rolling out.
+func ControllerRevisions(ctx context.Context, objectStore store.Store, kind string, owner metav1.Object) ([]PodTemplateRevision, error) {
+	if owner == nil {
+		return nil, fmt.Errorf("owner is nil")
+	}
+
+	key := store.Key{
+		Namespace:  owner.GetNamespace(),
+		APIVersion: gvk.ControllerRevision.GroupVersion().String(),
+		Kind:       gvk.ControllerRevision.Kind,
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("list controller revisions: %w", err)
+	}
+
+	var revisions []PodTemplateRevision
+	for i := range list.Items {
+		controllerRevision := &appsv1.ControllerRevision{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], controllerRevision); err != nil {
+			return nil, err
+		}
+
+		if !isControlledBy(controllerRevision.OwnerReferences, kind, owner) {
+			continue
+		}
+
+		template, err := controllerRevisionTemplate(controllerRevision)
+		if err != nil {
+			return nil, fmt.Errorf("read template of controller revision %q: %w", controllerRevision.Name, err)
+		}
+
+		revisions = append(revisions, PodTemplateRevision{
+			Revision:           controllerRevision.Revision,
+			ControllerRevision: controllerRevision,
+			Template:           template,
+		})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+
+	return revisions, nil
+}
+
+// controllerRevisionTemplate reads the pod template from a ControllerRevision.
+// StatefulSets and DaemonSets store their revisions as patches which replace
+// the pod template.
+func controllerRevisionTemplate(controllerRevision *appsv1.ControllerRevision) (corev1.PodTemplateSpec, error) {
+	var data struct {
+		Spec struct {
+			Template corev1.PodTemplateSpec `json:"template"`
+		} `json:"spec"`
+	}
+
+	if len(controllerRevision.Data.Raw) == 0 {
+		return corev1.PodTemplateSpec{}, nil
+	}
+
+	if err := json.Unmarshal(controllerRevision.Data.Raw, &data); err != nil {
+		return corev1.PodTemplateSpec{}, err
+	}
+
+	return data.Spec.Template, nil
+}
+
+// RolloutRestart restarts the pods of a StatefulSet or DaemonSet by annotating
+// its pod template.
+type RolloutRestart struct {
+	store store.Store
+	now   func() time.Time
+}
+
+var _ action.Dispatcher = (*RolloutRestart)(nil)
+
+// NewRolloutRestart creates an instance of RolloutRestart.
+func NewRolloutRestart(objectStore store.Store) *RolloutRestart {
+	return &RolloutRestart{
+		store: objectStore,
+		now:   time.Now,
+	}
+}
+
+// ActionName returns the name of the action.
+func (r *RolloutRestart) ActionName() string {
+	return ActionRolloutRestart
+}
+
+// Handle restarts a StatefulSet or DaemonSet.
+func (r *RolloutRestart) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", r.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	restartedAt := r.now().Format(time.RFC3339)
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, restartedAt,
+			"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+	}
+
+	sendUpdateAlert(alerter, r.store.Update(ctx, key, fn),
+		fmt.Sprintf("Restarted %s %q", key.Kind, key.Name),
+		fmt.Sprintf("Unable to restart %s %q", key.Kind, key.Name))
+
+	return nil
+}
+
+// StatefulSetPartition sets the partition of a StatefulSet's rolling update.
+// Pods with an ordinal lower than the partition are not updated.
+type StatefulSetPartition struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*StatefulSetPartition)(nil)
+
+// NewStatefulSetPartition creates an instance of StatefulSetPartition.
+func NewStatefulSetPartition(objectStore store.Store) *StatefulSetPartition {
+	return &StatefulSetPartition{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of the action.
+func (s *StatefulSetPartition) ActionName() string {
+	return ActionStatefulSetPartition
+}
+
+// Handle sets the partition in the payload. Partitions can only be set for
+// StatefulSets which use the RollingUpdate strategy.
+func (s *StatefulSetPartition) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", s.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	partitionFloat, err := payload.Float64("partition")
+	if err != nil {
+		return err
+	}
+	partition := roundToInt(partitionFloat)
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	if partition < 0 {
+		message := fmt.Sprintf("Unable to set partition of StatefulSet %q: partition must not be negative", key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	object, err := s.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return fmt.Errorf("statefulset %q not found", key.Name)
+	}
+
+	statefulSet := &appsv1.StatefulSet{}
+	if err := kubernetes.FromUnstructured(object, statefulSet); err != nil {
+		return err
+	}
+
+	if strategy := statefulSet.Spec.UpdateStrategy.Type; strategy != "" && strategy != appsv1.RollingUpdateStatefulSetStrategyType {
+		message := fmt.Sprintf("Unable to set partition of StatefulSet %q: it uses the %s update strategy", key.Name, strategy)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, partition,
+			"spec", "updateStrategy", "rollingUpdate", "partition")
+	}
+
+	sendUpdateAlert(alerter, s.store.Update(ctx, key, fn),
+		fmt.Sprintf("Set partition of StatefulSet %q to %d", key.Name, partition),
+		fmt.Sprintf("Unable to set partition of StatefulSet %q", key.Name))
+
+	return nil
+}
+
+// ControllerRevisionRollback rolls a StatefulSet or DaemonSet back to a revision.
+type ControllerRevisionRollback struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*ControllerRevisionRollback)(nil)
+
+// NewControllerRevisionRollback creates an instance of ControllerRevisionRollback.
+func NewControllerRevisionRollback(objectStore store.Store) *ControllerRevisionRollback {
+	return &ControllerRevisionRollback{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of the action.
+func (c *ControllerRevisionRollback) ActionName() string {
+	return ActionControllerRevisionRollback
+}
+
+// Handle rolls the object in the payload back to a revision by copying the
+// revision's pod template to the object, as kubectl rollout undo does.
+func (c *ControllerRevisionRollback) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", c.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	revisionFloat, err := payload.Float64("revision")
+	if err != nil {
+		return err
+	}
+	revision := roundToInt(revisionFloat)
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	object, err := c.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return fmt.Errorf("%s %q not found", key.Kind, key.Name)
+	}
+
+	revisions, err := ControllerRevisions(ctx, c.store, key.Kind, object)
+	if err != nil {
+		return err
+	}
+
+	var target *PodTemplateRevision
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			target = &revisions[i]
+		}
+	}
+
+	if target == nil {
+		message := fmt.Sprintf("Unable to roll back %s %q: revision %d not found", key.Kind, key.Name, revision)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	if target == &revisions[len(revisions)-1] {
+		message := fmt.Sprintf("%s %q is already at revision %d", key.Kind, key.Name, revision)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	templateMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&target.Template)
+	if err != nil {
+		return fmt.Errorf("convert pod template: %w", err)
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, templateMap, "spec", "template")
+	}
+
+	sendUpdateAlert(alerter, c.store.Update(ctx, key, fn),
+		fmt.Sprintf("Rolled back %s %q to revision %d", key.Kind, key.Name, revision),
+		fmt.Sprintf("Unable to roll back %s %q", key.Kind, key.Name))
+
+	return nil
+}
@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestControllerRevisions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	statefulSet := testutil.CreateStatefulSet("web")
+	other := testutil.CreateDaemonSet("web")
+
+	objectStore := fake.NewMockStore(controller)
+	expectControllerRevisions(t, objectStore,
+		testControllerRevision(t, statefulSet, 2, "nginx:1.19"),
+		testControllerRevision(t, other, 3, "nginx:1.19"),
+		testControllerRevision(t, statefulSet, 1, "nginx:1.18"))
+
+	revisions, err := ControllerRevisions(context.Background(), objectStore, "StatefulSet", statefulSet)
+	require.NoError(t, err)
+
+	require.Len(t, revisions, 2)
+	assert.Equal(t, int64(1), revisions[0].Revision)
+	assert.Equal(t, int64(2), revisions[1].Revision)
+	assert.Equal(t, "revision 1", revisions[0].ChangeCause())
+	assert.Equal(t, "hash-1", revisions[0].Hash())
+	assert.True(t, revisions[0].Matches("web-1"))
+	assert.True(t, revisions[0].Matches("hash-1"))
+	assert.False(t, revisions[0].Matches("hash-2"))
+	assert.False(t, revisions[0].Matches(""))
+
+	template := revisions[0].Template
+	assert.Equal(t, map[string]string{"app": "web"}, template.Labels)
+	assert.Equal(t, "nginx:1.18", template.Spec.Containers[0].Image)
+}
+
+func TestRolloutButtons_controllerRevisions(t *testing.T) {
+	for _, object := range []runtime.Object{testutil.CreateStatefulSet("web"), testutil.CreateDaemonSet("web")} {
+		buttons, err := RolloutButtons(object)
+		require.NoError(t, err)
+
+		require.Len(t, buttons, 1)
+		assert.Equal(t, "Restart", buttons[0].Name)
+		assert.Equal(t, ActionRolloutRestart, buttons[0].Payload["action"])
+		assert.Equal(t, object.GetObjectKind().GroupVersionKind().Kind, buttons[0].Payload["kind"])
+	}
+}
+
+func TestRolloutRestart(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	daemonSet := testutil.CreateDaemonSet("daemonset")
+	key, err := store.KeyFromObject(daemonSet)
+	require.NoError(t, err)
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+			object := testutil.ToUnstructured(t, daemonSet)
+			require.NoError(t, fn(object))
+
+			restartedAt, _, _ := unstructured.NestedString(object.Object,
+				"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+			assert.Equal(t, "2020-06-01T12:00:00Z", restartedAt)
+			return nil
+		})
+
+	alerter := expectAlert(t, controller, action.AlertTypeInfo, `Restarted DaemonSet "daemonset"`)
+
+	restart := NewRolloutRestart(objectStore)
+	restart.now = func() time.Time { return time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC) }
+	assert.Equal(t, ActionRolloutRestart, restart.ActionName())
+
+	require.NoError(t, restart.Handle(context.Background(), alerter, key.ToActionPayload()))
+}
+
+func TestStatefulSetPartition(t *testing.T) {
+	tests := []struct {
+		name            string
+		strategy        appsv1.StatefulSetUpdateStrategyType
+		partition       float64
+		updated         bool
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "rolling update",
+			strategy:        appsv1.RollingUpdateStatefulSetStrategyType,
+			partition:       2,
+			updated:         true,
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Set partition of StatefulSet "web" to 2`,
+		},
+		{
+			name:            "on delete",
+			strategy:        appsv1.OnDeleteStatefulSetStrategyType,
+			partition:       2,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to set partition of StatefulSet "web": it uses the OnDelete update strategy`,
+		},
+		{
+			name:            "negative partition",
+			partition:       -1,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to set partition of StatefulSet "web": partition must not be negative`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			statefulSet := testutil.CreateStatefulSet("web")
+			statefulSet.Spec.UpdateStrategy.Type = test.strategy
+			key, err := store.KeyFromObject(statefulSet)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			if test.partition >= 0 {
+				objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, statefulSet), nil)
+			}
+			if test.updated {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+						object := testutil.ToUnstructured(t, statefulSet)
+						require.NoError(t, fn(object))
+
+						updated := &appsv1.StatefulSet{}
+						require.NoError(t, kubernetes.FromUnstructured(object, updated))
+						require.NotNil(t, updated.Spec.UpdateStrategy.RollingUpdate)
+						assert.Equal(t, int32(2), *updated.Spec.UpdateStrategy.RollingUpdate.Partition)
+						return nil
+					})
+			}
+
+			alerter := expectAlert(t, controller, test.expectedType, test.expectedMessage)
+
+			partition := NewStatefulSetPartition(objectStore)
+			assert.Equal(t, ActionStatefulSetPartition, partition.ActionName())
+
+			payload := key.ToActionPayload()
+			payload["partition"] = test.partition
+
+			require.NoError(t, partition.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
+
+func TestControllerRevisionRollback(t *testing.T) {
+	tests := []struct {
+		name            string
+		revision        float64
+		updated         bool
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "rollback",
+			revision:        1,
+			updated:         true,
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Rolled back StatefulSet "web" to revision 1`,
+		},
+		{
+			name:            "current revision",
+			revision:        2,
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `StatefulSet "web" is already at revision 2`,
+		},
+		{
+			name:            "missing revision",
+			revision:        5,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to roll back StatefulSet "web": revision 5 not found`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			statefulSet := testutil.CreateStatefulSet("web")
+			statefulSet.Spec.Template = testPodTemplate("nginx:1.19")
+			key, err := store.KeyFromObject(statefulSet)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, statefulSet), nil)
+			expectControllerRevisions(t, objectStore,
+				testControllerRevision(t, statefulSet, 1, "nginx:1.18"),
+				testControllerRevision(t, statefulSet, 2, "nginx:1.19"))
+
+			if test.updated {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+						object := testutil.ToUnstructured(t, statefulSet)
+						require.NoError(t, fn(object))
+
+						updated := &appsv1.StatefulSet{}
+						require.NoError(t, kubernetes.FromUnstructured(object, updated))
+						assert.Equal(t, "nginx:1.18", updated.Spec.Template.Spec.Containers[0].Image)
+						assert.Equal(t, map[string]string{"app": "web"}, updated.Spec.Template.Labels)
+						return nil
+					})
+			}
+
+			alerter := expectAlert(t, controller, test.expectedType, test.expectedMessage)
+
+			rollback := NewControllerRevisionRollback(objectStore)
+			assert.Equal(t, ActionControllerRevisionRollback, rollback.ActionName())
+
+			payload := key.ToActionPayload()
+			payload["revision"] = test.revision
+
+			require.NoError(t, rollback.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
+
+func testControllerRevision(t *testing.T, owner runtime.Object, revision int64, image string) *appsv1.ControllerRevision {
+	kind, ownerObject, ok := ControllerRevisionOwner(owner)
+	require.True(t, ok)
+
+	controllerRevision := testutil.CreateControllerRevision(fmt.Sprintf("%s-%d", ownerObject.GetName(), revision))
+	controllerRevision.Labels = map[string]string{
+		appsv1.ControllerRevisionHashLabelKey: fmt.Sprintf("hash-%d", revision),
+	}
+	controllerRevision.Annotations = map[string]string{
+		ChangeCauseAnnotation: fmt.Sprintf("revision %d", revision),
+	}
+	controllerRevision.SetOwnerReferences(testutil.ToOwnerReferences(t, owner))
+	controllerRevision.Revision = revision
+	controllerRevision.Data = runtime.RawExtension{Raw: []byte(fmt.Sprintf(
+		`{"spec":{"template":{"$patch":"replace","metadata":{"labels":{"app":"web"}},"spec":{"containers":[{"name":"web","image":%q}]}}}}`,
+		image))}
+
+	require.Equal(t, kind, controllerRevision.OwnerReferences[0].Kind)
+	return controllerRevision
+}
+
+func expectControllerRevisions(t *testing.T, objectStore *fake.MockStore, controllerRevisions ...*appsv1.ControllerRevision) {
+	var objects []runtime.Object
+	for _, controllerRevision := range controllerRevisions {
+		objects = append(objects, controllerRevision)
+	}
+
+	key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ControllerRevision"}
+	objectStore.EXPECT().List(gomock.Any(), key).Return(testutil.ToUnstructuredList(t, objects...), false, nil)
+}
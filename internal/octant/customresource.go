@@ -23,9 +23,18 @@ type CustomResourceDefinitionPrinterColumn struct {
 	JSONPath    string
 }
 
+// CustomResourceDefinitionScale describes the scale subresource of a custom resource.
+type CustomResourceDefinitionScale struct {
+	SpecReplicasPath   string
+	StatusReplicasPath string
+	LabelSelectorPath  string
+}
+
 type CustomResourceDefinitionVersion struct {
 	Version        string
 	PrinterColumns []CustomResourceDefinitionPrinterColumn
+	// Scale is nil if the version does not have a scale subresource.
+	Scale *CustomResourceDefinitionScale
 }
 
 type CustomResourceDefinition struct {
@@ -100,6 +109,7 @@ func (crd *CustomResourceDefinition) v1Version(version string) (CustomResourceDe
 		customResourceDefinitionVersion := CustomResourceDefinitionVersion{
 			Version:        name,
 			PrinterColumns: columns,
+			Scale:          crdScale(versions[i]["subresources"]),
 		}
 		return customResourceDefinitionVersion, nil
 	}
@@ -118,9 +128,27 @@ func (crd *CustomResourceDefinition) v1beta1Version(version string) (CustomResou
 		return CustomResourceDefinitionVersion{}, fmt.Errorf("collect CRD printer columns: %w", err)
 	}
 
+	// subresources are set for all versions or for each version.
+	subresources, found, err := unstructured.NestedFieldNoCopy(crd.object.Object, "spec", "subresources")
+	if err != nil {
+		return CustomResourceDefinitionVersion{}, fmt.Errorf("unable to read crd .spec.subresources: %w", err)
+	}
+	if !found {
+		versions, err := crd.versions()
+		if err != nil {
+			return CustomResourceDefinitionVersion{}, err
+		}
+		for i := range versions {
+			if versions[i]["name"] == version {
+				subresources = versions[i]["subresources"]
+			}
+		}
+	}
+
 	customResourceDefinitionVersion := CustomResourceDefinitionVersion{
 		Version:        version,
 		PrinterColumns: columns,
+		Scale:          crdScale(subresources),
 	}
 	return customResourceDefinitionVersion, nil
 
@@ -210,6 +238,24 @@ func crdV1beta1PrinterColumns(in interface{}) ([]CustomResourceDefinitionPrinter
 	return columns, nil
 }
 
+func crdScale(subresources interface{}) *CustomResourceDefinitionScale {
+	m, ok := subresources.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	scale, ok := m["scale"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return &CustomResourceDefinitionScale{
+		SpecReplicasPath:   mapString(scale, "specReplicasPath"),
+		StatusReplicasPath: mapString(scale, "statusReplicasPath"),
+		LabelSelectorPath:  mapString(scale, "labelSelectorPath"),
+	}
+}
+
 func mapString(m map[string]interface{}, key string) string {
 	if m[key] == nil {
 		return ""
@@ -108,6 +108,10 @@ func TestCustomResourceDefinition_Version(t *testing.T) {
 						JSONPath: ".metadata.creationTimestamp",
 					},
 				},
+				Scale: &octant.CustomResourceDefinitionScale{
+					SpecReplicasPath:   ".spec.replicas",
+					StatusReplicasPath: ".status.replicas",
+				},
 			},
 		},
 		{
@@ -134,6 +138,10 @@ func TestCustomResourceDefinition_Version(t *testing.T) {
 						JSONPath: ".metadata.creationTimestamp",
 					},
 				},
+				Scale: &octant.CustomResourceDefinitionScale{
+					SpecReplicasPath:   ".spec.replicas",
+					StatusReplicasPath: ".status.replicas",
+				},
 			},
 		},
 		{
@@ -0,0 +1,428 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// RevisionAnnotation is the revision of a deployment and its ReplicaSets.
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+	// ChangeCauseAnnotation is the cause of a revision.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+	// RestartedAtAnnotation is set on a pod template to restart its pods.
+	RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// rollbackSkippedAnnotations are ReplicaSet annotations which are not copied to
+// a deployment when it is rolled back. This matches kubectl rollout undo.
+var rollbackSkippedAnnotations = map[string]bool{
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+	RevisionAnnotation:                          true,
+	"deployment.kubernetes.io/revision-history": true,
+	"deployment.kubernetes.io/desired-replicas": true,
+	"deployment.kubernetes.io/max-replicas":     true,
+	"deprecated.deployment.rollback.to":         true,
+}
+
+// DeploymentRevision is a revision of a deployment.
+type DeploymentRevision struct {
+	Revision   int64
+	ReplicaSet *appsv1.ReplicaSet
+}
+
+// ChangeCause returns the revision's change cause.
+func (r DeploymentRevision) ChangeCause() string {
+	return r.ReplicaSet.Annotations[ChangeCauseAnnotation]
+}
+
+// Template returns the revision's pod template without the label the deployment
+// controller adds to tell its ReplicaSets apart.
+func (r DeploymentRevision) Template() corev1.PodTemplateSpec {
+	template := r.ReplicaSet.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	if len(template.Labels) == 0 {
+		template.Labels = nil
+	}
+	return *template
+}
+
+// DeploymentRevisions returns the revisions of a deployment, oldest first. They
+// are built from the ReplicaSets the deployment owns.
+func DeploymentRevisions(ctx context.Context, objectStore store.Store, deployment *appsv1.Deployment) ([]DeploymentRevision, error) {
+	if deployment == nil {
+		return nil, fmt.Errorf("deployment is nil")
+	}
+
+	key := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: gvk.AppReplicaSet.GroupVersion().String(),
+		Kind:       gvk.AppReplicaSet.Kind,
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("list replica sets: %w", err)
+	}
+
+	var revisions []DeploymentRevision
+	for i := range list.Items {
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], replicaSet); err != nil {
+			return nil, err
+		}
+
+		if !isControlledBy(replicaSet.OwnerReferences, gvk.Deployment.Kind, deployment) {
+			continue
+		}
+
+		revision, ok := Revision(replicaSet)
+		if !ok {
+			continue
+		}
+
+		revisions = append(revisions, DeploymentRevision{
+			Revision:   revision,
+			ReplicaSet: replicaSet,
+		})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+
+	return revisions, nil
+}
+
+// Revision returns the revision of a deployment or ReplicaSet.
+func Revision(object metav1.Object) (int64, bool) {
+	revision, err := strconv.ParseInt(object.GetAnnotations()[RevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return revision, true
+}
+
+func isControlledBy(ownerReferences []metav1.OwnerReference, kind string, owner metav1.Object) bool {
+	for _, ownerReference := range ownerReferences {
+		if ownerReference.Kind != kind || ownerReference.Name != owner.GetName() {
+			continue
+		}
+		if owner.GetUID() != "" && ownerReference.UID != owner.GetUID() {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// RolloutButtons returns buttons which manage the rollout of a workload. Objects
+// which do not have rollouts do not have buttons.
+func RolloutButtons(object runtime.Object) ([]component.Button, error) {
+	switch o := object.(type) {
+	case *appsv1.Deployment:
+		return deploymentRolloutButtons(o)
+	case *appsv1.StatefulSet, *appsv1.DaemonSet:
+		return controllerRevisionRolloutButtons(object)
+	default:
+		return nil, nil
+	}
+}
+
+func deploymentRolloutButtons(deployment *appsv1.Deployment) ([]component.Button, error) {
+	key, err := store.KeyFromObject(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	restart := component.NewButton("Restart",
+		action.CreatePayload(ActionDeploymentRestart, key.ToActionPayload()),
+		component.WithButtonConfirmation("Restart Deployment",
+			fmt.Sprintf("Are you sure you want to restart *Deployment* **%s**? Its pods will be replaced.", deployment.Name)))
+
+	pause := component.NewButton("Pause",
+		action.CreatePayload(ActionDeploymentPause, key.ToActionPayload()))
+	if deployment.Spec.Paused {
+		pause = component.NewButton("Resume",
+			action.CreatePayload(ActionDeploymentResume, key.ToActionPayload()))
+	}
+
+	return []component.Button{restart, pause}, nil
+}
+
+func controllerRevisionRolloutButtons(object runtime.Object) ([]component.Button, error) {
+	kind, owner, _ := ControllerRevisionOwner(object)
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	restart := component.NewButton("Restart",
+		action.CreatePayload(ActionRolloutRestart, key.ToActionPayload()),
+		component.WithButtonConfirmation(fmt.Sprintf("Restart %s", kind),
+			fmt.Sprintf("Are you sure you want to restart *%s* **%s**? Its pods will be replaced.", kind, owner.GetName())))
+
+	return []component.Button{restart}, nil
+}
+
+// DeploymentRestart restarts a deployment's pods by annotating its pod template.
+type DeploymentRestart struct {
+	store store.Store
+	now   func() time.Time
+}
+
+var _ action.Dispatcher = (*DeploymentRestart)(nil)
+
+// NewDeploymentRestart creates an instance of DeploymentRestart.
+func NewDeploymentRestart(objectStore store.Store) *DeploymentRestart {
+	return &DeploymentRestart{
+		store: objectStore,
+		now:   time.Now,
+	}
+}
+
+// ActionName returns the name of the action.
+func (d *DeploymentRestart) ActionName() string {
+	return ActionDeploymentRestart
+}
+
+// Handle restarts a deployment. Paused deployments can't be restarted.
+func (d *DeploymentRestart) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	deployment, key, err := getDeployment(ctx, d.store, payload)
+	if err != nil {
+		return err
+	}
+
+	if deployment.Spec.Paused {
+		message := fmt.Sprintf("Unable to restart paused Deployment %q. Resume it first.", deployment.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	restartedAt := d.now().Format(time.RFC3339)
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, restartedAt,
+			"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+	}
+
+	sendUpdateAlert(alerter, d.store.Update(ctx, key, fn),
+		fmt.Sprintf("Restarted Deployment %q", deployment.Name),
+		fmt.Sprintf("Unable to restart Deployment %q", deployment.Name))
+
+	return nil
+}
+
+// DeploymentPause pauses or resumes a deployment's rollout.
+type DeploymentPause struct {
+	store  store.Store
+	paused bool
+}
+
+var _ action.Dispatcher = (*DeploymentPause)(nil)
+
+// NewDeploymentPause creates an instance of DeploymentPause which pauses deployments.
+func NewDeploymentPause(objectStore store.Store) *DeploymentPause {
+	return &DeploymentPause{
+		store:  objectStore,
+		paused: true,
+	}
+}
+
+// NewDeploymentResume creates an instance of DeploymentPause which resumes deployments.
+func NewDeploymentResume(objectStore store.Store) *DeploymentPause {
+	return &DeploymentPause{
+		store:  objectStore,
+		paused: false,
+	}
+}
+
+// ActionName returns the name of the action.
+func (d *DeploymentPause) ActionName() string {
+	if d.paused {
+		return ActionDeploymentPause
+	}
+	return ActionDeploymentResume
+}
+
+// Handle pauses or resumes a deployment.
+func (d *DeploymentPause) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, d.paused, "spec", "paused")
+	}
+
+	verb := "Resumed"
+	failedVerb := "resume"
+	if d.paused {
+		verb = "Paused"
+		failedVerb = "pause"
+	}
+
+	sendUpdateAlert(alerter, d.store.Update(ctx, key, fn),
+		fmt.Sprintf("%s Deployment %q", verb, key.Name),
+		fmt.Sprintf("Unable to %s Deployment %q", failedVerb, key.Name))
+
+	return nil
+}
+
+// DeploymentRollback rolls a deployment back to a revision.
+type DeploymentRollback struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*DeploymentRollback)(nil)
+
+// NewDeploymentRollback creates an instance of DeploymentRollback.
+func NewDeploymentRollback(objectStore store.Store) *DeploymentRollback {
+	return &DeploymentRollback{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of the action.
+func (d *DeploymentRollback) ActionName() string {
+	return ActionDeploymentRollback
+}
+
+// Handle rolls a deployment back to the revision in the payload by copying the
+// revision's pod template to the deployment, as kubectl rollout undo does.
+// Paused deployments can't be rolled back.
+func (d *DeploymentRollback) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	revisionFloat, err := payload.Float64("revision")
+	if err != nil {
+		return err
+	}
+	revision := roundToInt(revisionFloat)
+
+	deployment, key, err := getDeployment(ctx, d.store, payload)
+	if err != nil {
+		return err
+	}
+
+	if deployment.Spec.Paused {
+		message := fmt.Sprintf("Unable to roll back paused Deployment %q. Resume it first.", deployment.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	revisions, err := DeploymentRevisions(ctx, d.store, deployment)
+	if err != nil {
+		return err
+	}
+
+	var target *DeploymentRevision
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			target = &revisions[i]
+		}
+	}
+
+	if target == nil {
+		message := fmt.Sprintf("Unable to roll back Deployment %q: revision %d not found", deployment.Name, revision)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	if current, ok := Revision(deployment); ok && current == revision {
+		message := fmt.Sprintf("Deployment %q is already at revision %d", deployment.Name, revision)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	template := target.Template()
+	templateMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+	if err != nil {
+		return fmt.Errorf("convert pod template: %w", err)
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		if err := unstructured.SetNestedField(object.Object, templateMap, "spec", "template"); err != nil {
+			return err
+		}
+
+		annotations := object.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		for k, v := range target.ReplicaSet.Annotations {
+			if !rollbackSkippedAnnotations[k] {
+				annotations[k] = v
+			}
+		}
+		object.SetAnnotations(annotations)
+
+		return nil
+	}
+
+	sendUpdateAlert(alerter, d.store.Update(ctx, key, fn),
+		fmt.Sprintf("Rolled back Deployment %q to revision %d", deployment.Name, revision),
+		fmt.Sprintf("Unable to roll back Deployment %q", deployment.Name))
+
+	return nil
+}
+
+func getDeployment(ctx context.Context, objectStore store.Store, payload action.Payload) (*appsv1.Deployment, store.Key, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return nil, store.Key{}, err
+	}
+
+	object, err := objectStore.Get(ctx, key)
+	if err != nil {
+		return nil, store.Key{}, err
+	}
+	if object == nil {
+		return nil, store.Key{}, fmt.Errorf("deployment %q not found", key.Name)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := kubernetes.FromUnstructured(object, deployment); err != nil {
+		return nil, store.Key{}, err
+	}
+
+	return deployment, key, nil
+}
+
+func sendUpdateAlert(alerter action.Alerter, err error, message, failedMessage string) {
+	alertType := action.AlertTypeInfo
+	if err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("%s: %s", failedMessage, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+}
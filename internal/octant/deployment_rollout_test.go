@@ -0,0 +1,343 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestDeploymentRevisions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testDeployment(2, "nginx:1.19")
+	other := testutil.CreateDeployment("other")
+
+	objectStore := fake.NewMockStore(controller)
+	expectReplicaSets(t, objectStore,
+		testRevision(t, deployment, 2, "nginx:1.19"),
+		testRevision(t, other, 3, "nginx:1.19"),
+		testRevision(t, deployment, 1, "nginx:1.18"))
+
+	revisions, err := DeploymentRevisions(context.Background(), objectStore, deployment)
+	require.NoError(t, err)
+
+	require.Len(t, revisions, 2)
+	assert.Equal(t, int64(1), revisions[0].Revision)
+	assert.Equal(t, int64(2), revisions[1].Revision)
+	assert.Equal(t, "revision 1", revisions[0].ChangeCause())
+
+	template := revisions[0].Template()
+	assert.Equal(t, map[string]string{"app": "web"}, template.Labels)
+	assert.Equal(t, "nginx:1.18", template.Spec.Containers[0].Image)
+}
+
+func TestRolloutButtons(t *testing.T) {
+	tests := []struct {
+		name     string
+		object   func() runtime.Object
+		expected []string
+	}{
+		{
+			name:     "deployment",
+			object:   func() runtime.Object { return testDeployment(1, "nginx") },
+			expected: []string{ActionDeploymentRestart, ActionDeploymentPause},
+		},
+		{
+			name: "paused deployment",
+			object: func() runtime.Object {
+				deployment := testDeployment(1, "nginx")
+				deployment.Spec.Paused = true
+				return deployment
+			},
+			expected: []string{ActionDeploymentRestart, ActionDeploymentResume},
+		},
+		{
+			name:   "pod",
+			object: func() runtime.Object { return testutil.CreatePod("pod") },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buttons, err := RolloutButtons(test.object())
+			require.NoError(t, err)
+
+			var got []string
+			for _, button := range buttons {
+				got = append(got, button.Payload["action"].(string))
+			}
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestDeploymentRestart(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		paused          bool
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "restart",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Restarted Deployment "deployment"`,
+		},
+		{
+			name:            "paused",
+			paused:          true,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to restart paused Deployment "deployment". Resume it first.`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testDeployment(1, "nginx")
+			deployment.Spec.Paused = test.paused
+			key, err := store.KeyFromObject(deployment)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, deployment), nil)
+			if !test.paused {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+						object := testutil.ToUnstructured(t, deployment)
+						require.NoError(t, fn(object))
+
+						restartedAt, _, _ := unstructured.NestedString(object.Object,
+							"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+						assert.Equal(t, "2020-06-01T12:00:00Z", restartedAt)
+						return nil
+					})
+			}
+
+			alerter := expectAlert(t, controller, test.expectedType, test.expectedMessage)
+
+			restart := NewDeploymentRestart(objectStore)
+			restart.now = func() time.Time { return now }
+			assert.Equal(t, ActionDeploymentRestart, restart.ActionName())
+
+			require.NoError(t, restart.Handle(context.Background(), alerter, key.ToActionPayload()))
+		})
+	}
+}
+
+func TestDeploymentPause(t *testing.T) {
+	tests := []struct {
+		name            string
+		dispatcher      func(store.Store) *DeploymentPause
+		expectedAction  string
+		expectedPaused  bool
+		expectedMessage string
+	}{
+		{
+			name:            "pause",
+			dispatcher:      NewDeploymentPause,
+			expectedAction:  ActionDeploymentPause,
+			expectedPaused:  true,
+			expectedMessage: `Paused Deployment "deployment"`,
+		},
+		{
+			name:            "resume",
+			dispatcher:      NewDeploymentResume,
+			expectedAction:  ActionDeploymentResume,
+			expectedPaused:  false,
+			expectedMessage: `Resumed Deployment "deployment"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testDeployment(1, "nginx")
+			deployment.Spec.Paused = !test.expectedPaused
+			key, err := store.KeyFromObject(deployment)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Update(gomock.Any(), key, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+					object := testutil.ToUnstructured(t, deployment)
+					require.NoError(t, fn(object))
+
+					paused, _, _ := unstructured.NestedBool(object.Object, "spec", "paused")
+					assert.Equal(t, test.expectedPaused, paused)
+					return nil
+				})
+
+			alerter := expectAlert(t, controller, action.AlertTypeInfo, test.expectedMessage)
+
+			dispatcher := test.dispatcher(objectStore)
+			assert.Equal(t, test.expectedAction, dispatcher.ActionName())
+
+			require.NoError(t, dispatcher.Handle(context.Background(), alerter, key.ToActionPayload()))
+		})
+	}
+}
+
+func TestDeploymentRollback(t *testing.T) {
+	tests := []struct {
+		name            string
+		revision        interface{}
+		paused          bool
+		updated         bool
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "rollback",
+			revision:        float64(1),
+			updated:         true,
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Rolled back Deployment "deployment" to revision 1`,
+		},
+		{
+			name:            "current revision",
+			revision:        "2",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Deployment "deployment" is already at revision 2`,
+		},
+		{
+			name:            "missing revision",
+			revision:        float64(5),
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to roll back Deployment "deployment": revision 5 not found`,
+		},
+		{
+			name:            "paused",
+			revision:        float64(1),
+			paused:          true,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to roll back paused Deployment "deployment". Resume it first.`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testDeployment(2, "nginx:1.19")
+			deployment.Spec.Paused = test.paused
+			key, err := store.KeyFromObject(deployment)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, deployment), nil)
+			if !test.paused {
+				expectReplicaSets(t, objectStore,
+					testRevision(t, deployment, 1, "nginx:1.18"),
+					testRevision(t, deployment, 2, "nginx:1.19"))
+			}
+			if test.updated {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+						object := testutil.ToUnstructured(t, deployment)
+						require.NoError(t, fn(object))
+
+						updated := &appsv1.Deployment{}
+						require.NoError(t, kubernetes.FromUnstructured(object, updated))
+						assert.Equal(t, "nginx:1.18", updated.Spec.Template.Spec.Containers[0].Image)
+						assert.Equal(t, map[string]string{"app": "web"}, updated.Spec.Template.Labels)
+						assert.Equal(t, "revision 1", updated.Annotations[ChangeCauseAnnotation])
+						assert.Equal(t, "2", updated.Annotations[RevisionAnnotation])
+						return nil
+					})
+			}
+
+			alerter := expectAlert(t, controller, test.expectedType, test.expectedMessage)
+
+			rollback := NewDeploymentRollback(objectStore)
+			assert.Equal(t, ActionDeploymentRollback, rollback.ActionName())
+
+			payload := key.ToActionPayload()
+			payload["revision"] = test.revision
+
+			require.NoError(t, rollback.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
+
+func testDeployment(revision int64, image string) *appsv1.Deployment {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{RevisionAnnotation: fmt.Sprintf("%d", revision)}
+	deployment.Spec.Template = testPodTemplate(image)
+	return deployment
+}
+
+func testRevision(t *testing.T, deployment *appsv1.Deployment, revision int64, image string) *appsv1.ReplicaSet {
+	replicaSet := testutil.CreateAppReplicaSet(fmt.Sprintf("%s-%d", deployment.Name, revision))
+	replicaSet.Annotations = map[string]string{
+		RevisionAnnotation:    fmt.Sprintf("%d", revision),
+		ChangeCauseAnnotation: fmt.Sprintf("revision %d", revision),
+	}
+	replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+	replicaSet.Spec.Template = testPodTemplate(image)
+	replicaSet.Spec.Template.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = fmt.Sprintf("hash-%d", revision)
+	return replicaSet
+}
+
+func testPodTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "web", Image: image}},
+		},
+	}
+}
+
+func expectReplicaSets(t *testing.T, objectStore *fake.MockStore, replicaSets ...*appsv1.ReplicaSet) {
+	var objects []runtime.Object
+	for _, replicaSet := range replicaSets {
+		objects = append(objects, replicaSet)
+	}
+
+	key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet"}
+	objectStore.EXPECT().List(gomock.Any(), key).Return(testutil.ToUnstructuredList(t, objects...), false, nil)
+}
+
+func expectAlert(t *testing.T, controller *gomock.Controller, alertType action.AlertType, message string) action.Alerter {
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, alertType, alert.Type)
+			assert.Equal(t, message, alert.Message)
+		})
+	return alerter
+}
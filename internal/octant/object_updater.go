@@ -38,10 +38,19 @@ func ObjectUpdateFromPayload(payload action.Payload) (*unstructured.Unstructured
 
 type ObjectUpdaterDispatcherOption func(dispatcher *ObjectUpdaterDispatcher)
 
+// WithObjectUpdaterValidator validates objects against their schemas before
+// they are updated.
+func WithObjectUpdaterValidator(validator *SchemaValidator) ObjectUpdaterDispatcherOption {
+	return func(dispatcher *ObjectUpdaterDispatcher) {
+		dispatcher.validator = validator
+	}
+}
+
 // ObjectUpdaterDispatcher is an action that updates an object.
 type ObjectUpdaterDispatcher struct {
 	store             store.Store
 	objectFromPayload func(payload action.Payload) (*unstructured.Unstructured, error)
+	validator         *SchemaValidator
 }
 
 var _ action.Dispatcher = &ObjectUpdaterDispatcher{}
@@ -80,6 +89,17 @@ func (o ObjectUpdaterDispatcher) Handle(ctx context.Context, alerter action.Aler
 		return nil
 	}
 
+	if update, err := payload.String("update"); err == nil {
+		if err := o.validator.ValidateYAML(ctx, update); err != nil {
+			sendAlert(
+				alerter,
+				action.AlertTypeError,
+				fmt.Sprintf("validate object: %s", err.Error()),
+				&expiration)
+			return nil
+		}
+	}
+
 	key, _ := store.KeyFromPayload(payload)
 	err = o.store.Update(ctx, key, func(u *unstructured.Unstructured) error {
 		if object.GetAPIVersion() != u.GetAPIVersion() {
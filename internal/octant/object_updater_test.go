@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -84,6 +85,7 @@ func TestObjectUpdaterDispatcher_Handle(t *testing.T) {
 		objectFromPayload func(action.Payload) (*unstructured.Unstructured, error)
 		initStore         func(ctrl *gomock.Controller) *storeFake.MockStore
 		initAlerter       func(ctrl *gomock.Controller) *actionFake.MockAlerter
+		validator         func(ctrl *gomock.Controller) *SchemaValidator
 		wantErr           bool
 	}{
 		{
@@ -129,6 +131,34 @@ func TestObjectUpdaterDispatcher_Handle(t *testing.T) {
 				return alerter
 			},
 		},
+		{
+			name: "invalid object",
+			payload: action.Payload{
+				"update": "apiVersion: v1\nkind: ConfigMap\nspec:\n  key: value\n",
+			},
+			objectFromPayload: func(payload action.Payload) (*unstructured.Unstructured, error) {
+				return pod, nil
+			},
+			initStore: func(ctrl *gomock.Controller) *storeFake.MockStore {
+				objectStore := storeFake.NewMockStore(ctrl)
+				return objectStore
+			},
+			initAlerter: func(ctrl *gomock.Controller) *actionFake.MockAlerter {
+				alerter := actionFake.NewMockAlerter(ctrl)
+				alerter.EXPECT().
+					SendAlert(gomock.Any()).
+					DoAndReturn(func(alert action.Alert) {
+						require.Equal(t, action.AlertTypeError, alert.Type)
+						require.Equal(t, "validate object: line 3: spec: unknown field", alert.Message)
+					})
+				return alerter
+			},
+			validator: func(ctrl *gomock.Controller) *SchemaValidator {
+				clusterClient := clusterFake.NewMockClientInterface(ctrl)
+				clusterClient.EXPECT().OpenAPIResources().Return(configMapResources(t), nil)
+				return NewSchemaValidator(clusterClient)
+			},
+		},
 		{
 			name:    "update failed",
 			payload: podPayload,
@@ -163,7 +193,13 @@ func TestObjectUpdaterDispatcher_Handle(t *testing.T) {
 			objectStore := test.initStore(ctrl)
 			alerter := test.initAlerter(ctrl)
 
+			var validator *SchemaValidator
+			if test.validator != nil {
+				validator = test.validator(ctrl)
+			}
+
 			o := NewObjectUpdaterDispatcher(objectStore,
+				WithObjectUpdaterValidator(validator),
 				func(dispatcher *ObjectUpdaterDispatcher) {
 					dispatcher.objectFromPayload = test.objectFromPayload
 				})
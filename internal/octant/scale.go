@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const scaleSubresource = "scale"
+
+// Scale scales any resource which has a scale subresource.
+type Scale struct {
+	clusterClient cluster.ClientInterface
+}
+
+var _ action.Dispatcher = (*Scale)(nil)
+
+// NewScale creates an instance of Scale.
+func NewScale(clusterClient cluster.ClientInterface) *Scale {
+	return &Scale{
+		clusterClient: clusterClient,
+	}
+}
+
+// ActionName returns the name of the action.
+func (s *Scale) ActionName() string {
+	return ActionScale
+}
+
+// Handle sets the replicas of the object in the payload using its scale subresource.
+func (s *Scale) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", s.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	replicasFloat, err := payload.Float64("replicas")
+	if err != nil {
+		return err
+	}
+	replicas := roundToInt(replicasFloat)
+
+	if replicas < 0 {
+		message := fmt.Sprintf("Unable to scale %s %q: replicas must not be negative", key.Kind, key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	message := fmt.Sprintf("Scaled %s %q to %d replicas", key.Kind, key.Name, replicas)
+	alertType := action.AlertTypeInfo
+	if err := s.Scale(ctx, key, int32(replicas)); err != nil {
+		logger.WithErr(err).Errorf("scale object")
+		message = fmt.Sprintf("Unable to scale %s %q: %s", key.Kind, key.Name, err)
+		alertType = action.AlertTypeWarning
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// Scale sets the replicas of an object.
+func (s *Scale) Scale(ctx context.Context, key store.Key, replicas int32) error {
+	discoveryClient, err := s.clusterClient.DiscoveryClient()
+	if err != nil {
+		return err
+	}
+
+	groupVersionKind := schema.FromAPIVersionAndKind(key.APIVersion, key.Kind)
+	resource, ok, err := ScalableResource(discoveryClient, groupVersionKind)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s does not have a scale subresource", key.Kind)
+	}
+
+	scalesGetter, err := s.clusterClient.ScaleClient()
+	if err != nil {
+		return err
+	}
+
+	scales := scalesGetter.Scales(key.Namespace)
+	scale, err := scales.Get(ctx, resource.GroupResource(), key.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	scale.Spec.Replicas = replicas
+	_, err = scales.Update(ctx, resource.GroupResource(), scale, metav1.UpdateOptions{})
+	return err
+}
+
+// ScalableResource uses discovery to find the resource for a kind. It returns
+// false if the resource does not have a scale subresource.
+func ScalableResource(discoveryClient discovery.DiscoveryInterface, groupVersionKind schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+	groupVersion := groupVersionKind.GroupVersion()
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(groupVersion.String())
+	if err != nil {
+		return schema.GroupVersionResource{}, false, fmt.Errorf("discover resources for %s: %w", groupVersion, err)
+	}
+
+	subresources := make(map[string]bool)
+	var resourceName string
+	for _, resource := range resourceList.APIResources {
+		if strings.Contains(resource.Name, "/") {
+			subresources[resource.Name] = true
+			continue
+		}
+		if resource.Kind == groupVersionKind.Kind {
+			resourceName = resource.Name
+		}
+	}
+
+	if resourceName == "" || !subresources[resourceName+"/"+scaleSubresource] {
+		return schema.GroupVersionResource{}, false, nil
+	}
+
+	return groupVersion.WithResource(resourceName), true, nil
+}
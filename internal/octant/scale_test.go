@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	scalefake "k8s.io/client-go/scale/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestScale(t *testing.T) {
+	tests := []struct {
+		name            string
+		key             store.Key
+		replicas        interface{}
+		expectedScale   int32
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "stateful set",
+			key:             store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web"},
+			replicas:        float64(3),
+			expectedScale:   3,
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Scaled StatefulSet "web" to 3 replicas`,
+		},
+		{
+			name:            "custom resource",
+			key:             store.Key{Namespace: "default", APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "rollout"},
+			replicas:        "0",
+			expectedScale:   0,
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Scaled Rollout "rollout" to 0 replicas`,
+		},
+		{
+			name:            "no scale subresource",
+			key:             store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"},
+			replicas:        float64(2),
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to scale Pod "pod": Pod does not have a scale subresource`,
+		},
+		{
+			name:            "negative replicas",
+			key:             store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web"},
+			replicas:        float64(-1),
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to scale StatefulSet "web": replicas must not be negative`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			scaleClient := &scalefake.FakeScaleClient{}
+			var updated *autoscalingv1.Scale
+			scaleClient.AddReactor("get", "*", func(a clienttesting.Action) (bool, runtime.Object, error) {
+				getAction := a.(clienttesting.GetAction)
+				return true, &autoscalingv1.Scale{
+					ObjectMeta: metav1.ObjectMeta{Name: getAction.GetName(), Namespace: getAction.GetNamespace()},
+					Spec:       autoscalingv1.ScaleSpec{Replicas: 1},
+				}, nil
+			})
+			scaleClient.AddReactor("update", "*", func(a clienttesting.Action) (bool, runtime.Object, error) {
+				updated = a.(clienttesting.UpdateAction).GetObject().(*autoscalingv1.Scale)
+				return true, updated, nil
+			})
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			clusterClient.EXPECT().DiscoveryClient().Return(testScaleDiscovery(), nil).AnyTimes()
+			clusterClient.EXPECT().ScaleClient().Return(scaleClient, nil).AnyTimes()
+
+			alerter := expectAlert(t, controller, test.expectedType, test.expectedMessage)
+
+			scale := NewScale(clusterClient)
+			assert.Equal(t, ActionScale, scale.ActionName())
+
+			payload := test.key.ToActionPayload()
+			payload["replicas"] = test.replicas
+
+			require.NoError(t, scale.Handle(context.Background(), alerter, payload))
+
+			if test.expectedType != action.AlertTypeInfo {
+				assert.Nil(t, updated)
+				return
+			}
+			require.NotNil(t, updated)
+			assert.Equal(t, test.key.Name, updated.Name)
+			assert.Equal(t, test.expectedScale, updated.Spec.Replicas)
+		})
+	}
+}
+
+func TestScalableResource(t *testing.T) {
+	tests := []struct {
+		name             string
+		groupVersionKind schema.GroupVersionKind
+		expected         schema.GroupVersionResource
+		expectedOK       bool
+		wantErr          bool
+	}{
+		{
+			name:             "replica set",
+			groupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+			expected:         schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"},
+			expectedOK:       true,
+		},
+		{
+			name:             "no scale subresource",
+			groupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+		},
+		{
+			name:             "unknown kind",
+			groupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Unknown"},
+		},
+		{
+			name:             "unknown group version",
+			groupVersionKind: schema.GroupVersionKind{Group: "unknown", Version: "v1", Kind: "Unknown"},
+			wantErr:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok, err := ScalableResource(testScaleDiscovery(), test.groupVersionKind)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedOK, ok)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func testScaleDiscovery() *discoveryfake.FakeDiscovery {
+	resourceList := func(groupVersion string, resources ...metav1.APIResource) *metav1.APIResourceList {
+		return &metav1.APIResourceList{GroupVersion: groupVersion, APIResources: resources}
+	}
+	resource := func(name, kind string) metav1.APIResource {
+		return metav1.APIResource{Name: name, Kind: kind, Namespaced: true}
+	}
+	scale := func(name string) metav1.APIResource {
+		return resource(fmt.Sprintf("%s/scale", name), "Scale")
+	}
+
+	return &discoveryfake.FakeDiscovery{
+		Fake: &clienttesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				resourceList("v1",
+					resource("pods", "Pod"),
+					resource("pods/log", "Pod"),
+					resource("replicationcontrollers", "ReplicationController"),
+					scale("replicationcontrollers")),
+				resourceList("apps/v1",
+					resource("replicasets", "ReplicaSet"),
+					scale("replicasets"),
+					resource("statefulsets", "StatefulSet"),
+					scale("statefulsets")),
+				resourceList("argoproj.io/v1alpha1",
+					resource("rollouts", "Rollout"),
+					scale("rollouts")),
+			},
+		},
+	}
+}
@@ -0,0 +1,453 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/openapi"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// schemaFormFieldPrefix prefixes the names of schema form fields, so they
+// can't clash with the fields which identify the object.
+const schemaFormFieldPrefix = "field:"
+
+// SchemaEditButton creates a button which edits an object with a form
+// generated from its schema. It returns nil if the cluster does not publish a
+// schema for the object's kind.
+func SchemaEditButton(resources *openapi.Resources, object runtime.Object) (*component.Button, error) {
+	if resources == nil || object == nil {
+		return nil, nil
+	}
+
+	u, err := toUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := resources.FormFields(u.GroupVersionKind(), u.Object)
+	if !ok || len(fields) == 0 {
+		return nil, nil
+	}
+
+	var formFields []component.FormField
+	for _, field := range fields {
+		formField, err := schemaFormField(field)
+		if err != nil {
+			return nil, fmt.Errorf("create form field for %s: %w", field.Path, err)
+		}
+		formFields = append(formFields, formField)
+	}
+
+	form, err := component.CreateFormForObject(ActionEditObjectFields, u, formFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := store.KeyFromObject(u)
+	if err != nil {
+		return nil, err
+	}
+
+	button := component.NewButton("Edit",
+		action.CreatePayload(ActionEditObjectFields, key.ToActionPayload()),
+		component.WithButtonForm(form))
+	return &button, nil
+}
+
+// schemaFormField creates the input for a field.
+func schemaFormField(field openapi.FormField) (component.FormField, error) {
+	name := schemaFormFieldPrefix + field.Path
+
+	label := field.Path
+	if field.Required {
+		label += " (required)"
+	}
+
+	value := ""
+	if field.Value != nil {
+		value = fmt.Sprintf("%v", field.Value)
+	}
+
+	switch {
+	case len(field.Enum) > 0:
+		return component.NewFormFieldSelect(label, name, schemaFormChoices(field.Enum, value), false), nil
+	case field.Type == openapi.FormFieldBoolean:
+		return component.NewFormFieldSelect(label, name, schemaFormChoices([]string{"true", "false"}, value), false), nil
+	case field.Type == openapi.FormFieldInteger, field.Type == openapi.FormFieldNumber:
+		return component.NewFormFieldNumber(label, name, value), nil
+	case field.Type == openapi.FormFieldYAML:
+		if field.Value == nil {
+			return component.NewFormFieldTextarea(label, name, ""), nil
+		}
+		data, err := yaml.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+		return component.NewFormFieldTextarea(label, name, string(data)), nil
+	default:
+		return component.NewFormFieldText(label, name, value), nil
+	}
+}
+
+// schemaFormChoices creates the choices of a select. The first choice leaves
+// the field unset.
+func schemaFormChoices(values []string, current string) []component.InputChoice {
+	choices := []component.InputChoice{{Label: "", Value: "", Checked: current == ""}}
+	for _, value := range values {
+		choices = append(choices, component.InputChoice{Label: value, Value: value, Checked: value == current})
+	}
+	return choices
+}
+
+// SchemaFormEditor updates an object with the values of a form generated from
+// its schema.
+type SchemaFormEditor struct {
+	logger        log.Logger
+	store         store.Store
+	clusterClient cluster.ClientInterface
+}
+
+var _ action.Dispatcher = (*SchemaFormEditor)(nil)
+
+// NewSchemaFormEditor creates an instance of SchemaFormEditor.
+func NewSchemaFormEditor(logger log.Logger, objectStore store.Store, clusterClient cluster.ClientInterface) *SchemaFormEditor {
+	return &SchemaFormEditor{
+		logger:        logger,
+		store:         objectStore,
+		clusterClient: clusterClient,
+	}
+}
+
+// ActionName returns the name of the action.
+func (e *SchemaFormEditor) ActionName() string {
+	return ActionEditObjectFields
+}
+
+// Handle patches an object with the fields in the payload. Built in kinds are
+// patched with a strategic merge patch, and custom resources with a JSON merge
+// patch.
+func (e *SchemaFormEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	e.logger.With("payload", payload, "actionName", e.ActionName()).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	if err := e.update(ctx, key, payload); err != nil {
+		message := fmt.Sprintf("Unable to update %s %q: %s", key.Kind, key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	message := fmt.Sprintf("Updated %s %q", key.Kind, key.Name)
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+func (e *SchemaFormEditor) update(ctx context.Context, key store.Key, payload action.Payload) error {
+	object, err := e.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return fmt.Errorf("object not found")
+	}
+
+	resources, err := e.clusterClient.OpenAPIResources()
+	if err != nil {
+		return err
+	}
+
+	fields, ok := resources.FormFields(object.GroupVersionKind(), object.Object)
+	if !ok {
+		return fmt.Errorf("cluster does not publish a schema for %s", key.Kind)
+	}
+
+	patch, err := schemaFormPatch(object.Object, fields, payload)
+	if err != nil {
+		return err
+	}
+
+	return e.store.Update(ctx, key, func(u *unstructured.Unstructured) error {
+		return applySchemaFormPatch(u, patch)
+	})
+}
+
+// schemaFormPatch creates a merge patch from the fields in the payload. Arrays
+// are replaced by merge patches, so items of arrays are patched in a copy of
+// the array.
+func schemaFormPatch(object map[string]interface{}, fields []openapi.FormField, payload action.Payload) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+
+	// fields of new items are only set if any of the item's fields are set.
+	newItems := map[string][]openapi.FormField{}
+	newValues := map[string]interface{}{}
+	var newItemOrder []string
+
+	for _, field := range fields {
+		raw, ok := payload[schemaFormFieldPrefix+field.Path]
+		if !ok {
+			continue
+		}
+
+		value, err := schemaFormValue(field, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Path, err)
+		}
+
+		if field.Item != "" {
+			if _, ok := newItems[field.Item]; !ok {
+				newItemOrder = append(newItemOrder, field.Item)
+			}
+			newItems[field.Item] = append(newItems[field.Item], field)
+			newValues[field.Path] = value
+			continue
+		}
+
+		if value == nil && field.Required {
+			return nil, fmt.Errorf("%s is required", field.Path)
+		}
+		if equalJSON(value, field.Value) {
+			continue
+		}
+		if err := setPatchValue(patch, object, field.Elements, value, false); err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Path, err)
+		}
+	}
+
+	for _, item := range newItemOrder {
+		set := false
+		for _, field := range newItems[item] {
+			if newValues[field.Path] != nil {
+				set = true
+			}
+		}
+		if !set {
+			continue
+		}
+
+		for _, field := range newItems[item] {
+			value := newValues[field.Path]
+			if value == nil && field.Required {
+				return nil, fmt.Errorf("%s is required", field.Path)
+			}
+			if err := setPatchValue(patch, object, field.Elements, value, false); err != nil {
+				return nil, fmt.Errorf("%s: %w", field.Path, err)
+			}
+		}
+	}
+
+	return patch, nil
+}
+
+// setPatchValue sets a value in a patch. A nil value removes the field. The
+// first time an array is patched, it is copied from the object, and its items
+// are patched in the copy.
+func setPatchValue(patch, object map[string]interface{}, elements []interface{}, value interface{}, inArray bool) error {
+	if len(elements) == 0 {
+		return fmt.Errorf("path is empty")
+	}
+
+	key, ok := elements[0].(string)
+	if !ok {
+		return fmt.Errorf("expected field, got %v", elements[0])
+	}
+
+	if len(elements) == 1 {
+		if value == nil && inArray {
+			// a copied item omits the field instead of setting it to null.
+			delete(patch, key)
+			return nil
+		}
+		patch[key] = value
+		return nil
+	}
+
+	index, isIndex := elements[1].(int)
+	if !isIndex {
+		subPatch, _ := patch[key].(map[string]interface{})
+		if subPatch == nil {
+			subPatch = map[string]interface{}{}
+			patch[key] = subPatch
+		}
+		subObject, _ := object[key].(map[string]interface{})
+		if inArray {
+			subObject = subPatch
+		}
+		return setPatchValue(subPatch, subObject, elements[1:], value, inArray)
+	}
+
+	list, ok := patch[key].([]interface{})
+	if !ok {
+		current, _ := object[key].([]interface{})
+		list = runtime.DeepCopyJSONValue(append([]interface{}{}, current...)).([]interface{})
+	}
+
+	switch {
+	case index == len(list):
+		list = append(list, map[string]interface{}{})
+	case index < 0 || index > len(list):
+		return fmt.Errorf("index %d is out of range", index)
+	}
+
+	item, ok := list[index].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("item %d is not an object", index)
+	}
+	patch[key] = list
+
+	return setPatchValue(item, item, elements[2:], value, true)
+}
+
+// schemaFormValue converts the value of a form input to the type of its
+// field. It returns nil if the input is empty.
+func schemaFormValue(field openapi.FormField, raw interface{}) (interface{}, error) {
+	// selects submit their initial value as a list.
+	switch v := raw.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, nil
+		}
+		raw = v[0]
+	case []string:
+		if len(v) == 0 {
+			return nil, nil
+		}
+		raw = v[0]
+	}
+
+	if raw == nil {
+		return nil, nil
+	}
+
+	s := fmt.Sprintf("%v", raw)
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	switch field.Type {
+	case openapi.FormFieldInteger:
+		if f, ok := raw.(float64); ok {
+			if f != math.Trunc(f) {
+				return nil, fmt.Errorf("%v is not an integer", f)
+			}
+			return int64(f), nil
+		}
+		i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", s)
+		}
+		return i, nil
+	case openapi.FormFieldNumber:
+		if f, ok := raw.(float64); ok {
+			return f, nil
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", s)
+		}
+		return f, nil
+	case openapi.FormFieldBoolean:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", s)
+		}
+		return b, nil
+	case openapi.FormFieldYAML:
+		var value interface{}
+		if err := yaml.Unmarshal([]byte(s), &value); err != nil {
+			return nil, fmt.Errorf("unable to parse yaml: %w", err)
+		}
+		return value, nil
+	default:
+		if len(field.Enum) > 0 && !containsString(field.Enum, s) {
+			return nil, fmt.Errorf("%q is not one of %s", s, strings.Join(field.Enum, ", "))
+		}
+		return s, nil
+	}
+}
+
+// applySchemaFormPatch applies a merge patch to an object. Kinds which are
+// registered in the client-go scheme are patched with a strategic merge patch,
+// so arrays are merged by their merge keys.
+func applySchemaFormPatch(object *unstructured.Unstructured, patch map[string]interface{}) error {
+	original, err := object.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	patchData, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	dataStruct, err := scheme.Scheme.New(object.GroupVersionKind())
+	switch {
+	case err == nil:
+		patched, err = strategicpatch.StrategicMergePatch(original, patchData, dataStruct)
+	case runtime.IsNotRegisteredError(err):
+		patched, err = jsonpatch.MergePatch(original, patchData)
+	}
+	if err != nil {
+		return fmt.Errorf("patch object: %w", err)
+	}
+
+	return object.UnmarshalJSON(patched)
+}
+
+func equalJSON(a, b interface{}) bool {
+	aData, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bData, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aData, bData)
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func toUnstructured(object runtime.Object) (*unstructured.Unstructured, error) {
+	if u, ok := object.(*unstructured.Unstructured); ok {
+		return u, nil
+	}
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("convert object to unstructured: %w", err)
+	}
+	return &unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/openapi"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const tenantSchema = `{
+  "swagger": "2.0",
+  "info": {"title": "Kubernetes", "version": "v1.18.0"},
+  "paths": {},
+  "definitions": {
+    "dev.octant.v1.Tenant": {
+      "type": "object",
+      "properties": {
+        "apiVersion": {"type": "string"},
+        "kind": {"type": "string"},
+        "metadata": {"type": "object"},
+        "spec": {
+          "type": "object",
+          "required": ["tier"],
+          "properties": {
+            "tier": {"type": "string", "enum": ["free", "paid"]},
+            "quota": {"type": "integer", "format": "int64"},
+            "suspended": {"type": "boolean"},
+            "owners": {
+              "type": "array",
+              "items": {
+                "type": "object",
+                "required": ["name"],
+                "properties": {"name": {"type": "string"}, "email": {"type": "string"}}
+              }
+            },
+            "labels": {"type": "object", "additionalProperties": {"type": "string"}}
+          }
+        }
+      },
+      "x-kubernetes-group-version-kind": [{"group": "octant.dev", "kind": "Tenant", "version": "v1"}]
+    }
+  }
+}`
+
+func tenantResources(t *testing.T) *openapi.Resources {
+	doc, err := openapi_v2.ParseDocument([]byte(tenantSchema))
+	require.NoError(t, err)
+	resources, err := openapi.NewResources(doc)
+	require.NoError(t, err)
+	return resources
+}
+
+func tenant() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "octant.dev/v1",
+		"kind":       "Tenant",
+		"metadata":   map[string]interface{}{"name": "acme", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"tier":  "free",
+			"quota": int64(10),
+			"owners": []interface{}{
+				map[string]interface{}{"name": "alice", "email": "alice@example.com"},
+			},
+		},
+	}}
+}
+
+func TestSchemaEditButton(t *testing.T) {
+	object := tenant()
+
+	got, err := SchemaEditButton(tenantResources(t), object)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	form, err := component.CreateFormForObject(ActionEditObjectFields, object,
+		component.NewFormFieldTextarea("spec.labels", "field:spec.labels", ""),
+		component.NewFormFieldText("spec.owners[0].email", "field:spec.owners[0].email", "alice@example.com"),
+		component.NewFormFieldText("spec.owners[0].name (required)", "field:spec.owners[0].name", "alice"),
+		component.NewFormFieldText("spec.owners[1].email", "field:spec.owners[1].email", ""),
+		component.NewFormFieldText("spec.owners[1].name (required)", "field:spec.owners[1].name", ""),
+		component.NewFormFieldNumber("spec.quota", "field:spec.quota", "10"),
+		component.NewFormFieldSelect("spec.suspended", "field:spec.suspended", []component.InputChoice{
+			{Label: "", Value: "", Checked: true},
+			{Label: "true", Value: "true"},
+			{Label: "false", Value: "false"},
+		}, false),
+		component.NewFormFieldSelect("spec.tier (required)", "field:spec.tier", []component.InputChoice{
+			{Label: "", Value: ""},
+			{Label: "free", Value: "free", Checked: true},
+			{Label: "paid", Value: "paid"},
+		}, false),
+	)
+	require.NoError(t, err)
+
+	key, err := store.KeyFromObject(object)
+	require.NoError(t, err)
+
+	expected := component.NewButton("Edit",
+		action.CreatePayload(ActionEditObjectFields, key.ToActionPayload()),
+		component.WithButtonForm(form))
+	testutil.AssertJSONEqual(t, expected, *got)
+
+	got, err = SchemaEditButton(tenantResources(t), testutil.CreatePod("pod"))
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestSchemaFormPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name: "unchanged",
+			payload: action.Payload{
+				"field:spec.tier":  []interface{}{"free"},
+				"field:spec.quota": "10",
+			},
+			expected: map[string]interface{}{},
+		},
+		{
+			name: "typed values",
+			payload: action.Payload{
+				"field:spec.tier":      "paid",
+				"field:spec.quota":     float64(20),
+				"field:spec.suspended": "true",
+				"field:spec.labels":    "team: a\n",
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"tier":      "paid",
+					"quota":     int64(20),
+					"suspended": true,
+					"labels":    map[string]interface{}{"team": "a"},
+				},
+			},
+		},
+		{
+			name: "cleared value",
+			payload: action.Payload{
+				"field:spec.quota": "",
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"quota": nil},
+			},
+		},
+		{
+			name: "array items",
+			payload: action.Payload{
+				"field:spec.owners[0].email": "",
+				"field:spec.owners[1].name":  "bob",
+				"field:spec.owners[1].email": "",
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"owners": []interface{}{
+						map[string]interface{}{"name": "alice"},
+						map[string]interface{}{"name": "bob"},
+					},
+				},
+			},
+		},
+		{
+			name: "empty new item",
+			payload: action.Payload{
+				"field:spec.owners[1].name":  "",
+				"field:spec.owners[1].email": "",
+			},
+			expected: map[string]interface{}{},
+		},
+		{
+			name: "new item without required field",
+			payload: action.Payload{
+				"field:spec.owners[1].name":  "",
+				"field:spec.owners[1].email": "bob@example.com",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "required field",
+			payload: action.Payload{"field:spec.tier": ""},
+			wantErr: true,
+		},
+		{
+			name:    "invalid enum",
+			payload: action.Payload{"field:spec.tier": "gold"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid integer",
+			payload: action.Payload{"field:spec.quota": "ten"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid yaml",
+			payload: action.Payload{"field:spec.labels": "team: [a"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			object := tenant()
+
+			fields, ok := tenantResources(t).FormFields(object.GroupVersionKind(), object.Object)
+			require.True(t, ok)
+
+			got, err := schemaFormPatch(object.Object, fields, test.payload)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, tenant(), object, "object should not be modified")
+		})
+	}
+}
+
+func TestApplySchemaFormPatch(t *testing.T) {
+	t.Run("custom resource", func(t *testing.T) {
+		object := tenant()
+		patch := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"quota": nil,
+				"tier":  "paid",
+			},
+		}
+		require.NoError(t, applySchemaFormPatch(object, patch))
+
+		spec, _, _ := unstructured.NestedMap(object.Object, "spec")
+		assert.Equal(t, map[string]interface{}{
+			"tier": "paid",
+			"owners": []interface{}{
+				map[string]interface{}{"name": "alice", "email": "alice@example.com"},
+			},
+		}, spec)
+	})
+
+	t.Run("strategic merge", func(t *testing.T) {
+		deployment := testutil.CreateDeployment("web")
+		deployment.Spec.Template.Spec.Containers = []corev1.Container{
+			{Name: "web", Image: "nginx:1"},
+			{Name: "sidecar", Image: "envoy"},
+		}
+		object := testutil.ToUnstructured(t, deployment)
+
+		patch := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "web", "image": "nginx:2"},
+						},
+					},
+				},
+			},
+		}
+		require.NoError(t, applySchemaFormPatch(object, patch))
+
+		containers, _, _ := unstructured.NestedSlice(object.Object, "spec", "template", "spec", "containers")
+		require.Len(t, containers, 2)
+		assert.Equal(t, "nginx:2", containers[0].(map[string]interface{})["image"])
+		assert.Equal(t, "envoy", containers[1].(map[string]interface{})["image"])
+	})
+}
+
+func TestSchemaFormEditor_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := tenant()
+	key, err := store.KeyFromObject(object)
+	require.NoError(t, err)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+			updated := tenant()
+			require.NoError(t, fn(updated))
+			tier, _, _ := unstructured.NestedString(updated.Object, "spec", "tier")
+			assert.Equal(t, "paid", tier)
+			return nil
+		})
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().OpenAPIResources().Return(tenantResources(t), nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Updated Tenant "acme"`, alert.Message)
+		})
+
+	editor := NewSchemaFormEditor(log.NopLogger(), objectStore, clusterClient)
+	assert.Equal(t, ActionEditObjectFields, editor.ActionName())
+
+	payload := action.CreatePayload(ActionEditObjectFields, key.ToActionPayload())
+	payload["field:spec.tier"] = "paid"
+
+	require.NoError(t, editor.Handle(context.Background(), alerter, payload))
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+// SchemaValidator validates YAML against the schemas in the cluster's OpenAPI document.
+type SchemaValidator struct {
+	clusterClient cluster.ClientInterface
+}
+
+// NewSchemaValidator creates an instance of SchemaValidator.
+func NewSchemaValidator(clusterClient cluster.ClientInterface) *SchemaValidator {
+	return &SchemaValidator{
+		clusterClient: clusterClient,
+	}
+}
+
+// ValidateYAML validates each document in the YAML. It returns
+// openapi.ValidationErrors if fields do not match their schemas. If the schemas
+// can't be loaded, e.g. in snapshots, the YAML is not validated, and is left to
+// the API server to validate.
+func (v *SchemaValidator) ValidateYAML(ctx context.Context, input string) error {
+	if v == nil || v.clusterClient == nil {
+		return nil
+	}
+
+	resources, err := v.clusterClient.OpenAPIResources()
+	if err != nil {
+		log.From(ctx).WithErr(err).Debugf("unable to load openapi schemas; yaml will not be validated")
+		return nil
+	}
+
+	return resources.ValidateYAML(input)
+}
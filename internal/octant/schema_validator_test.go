@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/openapi"
+)
+
+const configMapSchema = `{
+  "swagger": "2.0",
+  "info": {"title": "Kubernetes", "version": "v1.18.0"},
+  "paths": {},
+  "definitions": {
+    "io.k8s.api.core.v1.ConfigMap": {
+      "type": "object",
+      "properties": {
+        "apiVersion": {"type": "string"},
+        "kind": {"type": "string"},
+        "metadata": {"type": "object"},
+        "data": {"type": "object", "additionalProperties": {"type": "string"}}
+      },
+      "x-kubernetes-group-version-kind": [{"group": "", "kind": "ConfigMap", "version": "v1"}]
+    }
+  }
+}`
+
+func configMapResources(t *testing.T) *openapi.Resources {
+	doc, err := openapi_v2.ParseDocument([]byte(configMapSchema))
+	require.NoError(t, err)
+	resources, err := openapi.NewResources(doc)
+	require.NoError(t, err)
+	return resources
+}
+
+func TestSchemaValidator_ValidateYAML(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		resourcesErr error
+		expected     error
+	}{
+		{
+			name:  "valid",
+			input: "apiVersion: v1\nkind: ConfigMap\ndata:\n  key: value\n",
+		},
+		{
+			name:  "unknown field",
+			input: "apiVersion: v1\nkind: ConfigMap\nspec:\n  key: value\n",
+			expected: openapi.ValidationErrors{
+				{Line: 3, Path: "spec", Message: "unknown field"},
+			},
+		},
+		{
+			name:         "schemas are not available",
+			input:        "apiVersion: v1\nkind: ConfigMap\nspec:\n  key: value\n",
+			resourcesErr: fmt.Errorf("forbidden"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			if test.resourcesErr != nil {
+				clusterClient.EXPECT().OpenAPIResources().Return(nil, test.resourcesErr)
+			} else {
+				clusterClient.EXPECT().OpenAPIResources().Return(configMapResources(t), nil)
+			}
+
+			validator := NewSchemaValidator(clusterClient)
+			err := validator.ValidateYAML(context.Background(), test.input)
+			if test.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, test.expected, err)
+		})
+	}
+}
+
+func TestSchemaValidator_ValidateYAML_nil(t *testing.T) {
+	var validator *SchemaValidator
+	require.NoError(t, validator.ValidateYAML(context.Background(), "kind: ConfigMap"))
+}
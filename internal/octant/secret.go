@@ -0,0 +1,322 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// SecretRevealDuration is how long a revealed secret value is shown before
+	// it is hidden again.
+	SecretRevealDuration = 5 * time.Minute
+
+	// SecretEncodingText and SecretEncodingBase64 are the encodings of values
+	// entered in secret data forms.
+	SecretEncodingText   = "text"
+	SecretEncodingBase64 = "base64"
+)
+
+// SecretReveals tracks which secret values are revealed in each session.
+// Values are decoded by Octant, and are only sent to the browser of the
+// session they are revealed in while they are revealed. It is shared by the
+// action which reveals values and the printer which shows them.
+type SecretReveals struct {
+	revealed map[secretRevealKey]time.Time
+	now      func() time.Time
+	mu       sync.RWMutex
+}
+
+type secretRevealKey struct {
+	session Session
+	key     store.Key
+	dataKey string
+}
+
+// NewSecretReveals creates an instance of SecretReveals.
+func NewSecretReveals() *SecretReveals {
+	return &SecretReveals{
+		revealed: map[secretRevealKey]time.Time{},
+		now:      time.Now,
+	}
+}
+
+// Reveal reveals a secret value in a session for SecretRevealDuration. Values
+// whose reveals expired are forgotten.
+func (r *SecretReveals) Reveal(session Session, key store.Key, dataKey string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	for revealKey, expires := range r.revealed {
+		if !now.Before(expires) {
+			delete(r.revealed, revealKey)
+		}
+	}
+
+	r.revealed[secretRevealKey{session: session, key: key, dataKey: dataKey}] = now.Add(SecretRevealDuration)
+}
+
+// Hide hides a secret value in a session.
+func (r *SecretReveals) Hide(session Session, key store.Key, dataKey string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.revealed, secretRevealKey{session: session, key: key, dataKey: dataKey})
+}
+
+// IsRevealed returns true if a secret value is revealed in a session. A reveal
+// which expired is forgotten.
+func (r *SecretReveals) IsRevealed(session Session, key store.Key, dataKey string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	revealKey := secretRevealKey{session: session, key: key, dataKey: dataKey}
+	expires, ok := r.revealed[revealKey]
+	if !ok {
+		return false
+	}
+	if !r.now().Before(expires) {
+		delete(r.revealed, revealKey)
+		return false
+	}
+	return true
+}
+
+// RemoveClient forgets the values revealed in a client's sessions.
+func (r *SecretReveals) RemoveClient(clientID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for revealKey := range r.revealed {
+		if revealKey.session.ClientID == clientID {
+			delete(r.revealed, revealKey)
+		}
+	}
+}
+
+// SecretRevealer reveals and hides secret values in the session of the client
+// which requests it. Each reveal is logged.
+type SecretRevealer struct {
+	logger      log.Logger
+	store       store.Store
+	contextName func() string
+	reveals     *SecretReveals
+}
+
+var _ action.Dispatcher = (*SecretRevealer)(nil)
+
+// NewSecretRevealer creates an instance of SecretRevealer. contextName returns
+// the name of the current cluster context.
+func NewSecretRevealer(logger log.Logger, objectStore store.Store, contextName func() string, reveals *SecretReveals) *SecretRevealer {
+	return &SecretRevealer{
+		logger:      logger.With("action", ActionSecretReveal),
+		store:       objectStore,
+		contextName: contextName,
+		reveals:     reveals,
+	}
+}
+
+// ActionName returns the name of the action.
+func (s *SecretRevealer) ActionName() string {
+	return ActionSecretReveal
+}
+
+// Handle reveals or hides the secret value in the payload.
+func (s *SecretRevealer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	key, dataKey, err := secretDataKeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	reveal, err := payload.Bool("reveal")
+	if err != nil {
+		return err
+	}
+
+	session := NewSession(ctx, s.contextName())
+
+	if !reveal {
+		s.reveals.Hide(session, key, dataKey)
+		return nil
+	}
+
+	object, err := s.store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("get secret: %w", err)
+	}
+	if object == nil {
+		message := fmt.Sprintf("Unable to reveal %q: secret %q does not exist", dataKey, key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+	if _, ok, _ := unstructured.NestedString(object.Object, "data", dataKey); !ok {
+		message := fmt.Sprintf("Unable to reveal %q: secret %q has no such key", dataKey, key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	s.logger.
+		With("namespace", key.Namespace, "secret", key.Name, "key", dataKey).
+		Infof("revealed secret value")
+	s.reveals.Reveal(session, key, dataKey)
+
+	return nil
+}
+
+// SecretDataEditor adds and updates the values of secrets. Values are base64
+// encoded by Octant unless they are entered encoded.
+type SecretDataEditor struct {
+	logger log.Logger
+	store  store.Store
+}
+
+var _ action.Dispatcher = (*SecretDataEditor)(nil)
+
+// NewSecretDataEditor creates an instance of SecretDataEditor.
+func NewSecretDataEditor(logger log.Logger, objectStore store.Store) *SecretDataEditor {
+	return &SecretDataEditor{
+		logger: logger.With("action", ActionSecretDataUpdate),
+		store:  objectStore,
+	}
+}
+
+// ActionName returns the name of the action.
+func (s *SecretDataEditor) ActionName() string {
+	return ActionSecretDataUpdate
+}
+
+// Handle sets the secret value in the payload.
+func (s *SecretDataEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	key, dataKey, err := secretDataKeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	value, err := payload.OptionalString("value")
+	if err != nil {
+		return err
+	}
+
+	encoded, err := encodeSecretValue(value, payloadSelectString(payload, "encoding"))
+	if err != nil {
+		message := fmt.Sprintf("Unable to update %q in secret %q: %s", dataKey, key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	err = s.store.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, encoded, "data", dataKey)
+	})
+	sendSecretDataAlert(alerter, s.logger, "Updated", key, dataKey, err)
+
+	return nil
+}
+
+// SecretDataDeleter deletes the values of secrets.
+type SecretDataDeleter struct {
+	logger log.Logger
+	store  store.Store
+}
+
+var _ action.Dispatcher = (*SecretDataDeleter)(nil)
+
+// NewSecretDataDeleter creates an instance of SecretDataDeleter.
+func NewSecretDataDeleter(logger log.Logger, objectStore store.Store) *SecretDataDeleter {
+	return &SecretDataDeleter{
+		logger: logger.With("action", ActionSecretDataDelete),
+		store:  objectStore,
+	}
+}
+
+// ActionName returns the name of the action.
+func (s *SecretDataDeleter) ActionName() string {
+	return ActionSecretDataDelete
+}
+
+// Handle deletes the secret value in the payload.
+func (s *SecretDataDeleter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	key, dataKey, err := secretDataKeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		unstructured.RemoveNestedField(object.Object, "data", dataKey)
+		return nil
+	})
+	sendSecretDataAlert(alerter, s.logger, "Deleted", key, dataKey, err)
+
+	return nil
+}
+
+func sendSecretDataAlert(alerter action.Alerter, logger log.Logger, verb string, key store.Key, dataKey string, err error) {
+	message := fmt.Sprintf("%s %q in secret %q", verb, dataKey, key.Name)
+	alertType := action.AlertTypeInfo
+	if err != nil {
+		logger.WithErr(err).Errorf("update secret data")
+		message = fmt.Sprintf("Unable to update %q in secret %q: %s", dataKey, key.Name, err)
+		alertType = action.AlertTypeWarning
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+}
+
+func secretDataKeyFromPayload(payload action.Payload) (store.Key, string, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return store.Key{}, "", err
+	}
+
+	dataKey, err := payload.String("secretKey")
+	if err != nil {
+		return store.Key{}, "", err
+	}
+	dataKey = strings.TrimSpace(dataKey)
+	if dataKey == "" {
+		return store.Key{}, "", fmt.Errorf("secret key is blank")
+	}
+
+	return key, dataKey, nil
+}
+
+// payloadSelectString returns the value of a select field. Selects send their
+// initial value as a list.
+func payloadSelectString(payload action.Payload, key string) string {
+	switch v := payload[key].(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			return fmt.Sprintf("%v", v[0])
+		}
+	}
+	return ""
+}
+
+// encodeSecretValue base64 encodes a value entered in a secret data form.
+func encodeSecretValue(value, encoding string) (string, error) {
+	switch encoding {
+	case "", SecretEncodingText:
+		return base64.StdEncoding.EncodeToString([]byte(value)), nil
+	case SecretEncodingBase64:
+		value = strings.Join(strings.Fields(value), "")
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			return "", fmt.Errorf("value is not valid base64")
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("unknown encoding %q", encoding)
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+var secretStoreKey = store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"}
+
+func secretPayload(actionName string, fields map[string]interface{}) action.Payload {
+	payload := secretStoreKey.ToActionPayload()
+	for k, v := range fields {
+		payload[k] = v
+	}
+	return action.CreatePayload(actionName, payload)
+}
+
+func TestSecretReveals(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	reveals := NewSecretReveals()
+	reveals.now = func() time.Time { return now }
+
+	session := Session{ClientID: "client", ContextName: "cluster"}
+
+	assert.False(t, reveals.IsRevealed(session, secretStoreKey, "password"))
+
+	reveals.Reveal(session, secretStoreKey, "password")
+	assert.True(t, reveals.IsRevealed(session, secretStoreKey, "password"))
+	assert.False(t, reveals.IsRevealed(session, secretStoreKey, "username"))
+	assert.False(t, reveals.IsRevealed(Session{ClientID: "other", ContextName: "cluster"}, secretStoreKey, "password"))
+	assert.False(t, reveals.IsRevealed(Session{ClientID: "client", ContextName: "other"}, secretStoreKey, "password"))
+
+	now = now.Add(SecretRevealDuration)
+	assert.False(t, reveals.IsRevealed(session, secretStoreKey, "password"), "reveal did not expire")
+	assert.Empty(t, reveals.revealed, "expired reveal was not forgotten on read")
+
+	reveals.Reveal(session, secretStoreKey, "username")
+	assert.Len(t, reveals.revealed, 1, "expired reveal was not forgotten")
+
+	reveals.Reveal(session, secretStoreKey, "password")
+	reveals.Hide(session, secretStoreKey, "password")
+	assert.False(t, reveals.IsRevealed(session, secretStoreKey, "password"))
+}
+
+func TestSecretReveals_RemoveClient(t *testing.T) {
+	reveals := NewSecretReveals()
+
+	session := Session{ClientID: "client", ContextName: "cluster"}
+	other := Session{ClientID: "other", ContextName: "cluster"}
+
+	reveals.Reveal(session, secretStoreKey, "password")
+	reveals.Reveal(Session{ClientID: "client", ContextName: "other"}, secretStoreKey, "password")
+	reveals.Reveal(other, secretStoreKey, "password")
+
+	reveals.RemoveClient("client")
+
+	assert.Len(t, reveals.revealed, 1)
+	assert.True(t, reveals.IsRevealed(other, secretStoreKey, "password"))
+}
+
+func TestSecretRevealer_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	secret := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+	require.NoError(t, unstructured.SetNestedField(secret.Object, "cGFzc3dvcmQ=", "data", "password"))
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), secretStoreKey).Return(secret, nil).Times(2)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, `Unable to reveal "missing": secret "secret" has no such key`, alert.Message)
+		})
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	reveals := NewSecretReveals()
+
+	contextName := func() string { return "cluster" }
+	revealer := NewSecretRevealer(log.Wrap(zap.New(core).Sugar()), objectStore, contextName, reveals)
+	assert.Equal(t, ActionSecretReveal, revealer.ActionName())
+
+	ctx := WithClientID(context.Background(), "client")
+	session := Session{ClientID: "client", ContextName: "cluster"}
+
+	payload := secretPayload(ActionSecretReveal, map[string]interface{}{"secretKey": "password", "reveal": true})
+	require.NoError(t, revealer.Handle(ctx, alerter, payload))
+	assert.True(t, reveals.IsRevealed(session, secretStoreKey, "password"))
+	assert.False(t, reveals.IsRevealed(Session{ContextName: "cluster"}, secretStoreKey, "password"))
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "revealed secret value", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{
+		"action":    ActionSecretReveal,
+		"namespace": "default",
+		"secret":    "secret",
+		"key":       "password",
+	}, entries[0].ContextMap())
+
+	payload = secretPayload(ActionSecretReveal, map[string]interface{}{"secretKey": "missing", "reveal": true})
+	require.NoError(t, revealer.Handle(ctx, alerter, payload))
+	assert.False(t, reveals.IsRevealed(session, secretStoreKey, "missing"))
+
+	payload = secretPayload(ActionSecretReveal, map[string]interface{}{"secretKey": "password", "reveal": false})
+	require.NoError(t, revealer.Handle(ctx, alerter, payload))
+	assert.False(t, reveals.IsRevealed(session, secretStoreKey, "password"))
+	assert.Len(t, logs.All(), 1, "hiding a value was logged as a reveal")
+}
+
+func TestSecretDataEditor_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           string
+		encoding        interface{}
+		expected        string
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "text",
+			value:           "password\n",
+			encoding:        []interface{}{SecretEncodingText},
+			expected:        "cGFzc3dvcmQK",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Updated "password" in secret "secret"`,
+		},
+		{
+			name:            "base64",
+			value:           "cGFzc3dv\ncmQK",
+			encoding:        SecretEncodingBase64,
+			expected:        "cGFzc3dvcmQK",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Updated "password" in secret "secret"`,
+		},
+		{
+			name:            "invalid base64",
+			value:           "not base64!",
+			encoding:        SecretEncodingBase64,
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to update "password" in secret "secret": value is not valid base64`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := storeFake.NewMockStore(controller)
+			if test.expected != "" {
+				objectStore.EXPECT().
+					Update(gomock.Any(), secretStoreKey, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+						secret := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+						require.NoError(t, fn(secret))
+
+						value, _, err := unstructured.NestedString(secret.Object, "data", "password")
+						require.NoError(t, err)
+						assert.Equal(t, test.expected, value)
+						return nil
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			editor := NewSecretDataEditor(log.NopLogger(), objectStore)
+			assert.Equal(t, ActionSecretDataUpdate, editor.ActionName())
+
+			payload := secretPayload(ActionSecretDataUpdate, map[string]interface{}{
+				"secretKey": "password",
+				"value":     test.value,
+				"encoding":  test.encoding,
+			})
+			require.NoError(t, editor.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
+
+func TestSecretDataDeleter_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), secretStoreKey, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+			secret := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+			require.NoError(t, unstructured.SetNestedField(secret.Object, "cGFzc3dvcmQ=", "data", "password"))
+			require.NoError(t, fn(secret))
+
+			_, found, err := unstructured.NestedString(secret.Object, "data", "password")
+			require.NoError(t, err)
+			assert.False(t, found)
+			return nil
+		})
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Deleted "password" in secret "secret"`, alert.Message)
+		})
+
+	deleter := NewSecretDataDeleter(log.NopLogger(), objectStore)
+	assert.Equal(t, ActionSecretDataDelete, deleter.ActionName())
+
+	payload := secretPayload(ActionSecretDataDelete, map[string]interface{}{"secretKey": "password"})
+	require.NoError(t, deleter.Handle(context.Background(), alerter, payload))
+}
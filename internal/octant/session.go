@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import "context"
+
+type clientIDKey struct{}
+
+// WithClientID returns a copy of ctx which carries the ID of the client a
+// request is handled for.
+func WithClientID(ctx context.Context, clientID string) context.Context {
+	return context.WithValue(ctx, clientIDKey{}, clientID)
+}
+
+// ClientIDFrom returns the client ID ctx carries. It returns an empty string
+// if ctx does not carry one.
+func ClientIDFrom(ctx context.Context) string {
+	clientID, _ := ctx.Value(clientIDKey{}).(string)
+	return clientID
+}
+
+// Session is a client's view of a cluster context. State a client creates,
+// such as previews, is kept per session so it isn't shown to other clients
+// or in other contexts.
+type Session struct {
+	ClientID    string
+	ContextName string
+}
+
+// NewSession creates the session of the client ctx carries in a context.
+func NewSession(ctx context.Context, contextName string) Session {
+	return Session{
+		ClientID:    ClientIDFrom(ctx),
+		ContextName: contextName,
+	}
+}
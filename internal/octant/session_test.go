@@ -0,0 +1,21 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSession(t *testing.T) {
+	assert.Equal(t, Session{ContextName: "cluster"}, NewSession(context.Background(), "cluster"))
+
+	ctx := WithClientID(context.Background(), "client")
+	assert.Equal(t, "client", ClientIDFrom(ctx))
+	assert.Equal(t, Session{ClientID: "client", ContextName: "cluster"}, NewSession(ctx, "cluster"))
+}
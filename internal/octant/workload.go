@@ -324,10 +324,16 @@ type ClusterWorkloadLoader struct {
 	PodMetricsLoader PodMetricsLoader
 }
 
+// workloadStatus creates the status of a workload. Workloads don't store
+// certificates, so the default status options are used.
+func workloadStatus(ctx context.Context, object runtime.Object, o store.Store) (objectstatus.ObjectStatus, error) {
+	return objectstatus.Status(ctx, object, o)
+}
+
 // NewWorkloadLoader creates an instance of ClusterWorkloadLoader.
 func NewClusterWorkloadLoader(objectStore store.Store, pml PodMetricsLoader, options ...ClusterWorkloadLoaderOption) (*ClusterWorkloadLoader, error) {
 	wl := &ClusterWorkloadLoader{
-		ObjectStatuser:   objectstatus.Status,
+		ObjectStatuser:   workloadStatus,
 		ObjectStore:      objectStore,
 		PodMetricsLoader: pml,
 	}
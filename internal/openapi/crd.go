@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"gopkg.in/yaml.v3"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// objectMetaDefinition is the name of the definition of object metadata.
+const objectMetaDefinition = "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"
+
+// CRDGetter gets a cluster's custom resource definitions.
+type CRDGetter interface {
+	CustomResourceDefinitions() ([]apiextv1.CustomResourceDefinition, error)
+}
+
+// v2SchemaKeys are the keys OpenAPI v2 schemas may contain, besides extensions.
+// openAPIV3Schema keys such as nullable and oneOf are dropped.
+var v2SchemaKeys = map[string]bool{
+	"$ref": true, "additionalProperties": true, "allOf": true, "default": true, "description": true,
+	"discriminator": true, "enum": true, "example": true, "exclusiveMaximum": true, "exclusiveMinimum": true,
+	"externalDocs": true, "format": true, "items": true, "maxItems": true, "maxLength": true,
+	"maxProperties": true, "maximum": true, "minItems": true, "minLength": true, "minProperties": true,
+	"minimum": true, "multipleOf": true, "pattern": true, "properties": true, "readOnly": true,
+	"required": true, "title": true, "type": true, "uniqueItems": true, "xml": true,
+}
+
+// withCRDSchemas returns a copy of an OpenAPI document with the openAPIV3Schema of
+// each custom resource version the document does not publish a schema for. The API
+// server does not publish schemas which are not structural, and older API servers
+// do not publish custom resources at all.
+func withCRDSchemas(doc *openapi_v2.Document, crds []apiextv1.CustomResourceDefinition) (*openapi_v2.Document, error) {
+	published := map[schema.GroupVersionKind]bool{}
+	hasObjectMeta := false
+	for _, namedSchema := range doc.GetDefinitions().GetAdditionalProperties() {
+		if namedSchema.GetName() == objectMetaDefinition {
+			hasObjectMeta = true
+		}
+		for _, gvk := range schemaGroupVersionKinds(namedSchema.GetValue()) {
+			published[gvk] = true
+		}
+	}
+
+	definitions := map[string]interface{}{}
+	for _, crd := range crds {
+		for _, version := range crd.Spec.Versions {
+			if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+				continue
+			}
+
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.Kind}
+			if published[gvk] {
+				continue
+			}
+
+			definition, err := crdDefinition(gvk, version.Schema.OpenAPIV3Schema, hasObjectMeta)
+			if err != nil {
+				return nil, fmt.Errorf("convert schema of %s: %w", gvk, err)
+			}
+			definitions[definitionName(gvk)] = definition
+		}
+	}
+
+	if len(definitions) == 0 {
+		return doc, nil
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"swagger":     "2.0",
+		"info":        map[string]interface{}{"title": "custom resources", "version": "v1"},
+		"paths":       map[string]interface{}{},
+		"definitions": definitions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	crdDoc, err := openapi_v2.ParseDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse custom resource schemas: %w", err)
+	}
+
+	// The copy only has the parts of the document resources are read from.
+	var namedSchemas []*openapi_v2.NamedSchema
+	namedSchemas = append(namedSchemas, doc.GetDefinitions().GetAdditionalProperties()...)
+	namedSchemas = append(namedSchemas, crdDoc.GetDefinitions().GetAdditionalProperties()...)
+
+	return &openapi_v2.Document{
+		Swagger:     doc.GetSwagger(),
+		Info:        doc.GetInfo(),
+		Paths:       doc.GetPaths(),
+		Definitions: &openapi_v2.Definitions{AdditionalProperties: namedSchemas},
+	}, nil
+}
+
+// crdDefinition converts an openAPIV3Schema to an OpenAPI v2 definition of a kind.
+// Metadata refers to the definition of object metadata when the document has it.
+func crdDefinition(gvk schema.GroupVersionKind, props *apiextv1.JSONSchemaProps, hasObjectMeta bool) (map[string]interface{}, error) {
+	data, err := json.Marshal(props)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition map[string]interface{}
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return nil, err
+	}
+
+	toV2Schema(definition)
+
+	// Like the API server, add the fields every object has.
+	properties, ok := definition["properties"].(map[string]interface{})
+	if !ok {
+		properties = map[string]interface{}{}
+		definition["properties"] = properties
+	}
+	properties["apiVersion"] = map[string]interface{}{
+		"type":        "string",
+		"description": "APIVersion defines the versioned schema of this representation of an object.",
+	}
+	properties["kind"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Kind is a string value representing the REST resource this object represents.",
+	}
+	if hasObjectMeta {
+		properties["metadata"] = map[string]interface{}{"$ref": "#/definitions/" + objectMetaDefinition}
+	}
+
+	definition[groupVersionKindExtension] = []interface{}{
+		map[string]interface{}{"group": gvk.Group, "version": gvk.Version, "kind": gvk.Kind},
+	}
+
+	return definition, nil
+}
+
+// toV2Schema drops the keys of a schema and its sub-schemas which OpenAPI v2 does not have.
+func toV2Schema(s map[string]interface{}) {
+	for key := range s {
+		if !v2SchemaKeys[key] && !strings.HasPrefix(key, "x-") {
+			delete(s, key)
+		}
+	}
+
+	if properties, ok := s["properties"].(map[string]interface{}); ok {
+		for _, property := range properties {
+			if m, ok := property.(map[string]interface{}); ok {
+				toV2Schema(m)
+			}
+		}
+	}
+
+	var subSchemas []interface{}
+	switch items := s["items"].(type) {
+	case map[string]interface{}:
+		subSchemas = append(subSchemas, items)
+	case []interface{}:
+		subSchemas = append(subSchemas, items...)
+	}
+	if allOf, ok := s["allOf"].([]interface{}); ok {
+		subSchemas = append(subSchemas, allOf...)
+	}
+	subSchemas = append(subSchemas, s["additionalProperties"])
+
+	for _, subSchema := range subSchemas {
+		if m, ok := subSchema.(map[string]interface{}); ok {
+			toV2Schema(m)
+		}
+	}
+}
+
+// definitionName returns the name the API server gives the definition of a kind,
+// e.g. com.example.v1.Widget for the Widget kind in the example.com/v1 group version.
+func definitionName(gvk schema.GroupVersionKind) string {
+	parts := strings.Split(gvk.Group, ".")
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return fmt.Sprintf("%s.%s.%s", strings.Join(parts, "."), gvk.Version, gvk.Kind)
+}
+
+// schemaGroupVersionKinds returns the kinds an OpenAPI v2 schema is the schema of.
+func schemaGroupVersionKinds(s *openapi_v2.Schema) []schema.GroupVersionKind {
+	var gvks []schema.GroupVersionKind
+	for _, extension := range s.GetVendorExtension() {
+		if extension.GetName() != groupVersionKindExtension {
+			continue
+		}
+
+		var list []struct {
+			Group   string `yaml:"group"`
+			Version string `yaml:"version"`
+			Kind    string `yaml:"kind"`
+		}
+		if err := yaml.Unmarshal([]byte(extension.GetValue().GetYaml()), &list); err != nil {
+			continue
+		}
+
+		for _, item := range list {
+			gvks = append(gvks, schema.GroupVersionKind{Group: item.Group, Version: item.Version, Kind: item.Kind})
+		}
+	}
+	return gvks
+}
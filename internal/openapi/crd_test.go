@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+)
+
+func TestCache_crdSchemas(t *testing.T) {
+	gadget := apiextv1.CustomResourceDefinition{
+		Spec: apiextv1.CustomResourceDefinitionSpec{
+			Group: "octant.dev",
+			Names: apiextv1.CustomResourceDefinitionNames{Kind: "Gadget"},
+			Versions: []apiextv1.CustomResourceDefinitionVersion{
+				{
+					Name: "v1",
+					Schema: &apiextv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextv1.JSONSchemaProps{
+							Description: "Gadget is a custom resource.",
+							Type:        "object",
+							Properties: map[string]apiextv1.JSONSchemaProps{
+								"metadata": {Type: "object"},
+								"spec": {
+									Type:     "object",
+									Required: []string{"size"},
+									Properties: map[string]apiextv1.JSONSchemaProps{
+										"size": {
+											Description: "Size of the gadget.",
+											Type:        "integer",
+											Nullable:    true,
+										},
+										"parts": {
+											Type: "array",
+											Items: &apiextv1.JSONSchemaPropsOrArray{Schema: &apiextv1.JSONSchemaProps{
+												Type:  "string",
+												OneOf: []apiextv1.JSONSchemaProps{{Pattern: "^a"}, {Pattern: "^b"}},
+											}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{Name: "v2"},
+			},
+		},
+	}
+
+	widget := apiextv1.CustomResourceDefinition{
+		Spec: apiextv1.CustomResourceDefinitionSpec{
+			Group: "octant.dev",
+			Names: apiextv1.CustomResourceDefinitionNames{Kind: "Widget"},
+			Versions: []apiextv1.CustomResourceDefinitionVersion{
+				{
+					Name: "v1",
+					Schema: &apiextv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextv1.JSONSchemaProps{Description: "Not published.", Type: "object"},
+					},
+				},
+			},
+		},
+	}
+
+	getter := &fakeSchemaGetter{doc: testDocument(t)}
+	crdGetter := &fakeCRDGetter{crds: []apiextv1.CustomResourceDefinition{gadget, widget}}
+	cache := NewCache(getter, crdGetter)
+
+	resources, err := cache.Resources()
+	require.NoError(t, err)
+
+	gadgetSchema := resources.LookupResource(schema.GroupVersionKind{Group: "octant.dev", Version: "v1", Kind: "Gadget"})
+	require.NotNil(t, gadgetSchema)
+	assert.Equal(t, "Gadget is a custom resource.", gadgetSchema.GetDescription())
+
+	kind, ok := gadgetSchema.(*proto.Kind)
+	require.True(t, ok)
+	metadata, ok := kind.Fields["metadata"].(*proto.Ref)
+	require.True(t, ok, "metadata is not the object metadata definition")
+	assert.Equal(t, objectMetaDefinition, metadata.Reference())
+
+	assert.Nil(t, resources.LookupResource(schema.GroupVersionKind{Group: "octant.dev", Version: "v2", Kind: "Gadget"}))
+
+	widgetSchema := resources.LookupResource(schema.GroupVersionKind{Group: "octant.dev", Version: "v1", Kind: "Widget"})
+	require.NotNil(t, widgetSchema)
+	assert.Equal(t, "Widget is a custom resource.", widgetSchema.GetDescription())
+
+	err = resources.ValidateYAML(`apiVersion: octant.dev/v1
+kind: Gadget
+metadata:
+  name: gadget
+spec:
+  size: large
+`)
+	assert.Equal(t, ValidationErrors{{Line: 6, Path: "spec.size", Message: "expected integer, got string"}}, err)
+}
+
+func TestCache_crdGetterError(t *testing.T) {
+	getter := &fakeSchemaGetter{doc: testDocument(t)}
+	cache := NewCache(getter, &fakeCRDGetter{err: fmt.Errorf("forbidden")})
+
+	resources, err := cache.Resources()
+	require.NoError(t, err)
+	assert.NotNil(t, resources.LookupResource(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}))
+}
+
+func Test_definitionName(t *testing.T) {
+	assert.Equal(t, "dev.octant.v1.Widget", definitionName(schema.GroupVersionKind{Group: "octant.dev", Version: "v1", Kind: "Widget"}))
+}
+
+type fakeCRDGetter struct {
+	crds []apiextv1.CustomResourceDefinition
+	err  error
+}
+
+func (f *fakeCRDGetter) CustomResourceDefinitions() ([]apiextv1.CustomResourceDefinition, error) {
+	return f.crds, f.err
+}
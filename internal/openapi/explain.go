@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+)
+
+// Field is a field of a resource's schema.
+type Field struct {
+	// Path is the path of the field. Items of arrays are shown as [] and values
+	// of maps are shown as *, e.g. spec.containers[].image.
+	Path        string
+	Depth       int
+	Type        string
+	Description string
+	Required    bool
+	// Set is true if the object has a value for the field.
+	Set bool
+}
+
+// Explanation describes a kind and its fields.
+type Explanation struct {
+	GroupVersionKind schema.GroupVersionKind
+	Description      string
+	Fields           []Field
+}
+
+// Explain describes the fields of an object's kind. Fields the object sets are
+// expanded to their sub-fields, and other fields are listed without them, as
+// the schemas of most kinds are too deep to list in full. It returns false if
+// the cluster does not publish a schema for the kind.
+func (r *Resources) Explain(gvk schema.GroupVersionKind, object map[string]interface{}) (*Explanation, bool) {
+	resource := r.LookupResource(gvk)
+	if resource == nil {
+		return nil, false
+	}
+
+	explanation := &Explanation{
+		GroupVersionKind: gvk,
+		Description:      resource.GetDescription(),
+	}
+	explainFields(resource, "", 0, []interface{}{object}, &explanation.Fields)
+
+	return explanation, true
+}
+
+// explainFields appends the fields of a schema. values are the values of the
+// schema in the object, e.g. each container when the schema is a container.
+func explainFields(s proto.Schema, path string, depth int, values []interface{}, fields *[]Field) {
+	switch t := s.(type) {
+	case proto.Reference:
+		explainFields(t.SubSchema(), path, depth, values, fields)
+	case *proto.Array:
+		var items []interface{}
+		for _, value := range values {
+			if list, ok := value.([]interface{}); ok {
+				items = append(items, list...)
+			}
+		}
+		explainFields(t.SubType, path+"[]", depth, items, fields)
+	case *proto.Map:
+		var items []interface{}
+		for _, value := range values {
+			if m, ok := value.(map[string]interface{}); ok {
+				for _, item := range m {
+					items = append(items, item)
+				}
+			}
+		}
+		explainFields(t.SubType, path+".*", depth, items, fields)
+	case *proto.Kind:
+		for _, key := range t.Keys() {
+			field := t.Fields[key]
+
+			var fieldValues []interface{}
+			for _, value := range values {
+				if m, ok := value.(map[string]interface{}); ok && m[key] != nil {
+					fieldValues = append(fieldValues, m[key])
+				}
+			}
+
+			fieldPath := strings.TrimPrefix(path+"."+key, ".")
+			*fields = append(*fields, Field{
+				Path:        fieldPath,
+				Depth:       depth,
+				Type:        schemaTypeName(field),
+				Description: fieldDescription(field),
+				Required:    t.IsRequired(key),
+				Set:         len(fieldValues) > 0,
+			})
+
+			if len(fieldValues) > 0 {
+				explainFields(field, fieldPath, depth+1, fieldValues, fields)
+			}
+		}
+	}
+}
+
+// fieldDescription returns the description of a field, or the description of
+// its type if the field does not have one.
+func fieldDescription(s proto.Schema) string {
+	if description := s.GetDescription(); description != "" {
+		return description
+	}
+	if reference, ok := s.(proto.Reference); ok {
+		return reference.SubSchema().GetDescription()
+	}
+	return ""
+}
+
+// schemaTypeName returns the type of a schema as it is shown to users.
+func schemaTypeName(s proto.Schema) string {
+	switch t := s.(type) {
+	case *proto.Primitive:
+		if t.Format != "" {
+			return fmt.Sprintf("%s (%s)", t.Type, t.Format)
+		}
+		return t.Type
+	case *proto.Array:
+		return "[]" + schemaTypeName(t.SubType)
+	case *proto.Map:
+		return "map[string]" + schemaTypeName(t.SubType)
+	case proto.Reference:
+		sub := t.SubSchema()
+		if _, ok := sub.(*proto.Kind); ok {
+			reference := t.Reference()
+			return reference[strings.LastIndex(reference, ".")+1:]
+		}
+		return schemaTypeName(sub)
+	case *proto.Kind:
+		return "object"
+	default:
+		return "any"
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestResources_Explain(t *testing.T) {
+	resources := testResources(t)
+
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	object := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "web"},
+				map[string]interface{}{"image": "nginx"},
+			},
+		},
+	}
+
+	explanation, ok := resources.Explain(gvk, object)
+	require.True(t, ok)
+
+	assert.Equal(t, gvk, explanation.GroupVersionKind)
+	assert.Equal(t, "Deployment enables declarative updates for Pods and ReplicaSets.", explanation.Description)
+	assert.Equal(t, []Field{
+		{
+			Path:        "apiVersion",
+			Type:        "string",
+			Description: "APIVersion defines the versioned schema of this representation of an object.",
+			Set:         true,
+		},
+		{
+			Path:        "kind",
+			Type:        "string",
+			Description: "Kind is a string value representing the REST resource this object represents.",
+			Set:         true,
+		},
+		{
+			Path:        "metadata",
+			Type:        "ObjectMeta",
+			Description: "Standard object metadata.",
+		},
+		{
+			Path:        "spec",
+			Type:        "DeploymentSpec",
+			Description: "Specification of the desired behavior of the Deployment.",
+			Set:         true,
+		},
+		{
+			Path:        "spec.containers",
+			Depth:       1,
+			Type:        "[]Container",
+			Description: "List of containers.",
+			Set:         true,
+		},
+		{
+			Path:        "spec.containers[].image",
+			Depth:       2,
+			Type:        "string",
+			Description: "Docker image name.",
+			Set:         true,
+		},
+		{
+			Path:        "spec.containers[].name",
+			Depth:       2,
+			Type:        "string",
+			Description: "Name of the container.",
+			Required:    true,
+			Set:         true,
+		},
+		{
+			Path:        "spec.replicas",
+			Depth:       1,
+			Type:        "integer (int32)",
+			Description: "Number of desired pods.",
+		},
+		{
+			Path:        "spec.selector",
+			Depth:       1,
+			Type:        "map[string]string",
+			Description: "Label selector for pods.",
+			Required:    true,
+		},
+	}, explanation.Fields)
+
+	_, ok = resources.Explain(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Unknown"}, object)
+	assert.False(t, ok)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+)
+
+// FormFieldType is the type of a form field's value.
+type FormFieldType string
+
+const (
+	FormFieldString  FormFieldType = "string"
+	FormFieldInteger FormFieldType = "integer"
+	FormFieldNumber  FormFieldType = "number"
+	FormFieldBoolean FormFieldType = "boolean"
+	// FormFieldYAML is a value which is edited as YAML, e.g. a map, an array
+	// of primitives, or an object which is not set yet.
+	FormFieldYAML FormFieldType = "yaml"
+)
+
+// formSkippedFields are top level fields which are not edited with forms.
+var formSkippedFields = map[string]bool{
+	"apiVersion": true,
+	"kind":       true,
+	"metadata":   true,
+	"status":     true,
+}
+
+// FormField is an editable field of an object.
+type FormField struct {
+	// Path is the path of the field, e.g. spec.containers[0].image.
+	Path string
+	// Elements are the elements of the path. Fields are strings and array
+	// indexes are ints.
+	Elements    []interface{}
+	Description string
+	Type        FormFieldType
+	// Enum are the values the field allows. It is nil if any value is allowed.
+	Enum     []string
+	Required bool
+	// Value is the field's value. It is nil if the field is not set.
+	Value interface{}
+	// Item is the path of the array item the field belongs to if the item
+	// does not exist yet, e.g. spec.containers[1]. Each array of objects has
+	// one new item, which is created if any of its fields are set.
+	Item string
+}
+
+// FormFields returns the editable fields of an object. Objects the object sets
+// are expanded to their fields, and arrays of objects are expanded to their
+// items and a new item. Other values are edited as YAML. It returns false if
+// the cluster does not publish a schema for the kind.
+func (r *Resources) FormFields(gvk schema.GroupVersionKind, object map[string]interface{}) ([]FormField, bool) {
+	resource := r.LookupResource(gvk)
+	if resource == nil {
+		return nil, false
+	}
+
+	kind, ok := resolveSchema(resource).(*proto.Kind)
+	if !ok {
+		return nil, true
+	}
+
+	var fields []FormField
+	r.formKindFields(kind, formPath{}, object, "", &fields)
+	return fields, true
+}
+
+// formPath is the path of a form field.
+type formPath struct {
+	path     string
+	elements []interface{}
+}
+
+func (p formPath) field(name string) formPath {
+	path := name
+	if p.path != "" {
+		path = p.path + "." + name
+	}
+	return formPath{path: path, elements: appendElement(p.elements, name)}
+}
+
+func (p formPath) index(i int) formPath {
+	return formPath{path: fmt.Sprintf("%s[%d]", p.path, i), elements: appendElement(p.elements, i)}
+}
+
+func appendElement(elements []interface{}, element interface{}) []interface{} {
+	return append(append([]interface{}{}, elements...), element)
+}
+
+// formKindFields appends the fields of an object. item is the path of the new
+// array item the object belongs to, if any.
+func (r *Resources) formKindFields(kind *proto.Kind, path formPath, object map[string]interface{}, item string, fields *[]FormField) {
+	for _, key := range kind.Keys() {
+		if path.path == "" && formSkippedFields[key] {
+			continue
+		}
+
+		field := kind.Fields[key]
+		fieldPath := path.field(key)
+		value := object[key]
+
+		formField := FormField{
+			Path:        fieldPath.path,
+			Elements:    fieldPath.elements,
+			Description: fieldDescription(field),
+			Type:        FormFieldYAML,
+			Required:    kind.IsRequired(key),
+			Value:       value,
+			Item:        item,
+		}
+
+		switch t := resolveSchema(field).(type) {
+		case *proto.Primitive:
+			formField.Type = primitiveFormFieldType(t)
+			formField.Enum = r.Enum(t)
+		case *proto.Kind:
+			if m, ok := value.(map[string]interface{}); ok && item == "" {
+				r.formKindFields(t, fieldPath, m, item, fields)
+				continue
+			}
+		case *proto.Array:
+			itemKind, ok := resolveSchema(t.SubType).(*proto.Kind)
+			if !ok || item != "" {
+				break
+			}
+
+			list, ok := value.([]interface{})
+			if value != nil && !ok {
+				break
+			}
+			for i := range list {
+				if m, ok := list[i].(map[string]interface{}); ok {
+					r.formKindFields(itemKind, fieldPath.index(i), m, "", fields)
+				}
+			}
+
+			newItem := fieldPath.index(len(list))
+			r.formKindFields(itemKind, newItem, nil, newItem.path, fields)
+			continue
+		}
+
+		*fields = append(*fields, formField)
+	}
+}
+
+// resolveSchema returns the schema a reference refers to.
+func resolveSchema(s proto.Schema) proto.Schema {
+	for {
+		reference, ok := s.(proto.Reference)
+		if !ok {
+			return s
+		}
+		s = reference.SubSchema()
+	}
+}
+
+func primitiveFormFieldType(primitive *proto.Primitive) FormFieldType {
+	switch primitive.Type {
+	case proto.Integer:
+		return FormFieldInteger
+	case proto.Number:
+		return FormFieldNumber
+	case proto.Boolean:
+		return FormFieldBoolean
+	default:
+		return FormFieldString
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestResources_FormFields(t *testing.T) {
+	resources := testResources(t)
+
+	gvk := schema.GroupVersionKind{Group: "octant.dev", Version: "v1", Kind: "Tenant"}
+	object := map[string]interface{}{
+		"apiVersion": "octant.dev/v1",
+		"kind":       "Tenant",
+		"metadata":   map[string]interface{}{"name": "acme"},
+		"spec": map[string]interface{}{
+			"tier":  "free",
+			"quota": int64(10),
+			"owners": []interface{}{
+				map[string]interface{}{"name": "alice"},
+			},
+			"labels": map[string]interface{}{"team": "a"},
+		},
+		"status": map[string]interface{}{"phase": "Ready"},
+	}
+
+	fields, ok := resources.FormFields(gvk, object)
+	require.True(t, ok)
+
+	assert.Equal(t, []FormField{
+		{
+			Path:        "spec.labels",
+			Elements:    []interface{}{"spec", "labels"},
+			Description: "Labels of the tenant's namespaces.",
+			Type:        FormFieldYAML,
+			Value:       map[string]interface{}{"team": "a"},
+		},
+		{
+			Path:     "spec.owners[0].email",
+			Elements: []interface{}{"spec", "owners", 0, "email"},
+			Type:     FormFieldString,
+		},
+		{
+			Path:     "spec.owners[0].name",
+			Elements: []interface{}{"spec", "owners", 0, "name"},
+			Type:     FormFieldString,
+			Required: true,
+			Value:    "alice",
+		},
+		{
+			Path:     "spec.owners[1].email",
+			Elements: []interface{}{"spec", "owners", 1, "email"},
+			Type:     FormFieldString,
+			Item:     "spec.owners[1]",
+		},
+		{
+			Path:     "spec.owners[1].name",
+			Elements: []interface{}{"spec", "owners", 1, "name"},
+			Type:     FormFieldString,
+			Required: true,
+			Item:     "spec.owners[1]",
+		},
+		{
+			Path:        "spec.quota",
+			Elements:    []interface{}{"spec", "quota"},
+			Description: "Quota of the tenant in GiB.",
+			Type:        FormFieldInteger,
+			Value:       int64(10),
+		},
+		{
+			Path:        "spec.suspended",
+			Elements:    []interface{}{"spec", "suspended"},
+			Description: "Suspended tenants can't create resources.",
+			Type:        FormFieldBoolean,
+		},
+		{
+			Path:        "spec.tier",
+			Elements:    []interface{}{"spec", "tier"},
+			Description: "Tier of the tenant.",
+			Type:        FormFieldString,
+			Enum:        []string{"free", "paid"},
+			Required:    true,
+			Value:       "free",
+		},
+	}, fields)
+
+	_, ok = resources.FormFields(schema.GroupVersionKind{Group: "octant.dev", Version: "v2", Kind: "Tenant"}, object)
+	assert.False(t, ok)
+}
+
+func TestResources_FormFields_unset(t *testing.T) {
+	resources := testResources(t)
+
+	gvk := schema.GroupVersionKind{Group: "octant.dev", Version: "v1", Kind: "Tenant"}
+	object := map[string]interface{}{
+		"apiVersion": "octant.dev/v1",
+		"kind":       "Tenant",
+	}
+
+	fields, ok := resources.FormFields(gvk, object)
+	require.True(t, ok)
+
+	assert.Equal(t, []FormField{
+		{
+			Path:        "spec",
+			Elements:    []interface{}{"spec"},
+			Description: "Spec is the desired state of the tenant.",
+			Type:        FormFieldYAML,
+		},
+	}, fields)
+}
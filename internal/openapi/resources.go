@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package openapi reads the schemas of a cluster's resources from its OpenAPI
+// document. The API server publishes the structural schemas of custom
+// resources in the same document. Custom resources it does not publish use
+// the openAPIV3Schema of their definitions.
+package openapi
+
+import (
+	"fmt"
+	"sync"
+
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+)
+
+// groupVersionKindExtension is the extension which lists the kinds a model is
+// the schema of.
+const groupVersionKindExtension = "x-kubernetes-group-version-kind"
+
+// Resources are the schemas of a cluster's resources.
+type Resources struct {
+	models    proto.Models
+	resources map[schema.GroupVersionKind]string
+	// enums are the allowed values of fields, by the path of their schema.
+	// proto schemas do not include them.
+	enums map[string][]string
+}
+
+// NewResources creates an instance of Resources from an OpenAPI v2 document.
+func NewResources(doc *openapi_v2.Document) (*Resources, error) {
+	models, err := proto.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, fmt.Errorf("parse openapi document: %w", err)
+	}
+
+	resources := map[schema.GroupVersionKind]string{}
+	for _, modelName := range models.ListModels() {
+		model := models.LookupModel(modelName)
+		if model == nil {
+			continue
+		}
+
+		for _, gvk := range modelGroupVersionKinds(model) {
+			resources[gvk] = modelName
+		}
+	}
+
+	enums := map[string][]string{}
+	for _, namedSchema := range doc.GetDefinitions().GetAdditionalProperties() {
+		collectEnums(namedSchema.GetValue(), namedSchema.GetName(), enums)
+	}
+
+	return &Resources{
+		models:    models,
+		resources: resources,
+		enums:     enums,
+	}, nil
+}
+
+// collectEnums records the enums of a schema and its sub-schemas. Paths match
+// the paths of proto schemas, where items of arrays and values of maps have
+// the path of their parent.
+func collectEnums(s *openapi_v2.Schema, path string, enums map[string][]string) {
+	if s == nil {
+		return
+	}
+
+	for _, value := range s.GetEnum() {
+		var item interface{}
+		if err := yaml.Unmarshal([]byte(value.GetYaml()), &item); err != nil {
+			continue
+		}
+		if str, ok := item.(string); ok {
+			enums[path] = append(enums[path], str)
+		}
+	}
+
+	for _, property := range s.GetProperties().GetAdditionalProperties() {
+		collectEnums(property.GetValue(), path+"."+property.GetName(), enums)
+	}
+	for _, item := range s.GetItems().GetSchema() {
+		collectEnums(item, path, enums)
+	}
+	collectEnums(s.GetAdditionalProperties().GetSchema(), path, enums)
+}
+
+// LookupResource returns the schema of a kind. It returns nil if the cluster
+// does not publish a schema for the kind.
+func (r *Resources) LookupResource(gvk schema.GroupVersionKind) proto.Schema {
+	modelName, ok := r.resources[gvk]
+	if !ok {
+		return nil
+	}
+	return r.models.LookupModel(modelName)
+}
+
+// Enum returns the allowed values of a schema. It returns nil if any value is allowed.
+func (r *Resources) Enum(s proto.Schema) []string {
+	return r.enums[s.GetPath().String()]
+}
+
+func modelGroupVersionKinds(model proto.Schema) []schema.GroupVersionKind {
+	extension, ok := model.GetExtensions()[groupVersionKindExtension]
+	if !ok {
+		return nil
+	}
+
+	list, ok := extension.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var gvks []schema.GroupVersionKind
+	for _, item := range list {
+		m, ok := item.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+
+		group, _ := m["group"].(string)
+		version, _ := m["version"].(string)
+		kind, _ := m["kind"].(string)
+		if version == "" || kind == "" {
+			continue
+		}
+
+		gvks = append(gvks, schema.GroupVersionKind{Group: group, Version: version, Kind: kind})
+	}
+
+	return gvks
+}
+
+// SchemaGetter gets a cluster's OpenAPI document. It is implemented by discovery clients.
+type SchemaGetter interface {
+	OpenAPISchema() (*openapi_v2.Document, error)
+}
+
+// Cache loads a cluster's schemas the first time they are needed. The document
+// is large, so it is kept until it is reset, e.g. when custom resource
+// definitions change.
+type Cache struct {
+	getter    SchemaGetter
+	crdGetter CRDGetter
+	resources *Resources
+	mu        sync.Mutex
+}
+
+// NewCache creates an instance of Cache. Custom resources the OpenAPI document
+// does not publish use the openAPIV3Schema of their definitions from crdGetter.
+// crdGetter is optional.
+func NewCache(getter SchemaGetter, crdGetter CRDGetter) *Cache {
+	return &Cache{
+		getter:    getter,
+		crdGetter: crdGetter,
+	}
+}
+
+// Resources returns the cluster's schemas.
+func (c *Cache) Resources() (*Resources, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.resources != nil {
+		return c.resources, nil
+	}
+
+	doc, err := c.getter.OpenAPISchema()
+	if err != nil {
+		return nil, fmt.Errorf("get openapi document: %w", err)
+	}
+
+	// Schemas of custom resource definitions are a fallback, so the document is
+	// used on its own if definitions cannot be listed.
+	if c.crdGetter != nil {
+		if crds, err := c.crdGetter.CustomResourceDefinitions(); err == nil {
+			doc, err = withCRDSchemas(doc, crds)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resources, err := NewResources(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	c.resources = resources
+	return resources, nil
+}
+
+// Reset discards the schemas, so they are loaded again.
+func (c *Cache) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.resources = nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestResources_LookupResource(t *testing.T) {
+	resources := testResources(t)
+
+	deployment := resources.LookupResource(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	require.NotNil(t, deployment)
+	assert.Equal(t, "Deployment enables declarative updates for Pods and ReplicaSets.", deployment.GetDescription())
+
+	widget := resources.LookupResource(schema.GroupVersionKind{Group: "octant.dev", Version: "v1", Kind: "Widget"})
+	require.NotNil(t, widget)
+
+	assert.Nil(t, resources.LookupResource(schema.GroupVersionKind{Group: "apps", Version: "v1beta1", Kind: "Deployment"}))
+}
+
+func TestCache(t *testing.T) {
+	getter := &fakeSchemaGetter{doc: testDocument(t)}
+	cache := NewCache(getter, nil)
+
+	first, err := cache.Resources()
+	require.NoError(t, err)
+	second, err := cache.Resources()
+	require.NoError(t, err)
+	assert.True(t, first == second)
+	assert.Equal(t, 1, getter.calls)
+
+	cache.Reset()
+	_, err = cache.Resources()
+	require.NoError(t, err)
+	assert.Equal(t, 2, getter.calls)
+
+	getter.err = fmt.Errorf("forbidden")
+	cache.Reset()
+	_, err = cache.Resources()
+	require.Error(t, err)
+}
+
+type fakeSchemaGetter struct {
+	doc   *openapi_v2.Document
+	err   error
+	calls int
+}
+
+func (f *fakeSchemaGetter) OpenAPISchema() (*openapi_v2.Document, error) {
+	f.calls++
+	return f.doc, f.err
+}
+
+func testDocument(t *testing.T) *openapi_v2.Document {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "swagger.json"))
+	require.NoError(t, err)
+
+	doc, err := openapi_v2.ParseDocument(data)
+	require.NoError(t, err)
+	return doc
+}
+
+func testResources(t *testing.T) *Resources {
+	resources, err := NewResources(testDocument(t))
+	require.NoError(t, err)
+	return resources
+}
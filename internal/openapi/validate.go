@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto/validation"
+)
+
+// FieldError is a field which does not match its schema.
+type FieldError struct {
+	// Line is the line of the field in the YAML, or of its closest parent if
+	// the field is missing.
+	Line int
+	// Path is the path of the field, e.g. spec.template.spec.containers[0].image.
+	Path    string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Path, e.Message)
+}
+
+// ValidationErrors are the fields of YAML which do not match their schemas.
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	var messages []string
+	for _, fieldError := range e {
+		messages = append(messages, fieldError.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ValidateYAML validates each document in YAML against the schema of its kind.
+// Documents whose kinds have no schema, e.g. custom resources which are
+// defined in the same YAML, are not validated. It returns ValidationErrors if
+// any field is invalid.
+func (r *Resources) ValidateYAML(input string) error {
+	decoder := yaml.NewDecoder(strings.NewReader(input))
+
+	var fieldErrors ValidationErrors
+	for {
+		node := &yaml.Node{}
+		if err := decoder.Decode(node); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("unable to parse yaml: %w", err)
+		}
+
+		var object map[string]interface{}
+		if err := node.Decode(&object); err != nil {
+			return fmt.Errorf("unable to parse yaml at line %d: %w", node.Line, err)
+		}
+		if len(object) == 0 {
+			// skip empty documents
+			continue
+		}
+
+		for _, fieldError := range r.validate(object) {
+			fieldError.Line = fieldLine(node, fieldError.Path)
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return fieldErrors
+	}
+	return nil
+}
+
+func (r *Resources) validate(object map[string]interface{}) []FieldError {
+	apiVersion, _ := object["apiVersion"].(string)
+	kind, _ := object["kind"].(string)
+
+	groupVersion, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return []FieldError{{Path: "apiVersion", Message: err.Error()}}
+	}
+
+	resource := r.LookupResource(groupVersion.WithKind(kind))
+	if resource == nil {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	for _, err := range validation.ValidateModel(object, resource, "") {
+		fieldErrors = append(fieldErrors, toFieldError(err))
+	}
+
+	return fieldErrors
+}
+
+// toFieldError converts a validation error, whose messages refer to the paths
+// of models, to an error which refers to the path of the field.
+func toFieldError(err error) FieldError {
+	var path string
+	if validationErr, ok := err.(validation.ValidationError); ok {
+		path = validationErr.Path
+		err = validationErr.Err
+	}
+
+	message := err.Error()
+	switch e := err.(type) {
+	case validation.UnknownFieldError:
+		path += "." + e.Field
+		message = "unknown field"
+	case validation.MissingRequiredFieldError:
+		path += "." + e.Field
+		message = "missing required field"
+	case validation.InvalidTypeError:
+		message = fmt.Sprintf("expected %s, got %s", e.Expected, e.Actual)
+	case validation.InvalidObjectTypeError:
+		path = e.Path
+		message = fmt.Sprintf("unexpected %s value", e.Type)
+	}
+
+	return FieldError{
+		Path:    strings.TrimPrefix(path, "."),
+		Message: message,
+	}
+}
+
+// fieldLine returns the line of a field in a YAML document. If the field does
+// not exist, the line of its closest parent is returned.
+func fieldLine(node *yaml.Node, path string) int {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	line := node.Line
+	remaining := path
+	for remaining != "" {
+		remaining = strings.TrimPrefix(remaining, ".")
+
+		switch node.Kind {
+		case yaml.MappingNode:
+			var key, value *yaml.Node
+			// keys can contain dots, e.g. annotations, so the longest key which
+			// prefixes the path is the field.
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				candidate := node.Content[i].Value
+				if !isPathPrefix(remaining, candidate) {
+					continue
+				}
+				if key == nil || len(candidate) > len(key.Value) {
+					key, value = node.Content[i], node.Content[i+1]
+				}
+			}
+			if key == nil {
+				return line
+			}
+			line = key.Line
+			node = value
+			remaining = remaining[len(key.Value):]
+		case yaml.SequenceNode:
+			end := strings.Index(remaining, "]")
+			if !strings.HasPrefix(remaining, "[") || end < 0 {
+				return line
+			}
+			index, err := strconv.Atoi(remaining[1:end])
+			if err != nil || index < 0 || index >= len(node.Content) {
+				return line
+			}
+			node = node.Content[index]
+			line = node.Line
+			remaining = remaining[end+1:]
+		default:
+			return line
+		}
+	}
+
+	return line
+}
+
+// isPathPrefix returns true if the path starts with the field.
+func isPathPrefix(path, field string) bool {
+	if !strings.HasPrefix(path, field) {
+		return false
+	}
+	rest := path[len(field):]
+	return rest == "" || rest[0] == '.' || rest[0] == '['
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestResources_ValidateYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected ValidationErrors
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			input: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  annotations:
+    example.com/owner: team
+spec:
+  replicas: 2
+  selector:
+    app: web
+  containers:
+  - name: web
+    image: nginx
+`,
+		},
+		{
+			name: "invalid fields",
+			input: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: two
+  selector:
+    app: web
+  containers:
+  - name: web
+    image: nginx
+  - imagePullPolicy: Always
+`,
+			expected: ValidationErrors{
+				{Line: 12, Path: "spec.containers[1].imagePullPolicy", Message: "unknown field"},
+				{Line: 12, Path: "spec.containers[1].name", Message: "missing required field"},
+				{Line: 6, Path: "spec.replicas", Message: "expected integer, got string"},
+			},
+		},
+		{
+			name: "multiple documents",
+			input: `apiVersion: octant.dev/v1
+kind: Widget
+spec:
+  anything: goes
+---
+---
+apiVersion: example.com/v1
+kind: Unknown
+spec: {}
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  selector:
+    app: web
+status: {}
+`,
+			expected: ValidationErrors{
+				{Line: 16, Path: "status", Message: "unknown field"},
+			},
+		},
+		{
+			name:    "invalid yaml",
+			input:   "apiVersion: [",
+			wantErr: true,
+		},
+	}
+
+	resources := testResources(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := resources.ValidateYAML(test.input)
+			if test.wantErr {
+				require.Error(t, err)
+				_, ok := err.(ValidationErrors)
+				assert.False(t, ok)
+				return
+			}
+
+			if test.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Equal(t, test.expected, err)
+		})
+	}
+}
+
+func TestValidationErrors_Error(t *testing.T) {
+	err := ValidationErrors{
+		{Line: 7, Path: "spec.replicas", Message: "expected integer, got string"},
+		{Line: 1, Message: "invalid"},
+	}
+
+	assert.Equal(t, "line 7: spec.replicas: expected integer, got string; line 1: invalid", err.Error())
+}
+
+func TestFieldLine(t *testing.T) {
+	input := `metadata:
+  annotations:
+    example.com: short
+    example.com/owner: team
+spec:
+  containers:
+  - name: web
+    image: nginx
+`
+	node := &yaml.Node{}
+	require.NoError(t, yaml.Unmarshal([]byte(input), node))
+
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{path: "metadata.annotations.example.com/owner", expected: 4},
+		{path: "metadata.annotations.example.com", expected: 3},
+		{path: "spec.containers[0].image", expected: 8},
+		{path: "spec.containers[1].name", expected: 6},
+		{path: "spec.replicas", expected: 5},
+		{path: "", expected: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, fieldLine(node, test.path))
+		})
+	}
+}
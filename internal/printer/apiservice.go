@@ -25,6 +25,8 @@ func APIServiceListHandler(ctx context.Context, list *apiregistrationv1.APIServi
 
 	cols := component.NewTableCols("Name", "Service", "Age")
 	ot := NewObjectTable("API Services", "We couldn't find any api services!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
+	ot.SetCertificateExpiryWindow(options.certificateExpiryWindow())
 
 	for _, apiService := range list.Items {
 		row := component.TableRow{}
@@ -59,7 +61,7 @@ func APIServiceListHandler(ctx context.Context, list *apiregistrationv1.APIServi
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // APIServiceHandler is a printFunc that prints a api service
@@ -25,6 +25,7 @@ func ClusterRoleListHandler(ctx context.Context, list *rbacv1.ClusterRoleList, o
 
 	cols := component.NewTableCols("Name", "Age")
 	ot := NewObjectTable("Cluster Roles", "We couldn't find any cluster roles!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, clusterRole := range list.Items {
 		row := component.TableRow{}
@@ -42,7 +43,7 @@ func ClusterRoleListHandler(ctx context.Context, list *rbacv1.ClusterRoleList, o
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ClusterRoleHandler is a printFunc that prints a cluster role
@@ -23,6 +23,7 @@ func ClusterRoleBindingListHandler(ctx context.Context, clusterRoleBindingList *
 
 	columns := component.NewTableCols("Name", "Labels", "Age", "Role kind", "Role name")
 	ot := NewObjectTable("Cluster Role Bindings", "We couldn't find any cluster role bindings!", columns, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, roleBinding := range clusterRoleBindingList.Items {
 		row := component.TableRow{}
@@ -50,7 +51,7 @@ func ClusterRoleBindingListHandler(ctx context.Context, clusterRoleBindingList *
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func roleLinkFromClusterRoleBinding(clusterRoleBinding *rbacv1.ClusterRoleBinding, options Options) (*component.Link, error) {
@@ -7,12 +7,16 @@ package printer
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +31,7 @@ func ConfigMapListHandler(ctx context.Context, list *corev1.ConfigMapList, opts
 	// Data column
 	cols := component.NewTableCols("Name", "Labels", "Data", "Age")
 	ot := NewObjectTable("ConfigMaps", "We couldn't find any config maps!", cols, opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 
 	for _, c := range list.Items {
 		row := component.TableRow{}
@@ -51,7 +56,7 @@ func ConfigMapListHandler(ctx context.Context, list *corev1.ConfigMapList, opts
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ConfigMapHandler is a printFunc that prints a ConfigMap
@@ -63,7 +68,7 @@ func ConfigMapHandler(ctx context.Context, cm *corev1.ConfigMap, options Options
 		return nil, err
 	}
 
-	if err := ch.Config(options); err != nil {
+	if err := ch.Config(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print configmap configuration")
 	}
 
@@ -71,6 +76,27 @@ func ConfigMapHandler(ctx context.Context, cm *corev1.ConfigMap, options Options
 		return nil, errors.Wrap(err, "print configmap data")
 	}
 
+	consumers, err := octant.ConfigMapConsumers(ctx, options.DashConfig.ObjectStore(), cm.Namespace, cm.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "find configmap consumers")
+	}
+
+	if err := ch.Consumers(consumers, options); err != nil {
+		return nil, errors.Wrap(err, "print configmap consumers")
+	}
+
+	key := configMapKey(cm)
+
+	o.AddButton("Add Key",
+		action.CreatePayload(octant.ActionConfigMapDataUpdate, key.ToActionPayload()),
+		component.WithButtonForm(configMapDataForm(cm, "", "", octant.ConfigMapEncodingText)))
+
+	if restart, ok := configMapRestartConfirmation(cm, consumers); ok {
+		o.AddButton("Restart Consumers",
+			action.CreatePayload(octant.ActionConfigMapRestartConsumers, key.ToActionPayload()),
+			restart)
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -127,7 +153,7 @@ func describeConfigMapData(cm *corev1.ConfigMap) (*component.Table, error) {
 	return table, nil
 }
 
-// describeDataRows prints key value pairs from data
+// describeDataRows prints key value pairs from data and binary data
 func describeConfigMapDataRows(cm *corev1.ConfigMap) ([]component.TableRow, error) {
 	if cm == nil {
 		return nil, errors.New("config map is nil")
@@ -154,21 +180,156 @@ func describeConfigMapDataRows(cm *corev1.ConfigMap) ([]component.TableRow, erro
 		} else {
 			row["Value"] = component.NewText(data[k])
 		}
+
+		addConfigMapDataActions(row, cm, k, data[k], octant.ConfigMapEncodingText)
+	}
+
+	var binaryKeys []string
+	for k := range cm.BinaryData {
+		binaryKeys = append(binaryKeys, k)
+	}
+	sort.Strings(binaryKeys)
+
+	for _, k := range binaryKeys {
+		row := component.TableRow{}
+		rows = append(rows, row)
+
+		value := cm.BinaryData[k]
+		row["Key"] = component.NewText(k)
+		row["Value"] = component.NewText(fmt.Sprintf("<binary data, %d bytes>", len(value)))
+
+		addConfigMapDataActions(row, cm, k, base64.StdEncoding.EncodeToString(value), octant.ConfigMapEncodingBase64)
 	}
 
 	return rows, nil
 }
 
+// addConfigMapDataActions adds actions which edit and delete a config map key.
+func addConfigMapDataActions(row component.TableRow, cm *corev1.ConfigMap, dataKey, value, encoding string) {
+	key := configMapKey(cm)
+
+	form := configMapDataForm(cm, dataKey, value, encoding)
+	row.AddAction(component.GridAction{
+		Name:       "Edit",
+		ActionPath: octant.ActionConfigMapDataUpdate,
+		Payload:    key.ToActionPayload(),
+		Form:       &form,
+	})
+
+	deletePayload := key.ToActionPayload()
+	deletePayload["configMapKey"] = dataKey
+	row.AddAction(component.GridAction{
+		Name:       "Delete",
+		ActionPath: octant.ActionConfigMapDataDelete,
+		Payload:    deletePayload,
+		Confirmation: &component.Confirmation{
+			Title: "Delete Key",
+			Body:  fmt.Sprintf("Are you sure you want to delete **%s** from config map **%s**?", dataKey, cm.Name),
+		},
+		Type: component.GridActionDanger,
+	})
+}
+
+// configMapDataForm creates a form which sets a config map value. The key can
+// be entered if dataKey is blank.
+func configMapDataForm(cm *corev1.ConfigMap, dataKey, value, encoding string) component.Form {
+	var fields []component.FormField
+	if dataKey == "" {
+		fields = append(fields, component.NewFormFieldText("Key", "configMapKey", ""))
+	} else {
+		fields = append(fields, component.NewFormFieldHidden("configMapKey", dataKey))
+	}
+
+	fields = append(fields,
+		component.NewFormFieldTextarea("Value", "value", value),
+		component.NewFormFieldSelect("Encoding", "encoding", []component.InputChoice{
+			{Label: "Text (data)", Value: octant.ConfigMapEncodingText, Checked: encoding == octant.ConfigMapEncodingText},
+			{Label: "Base64 (binaryData)", Value: octant.ConfigMapEncodingBase64, Checked: encoding == octant.ConfigMapEncodingBase64},
+		}, false),
+	)
+
+	fields = append(fields, keyFormFields(configMapKey(cm))...)
+	fields = append(fields, component.NewFormFieldHidden("action", octant.ActionConfigMapDataUpdate))
+
+	return component.Form{Fields: fields}
+}
+
+// describeConfigMapConsumers lists the pods and workloads which use a config map.
+func describeConfigMapConsumers(consumers []octant.ConfigMapConsumer, options Options) (*component.Table, error) {
+	cols := component.NewTableCols("Name", "Kind", "Usage", "Restart Needed")
+	table := component.NewTable("Consumers", "No pods or workloads use this config map", cols)
+
+	for _, consumer := range consumers {
+		key := consumer.Key
+
+		nameLink, err := options.Link.ForGVK(key.Namespace, key.APIVersion, key.Kind, key.Name, key.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var usages []string
+		for _, usage := range consumer.Usages {
+			usages = append(usages, usage.Description)
+		}
+
+		restartNeeded := "No"
+		if consumer.NeedsRestart() {
+			restartNeeded = "Yes"
+		}
+
+		table.Add(component.TableRow{
+			"Name":           nameLink,
+			"Kind":           component.NewText(key.Kind),
+			"Usage":          component.NewText(strings.Join(usages, ", ")),
+			"Restart Needed": component.NewText(restartNeeded),
+		})
+	}
+
+	return table, nil
+}
+
+// configMapRestartConfirmation returns a confirmation which lists the
+// workloads which will be restarted. It returns false if no workloads can be
+// restarted.
+func configMapRestartConfirmation(cm *corev1.ConfigMap, consumers []octant.ConfigMapConsumer) (component.ButtonOption, bool) {
+	var names []string
+	for _, consumer := range consumers {
+		if consumer.Restartable() {
+			names = append(names, fmt.Sprintf("*%s* **%s**", consumer.Key.Kind, consumer.Key.Name))
+		}
+	}
+	if len(names) == 0 {
+		return nil, false
+	}
+
+	return component.WithButtonConfirmation("Restart Consumers",
+		fmt.Sprintf("Are you sure you want to restart %s? Their pods will be replaced so they read config map **%s** again.",
+			strings.Join(names, ", "), cm.Name)), true
+}
+
+// configMapKey returns the store key of a config map. It does not depend on
+// the config map's type meta, which is not set on every config map.
+func configMapKey(cm *corev1.ConfigMap) store.Key {
+	return store.Key{
+		Namespace:  cm.Namespace,
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       cm.Name,
+	}
+}
+
 type configMapObject interface {
-	Config(options Options) error
+	Config(ctx context.Context, options Options) error
 	Data(option Options) error
+	Consumers(consumers []octant.ConfigMapConsumer, options Options) error
 }
 
 type configMapHandler struct {
-	configMap  *corev1.ConfigMap
-	configFunc func(*corev1.ConfigMap, Options) (*component.Summary, error)
-	dataFunc   func(*corev1.ConfigMap, Options) (*component.Table, error)
-	object     *Object
+	configMap     *corev1.ConfigMap
+	configFunc    func(context.Context, *corev1.ConfigMap, Options) (*component.Summary, error)
+	dataFunc      func(*corev1.ConfigMap, Options) (*component.Table, error)
+	consumersFunc func([]octant.ConfigMapConsumer, Options) (*component.Table, error)
+	object        *Object
 }
 
 var _ configMapObject = (*configMapHandler)(nil)
@@ -183,17 +344,18 @@ func newConfigMapHandler(configMap *corev1.ConfigMap, object *Object) (*configMa
 	}
 
 	ch := &configMapHandler{
-		configMap:  configMap,
-		configFunc: defaultConfigMapConfig,
-		dataFunc:   defaultConfigMapData,
-		object:     object,
+		configMap:     configMap,
+		configFunc:    defaultConfigMapConfig,
+		dataFunc:      defaultConfigMapData,
+		consumersFunc: describeConfigMapConsumers,
+		object:        object,
 	}
 
 	return ch, nil
 }
 
-func (c *configMapHandler) Config(options Options) error {
-	out, err := c.configFunc(c.configMap, options)
+func (c *configMapHandler) Config(ctx context.Context, options Options) error {
+	out, err := c.configFunc(ctx, c.configMap, options)
 	if err != nil {
 		return err
 	}
@@ -201,8 +363,24 @@ func (c *configMapHandler) Config(options Options) error {
 	return nil
 }
 
-func defaultConfigMapConfig(configMap *corev1.ConfigMap, options Options) (*component.Summary, error) {
-	return NewConfigMapConfiguration(configMap).Create(options)
+// defaultConfigMapConfig creates the configuration summary of a config map. It
+// has an alert if the config map was changed in the session.
+func defaultConfigMapConfig(ctx context.Context, configMap *corev1.ConfigMap, options Options) (*component.Summary, error) {
+	summary, err := NewConfigMapConfiguration(configMap).Create(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ConfigMapChanges != nil {
+		session := octant.NewSession(ctx, options.DashConfig.ContextName())
+		if options.ConfigMapChanges.IsChanged(session, configMapKey(configMap)) {
+			summary.SetAlert(component.NewAlert(component.AlertTypeInfo,
+				"This config map was changed. Consumers which read it through environment variables or subPath mounts "+
+					"only see the change after they are restarted."))
+		}
+	}
+
+	return summary, nil
 }
 
 func (c *configMapHandler) Data(options Options) error {
@@ -223,3 +401,19 @@ func (c *configMapHandler) Data(options Options) error {
 func defaultConfigMapData(configMap *corev1.ConfigMap, options Options) (*component.Table, error) {
 	return describeConfigMapData(configMap)
 }
+
+// Consumers shows the pods and workloads which use the config map.
+func (c *configMapHandler) Consumers(consumers []octant.ConfigMapConsumer, options Options) error {
+	if c.configMap == nil {
+		return errors.New("can't display consumers for nil configmap")
+	}
+
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.consumersFunc(consumers, options)
+		},
+	})
+
+	return nil
+}
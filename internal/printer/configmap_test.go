@@ -14,7 +14,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -133,16 +135,122 @@ func Test_describeConfigMapData(t *testing.T) {
 		"foo": "bar",
 		"bar": "foo",
 	}
+	configMap.BinaryData = map[string][]byte{
+		"blob": {0xff, 0x00},
+	}
 
 	got, err := describeConfigMapData(configMap)
 	require.NoError(t, err)
 
 	cols := component.NewTableCols("Key", "Value")
 	expected := component.NewTable("Data", "No data has been configured for this config map!", cols)
-	expected.Add([]component.TableRow{
+
+	rows := []component.TableRow{
 		{"Key": component.NewText("bar"), "Value": component.NewText("foo")},
+		{"Key": component.NewText("blob"), "Value": component.NewText("<binary data, 2 bytes>")},
 		{"Key": component.NewText("foo"), "Value": component.NewText("bar")},
-	}...)
+	}
+	addConfigMapDataActions(rows[0], configMap, "bar", "foo", octant.ConfigMapEncodingText)
+	addConfigMapDataActions(rows[1], configMap, "blob", "/wA=", octant.ConfigMapEncodingBase64)
+	addConfigMapDataActions(rows[2], configMap, "foo", "bar", octant.ConfigMapEncodingText)
+	expected.Add(rows...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_ConfigMapConfiguration_changed(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	configMap := testutil.CreateConfigMap("configmap")
+	configMap.CreationTimestamp = *testutil.CreateTimestamp()
+
+	tpo.dashConfig.EXPECT().ContextName().Return("cluster").AnyTimes()
+
+	printOptions.ConfigMapChanges = octant.NewConfigMapChanges()
+	printOptions.ConfigMapChanges.Change(octant.Session{ClientID: "client", ContextName: "cluster"}, configMapKey(configMap))
+
+	summary, err := defaultConfigMapConfig(context.Background(), configMap, printOptions)
+	require.NoError(t, err)
+	require.Nil(t, summary.Config.Alert)
+
+	ctx := octant.WithClientID(context.Background(), "client")
+	summary, err = defaultConfigMapConfig(ctx, configMap, printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Configuration", component.SummarySection{
+		Header:  "Age",
+		Content: component.NewTimestamp(testutil.Time()),
+	})
+	expected.SetAlert(component.NewAlert(component.AlertTypeInfo,
+		"This config map was changed. Consumers which read it through environment variables or subPath mounts "+
+			"only see the change after they are restarted."))
+
+	component.AssertEqual(t, expected, summary)
+}
+
+func Test_describeConfigMapConsumers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	consumers := []octant.ConfigMapConsumer{
+		{
+			Key: store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+			Usages: []octant.ConfigMapUsage{
+				{Description: "envFrom in container app", NeedsRestart: true},
+				{Description: "volume config mounted at /etc/config in container app"},
+			},
+		},
+		{
+			Key: store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "debug"},
+			Usages: []octant.ConfigMapUsage{
+				{Description: "volume config"},
+			},
+		},
+	}
+
+	tpo.PathForGVK("default", "apps/v1", "Deployment", "web", "web", "/web")
+	tpo.PathForGVK("default", "v1", "Pod", "debug", "debug", "/debug")
+
+	got, err := describeConfigMapConsumers(consumers, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Kind", "Usage", "Restart Needed")
+	expected := component.NewTable("Consumers", "No pods or workloads use this config map", cols)
+	expected.Add(
+		component.TableRow{
+			"Name":           component.NewLink("", "web", "/web"),
+			"Kind":           component.NewText("Deployment"),
+			"Usage":          component.NewText("envFrom in container app, volume config mounted at /etc/config in container app"),
+			"Restart Needed": component.NewText("Yes"),
+		},
+		component.TableRow{
+			"Name":           component.NewLink("", "debug", "/debug"),
+			"Kind":           component.NewText("Pod"),
+			"Usage":          component.NewText("volume config"),
+			"Restart Needed": component.NewText("No"),
+		},
+	)
 
 	component.AssertEqual(t, expected, got)
+
+	configMap := testutil.CreateConfigMap("configmap")
+
+	_, ok := configMapRestartConfirmation(configMap, consumers[1:])
+	require.False(t, ok, "pods which are not managed by a workload can't be restarted")
+
+	option, ok := configMapRestartConfirmation(configMap, consumers)
+	require.True(t, ok)
+
+	button := component.NewButton("Restart Consumers", nil, option)
+	require.Equal(t, &component.Confirmation{
+		Title: "Restart Consumers",
+		Body:  "Are you sure you want to restart *Deployment* **web**? Their pods will be replaced so they read config map **configmap** again.",
+	}, button.Confirmation)
 }
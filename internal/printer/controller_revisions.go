@@ -0,0 +1,289 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	controllerRevisionRolloutKinds = "StatefulSets and DaemonSets"
+
+	rolloutStatusUpdated         = "Updated"
+	rolloutStatusPending         = "Pending update"
+	rolloutStatusPartitioned     = "Held by partition"
+	rolloutStatusWaitingOnDelete = "Waiting for pod deletion"
+)
+
+var (
+	controllerRevisionColumns = component.NewTableCols("Revision", "Change Cause", "Images", "Age", "Changes")
+	statefulSetRolloutColumns = component.NewTableCols("Ordinal", "Pod", "Node", "Revision", "Status")
+	daemonSetRolloutColumns   = component.NewTableCols("Node", "Pod", "Revision", "Status")
+)
+
+// ControllerRevisionHistory prints the revisions of a StatefulSet or DaemonSet,
+// newest first. Revisions other than the current revision can be rolled back to.
+func ControllerRevisionHistory(ctx context.Context, object runtime.Object, options Options) (*component.Table, error) {
+	kind, owner, ok := octant.ControllerRevisionOwner(object)
+	if !ok {
+		return nil, fmt.Errorf("revision history is only available for %s", controllerRevisionRolloutKinds)
+	}
+
+	revisions, err := octant.ControllerRevisions(ctx, options.DashConfig.ObjectStore(), kind, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	table := component.NewTable("Revisions", "There are no revisions!", controllerRevisionColumns)
+
+	for i := len(revisions) - 1; i >= 0; i-- {
+		revision := revisions[i]
+		current := i == len(revisions)-1
+
+		revisionText := fmt.Sprintf("%d", revision.Revision)
+		if current {
+			revisionText += " (current)"
+		}
+
+		images := component.NewContainers()
+		for _, container := range revision.Template.Spec.Containers {
+			images.Add(container.Name, container.Image)
+		}
+
+		var changes component.Component = component.NewText("Initial revision")
+		if i > 0 {
+			previous := revisions[i-1]
+			diff, err := kubernetes.DiffYAML(
+				fmt.Sprintf("revision %d", previous.Revision), previous.Template,
+				fmt.Sprintf("revision %d", revision.Revision), revision.Template)
+			if err != nil {
+				return nil, err
+			}
+
+			changes = component.NewText("No changes")
+			if diff != "" {
+				changes = component.NewCodeBlock(diff)
+			}
+		}
+
+		changeCause := revision.ChangeCause()
+		if changeCause == "" {
+			changeCause = "<none>"
+		}
+
+		row := component.TableRow{
+			"Revision":     component.NewText(revisionText),
+			"Change Cause": component.NewText(changeCause),
+			"Images":       images,
+			"Age":          component.NewTimestamp(revision.ControllerRevision.CreationTimestamp.Time),
+			"Changes":      changes,
+		}
+
+		if !current {
+			payload := key.ToActionPayload()
+			payload["revision"] = revision.Revision
+
+			row.AddAction(component.GridAction{
+				Name:       "Rollback",
+				ActionPath: octant.ActionControllerRevisionRollback,
+				Payload:    payload,
+				Confirmation: &component.Confirmation{
+					Title: fmt.Sprintf("Rollback %s", kind),
+					Body: fmt.Sprintf("Are you sure you want to roll back *%s* **%s** to revision %d?",
+						kind, owner.GetName(), revision.Revision),
+				},
+				Type: component.GridActionDanger,
+			})
+		}
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+// RolloutProgress prints the pods of a StatefulSet by ordinal, or the pods of a
+// DaemonSet by node, with the revision each pod runs and whether it has been
+// updated to the revision being rolled out.
+func RolloutProgress(ctx context.Context, object runtime.Object, options Options) (*component.Table, error) {
+	kind, owner, ok := octant.ControllerRevisionOwner(object)
+	if !ok {
+		return nil, fmt.Errorf("rollout progress is only available for %s", controllerRevisionRolloutKinds)
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	revisions, err := octant.ControllerRevisions(ctx, objectStore, kind, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &rolloutProgress{revisions: revisions}
+	if len(revisions) > 0 {
+		progress.updateRevision = revisions[len(revisions)-1].ControllerRevision.Name
+	}
+
+	var pods []*corev1.Pod
+	var columns []component.TableCol
+
+	switch o := object.(type) {
+	case *appsv1.StatefulSet:
+		columns = statefulSetRolloutColumns
+		if o.Status.UpdateRevision != "" {
+			progress.updateRevision = o.Status.UpdateRevision
+		}
+		progress.onDelete = o.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType
+		if rollingUpdate := o.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+			progress.partition = int(*rollingUpdate.Partition)
+		}
+
+		pods, err = listPods(ctx, o.Namespace, o.Spec.Selector, o.UID, objectStore)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(pods, func(i, j int) bool {
+			return podOrdinal(pods[i]) < podOrdinal(pods[j])
+		})
+	case *appsv1.DaemonSet:
+		columns = daemonSetRolloutColumns
+		progress.onDelete = o.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType
+
+		pods, err = listPods(ctx, o.Namespace, o.Spec.Selector, o.UID, objectStore)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(pods, func(i, j int) bool {
+			return pods[i].Spec.NodeName < pods[j].Spec.NodeName
+		})
+	}
+
+	var rows []component.TableRow
+	updated := 0
+	for _, pod := range pods {
+		podLink, err := options.Link.ForObject(pod, pod.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var node component.Component = component.NewText("<not scheduled>")
+		if nodeName := pod.Spec.NodeName; nodeName != "" {
+			node, err = options.Link.ForGVK("", "v1", "Node", nodeName, nodeName)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		status := progress.status(pod)
+		if status == rolloutStatusUpdated {
+			updated++
+		}
+
+		row := component.TableRow{
+			"Pod":      podLink,
+			"Node":     node,
+			"Revision": component.NewText(progress.revision(pod)),
+			"Status":   component.NewText(status),
+		}
+		if kind == gvk.StatefulSet.Kind {
+			row["Ordinal"] = component.NewText(fmt.Sprintf("%d", podOrdinal(pod)))
+		}
+		rows = append(rows, row)
+	}
+
+	title := fmt.Sprintf("Rollout Progress (%d of %d updated)", updated, len(pods))
+	table := component.NewTableWithRows(title, "There are no pods!", columns, rows)
+
+	return table, nil
+}
+
+type rolloutProgress struct {
+	revisions      []octant.PodTemplateRevision
+	updateRevision string
+	onDelete       bool
+	// partition is the lowest ordinal which is updated. Pods without ordinals
+	// are not partitioned.
+	partition int
+}
+
+func (r *rolloutProgress) revision(pod *corev1.Pod) string {
+	hash := pod.Labels[appsv1.ControllerRevisionHashLabelKey]
+	for _, revision := range r.revisions {
+		if revision.Matches(hash) {
+			return fmt.Sprintf("%d", revision.Revision)
+		}
+	}
+
+	if hash == "" {
+		return "<unknown>"
+	}
+	return hash
+}
+
+func (r *rolloutProgress) status(pod *corev1.Pod) string {
+	hash := pod.Labels[appsv1.ControllerRevisionHashLabelKey]
+	if hash != "" && r.isUpdateRevision(hash) {
+		return rolloutStatusUpdated
+	}
+
+	if r.onDelete {
+		return rolloutStatusWaitingOnDelete
+	}
+
+	if r.partition > 0 && podOrdinal(pod) < r.partition {
+		return rolloutStatusPartitioned
+	}
+
+	return rolloutStatusPending
+}
+
+func (r *rolloutProgress) isUpdateRevision(hash string) bool {
+	if hash == r.updateRevision {
+		return true
+	}
+
+	for _, revision := range r.revisions {
+		if revision.ControllerRevision.Name == r.updateRevision {
+			return revision.Matches(hash)
+		}
+	}
+
+	return false
+}
+
+// podOrdinal returns the ordinal of a StatefulSet's pod, which is the suffix of
+// its name. It returns -1 if the name does not have an ordinal.
+func podOrdinal(pod *corev1.Pod) int {
+	i := strings.LastIndex(pod.Name, "-")
+	if i < 0 {
+		return -1
+	}
+
+	ordinal, err := strconv.Atoi(pod.Name[i+1:])
+	if err != nil {
+		return -1
+	}
+
+	return ordinal
+}
@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestControllerRevisionHistory(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	now := testutil.Time()
+
+	daemonSet := testutil.CreateDaemonSet("ds")
+
+	first := testRolloutControllerRevision(t, daemonSet, 1, "nginx:1.18")
+	first.CreationTimestamp = metav1.NewTime(now)
+	first.Annotations = map[string]string{octant.ChangeCauseAnnotation: "kubectl create"}
+	second := testRolloutControllerRevision(t, daemonSet, 2, "nginx:1.19")
+	second.CreationTimestamp = metav1.NewTime(now)
+
+	expectRolloutControllerRevisions(t, tpo, second, first)
+
+	got, err := ControllerRevisionHistory(context.Background(), daemonSet, tpo.ToOptions())
+	require.NoError(t, err)
+
+	key, err := store.KeyFromObject(daemonSet)
+	require.NoError(t, err)
+	rollbackPayload := key.ToActionPayload()
+	rollbackPayload["revision"] = int64(1)
+
+	diff := `--- revision 1
++++ revision 2
+@@ -4,6 +4,6 @@
+     app: ds
+ spec:
+   containers:
+-  - image: nginx:1.18
++  - image: nginx:1.19
+     name: nginx
+     resources: {}
+`
+
+	expected := component.NewTable("Revisions", "There are no revisions!", controllerRevisionColumns)
+	expected.Add(
+		component.TableRow{
+			"Revision":     component.NewText("2 (current)"),
+			"Change Cause": component.NewText("<none>"),
+			"Images":       containersComponent("nginx", "nginx:1.19"),
+			"Age":          component.NewTimestamp(now),
+			"Changes":      component.NewCodeBlock(diff),
+		},
+		component.TableRow{
+			"Revision":     component.NewText("1"),
+			"Change Cause": component.NewText("kubectl create"),
+			"Images":       containersComponent("nginx", "nginx:1.18"),
+			"Age":          component.NewTimestamp(now),
+			"Changes":      component.NewText("Initial revision"),
+			component.GridActionKey: gridActionsFactory([]component.GridAction{
+				{
+					Name:       "Rollback",
+					ActionPath: octant.ActionControllerRevisionRollback,
+					Payload:    rollbackPayload,
+					Confirmation: &component.Confirmation{
+						Title: "Rollback DaemonSet",
+						Body:  "Are you sure you want to roll back *DaemonSet* **ds** to revision 1?",
+					},
+					Type: component.GridActionDanger,
+				},
+			}),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func TestRolloutProgress(t *testing.T) {
+	statefulSet := testutil.CreateStatefulSet("web")
+	statefulSet.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	partition := int32(1)
+	statefulSet.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+	}
+	statefulSet.Status.UpdateRevision = "web-2"
+
+	daemonSet := testutil.CreateDaemonSet("ds")
+	daemonSet.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "ds"}}
+
+	pod := func(owner runtime.Object, name, node, hash string) *corev1.Pod {
+		_, ownerObject, _ := octant.ControllerRevisionOwner(owner)
+		pod := testutil.CreatePod(name)
+		pod.Labels = map[string]string{
+			"app":                                 ownerObject.GetName(),
+			appsv1.ControllerRevisionHashLabelKey: hash,
+		}
+		pod.SetOwnerReferences(testutil.ToOwnerReferences(t, owner))
+		pod.Spec.NodeName = node
+		return pod
+	}
+
+	tests := []struct {
+		name     string
+		object   runtime.Object
+		pods     []runtime.Object
+		expected *component.Table
+	}{
+		{
+			name:   "stateful set",
+			object: statefulSet,
+			pods: []runtime.Object{
+				pod(statefulSet, "web-2", "node-a", "web-1"),
+				pod(statefulSet, "web-0", "node-a", "web-1"),
+				pod(statefulSet, "web-1", "", "web-2"),
+			},
+			expected: component.NewTableWithRows("Rollout Progress (1 of 3 updated)", "There are no pods!", statefulSetRolloutColumns,
+				[]component.TableRow{
+					{
+						"Ordinal":  component.NewText("0"),
+						"Pod":      component.NewLink("", "web-0", "/web-0"),
+						"Node":     component.NewLink("", "node-a", "/node-a"),
+						"Revision": component.NewText("1"),
+						"Status":   component.NewText("Held by partition"),
+					},
+					{
+						"Ordinal":  component.NewText("1"),
+						"Pod":      component.NewLink("", "web-1", "/web-1"),
+						"Node":     component.NewText("<not scheduled>"),
+						"Revision": component.NewText("2"),
+						"Status":   component.NewText("Updated"),
+					},
+					{
+						"Ordinal":  component.NewText("2"),
+						"Pod":      component.NewLink("", "web-2", "/web-2"),
+						"Node":     component.NewLink("", "node-a", "/node-a"),
+						"Revision": component.NewText("1"),
+						"Status":   component.NewText("Pending update"),
+					},
+				}),
+		},
+		{
+			name:   "daemon set",
+			object: daemonSet,
+			pods: []runtime.Object{
+				pod(daemonSet, "ds-bbbbb", "node-b", "hash-1"),
+				pod(daemonSet, "ds-aaaaa", "node-a", "hash-2"),
+			},
+			expected: component.NewTableWithRows("Rollout Progress (1 of 2 updated)", "There are no pods!", daemonSetRolloutColumns,
+				[]component.TableRow{
+					{
+						"Pod":      component.NewLink("", "ds-aaaaa", "/ds-aaaaa"),
+						"Node":     component.NewLink("", "node-a", "/node-a"),
+						"Revision": component.NewText("2"),
+						"Status":   component.NewText("Updated"),
+					},
+					{
+						"Pod":      component.NewLink("", "ds-bbbbb", "/ds-bbbbb"),
+						"Node":     component.NewLink("", "node-b", "/node-b"),
+						"Revision": component.NewText("1"),
+						"Status":   component.NewText("Pending update"),
+					},
+				}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+
+			expectRolloutControllerRevisions(t, tpo,
+				testRolloutControllerRevision(t, test.object, 1, "nginx:1.18"),
+				testRolloutControllerRevision(t, test.object, 2, "nginx:1.19"))
+
+			podKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+			tpo.objectStore.EXPECT().
+				List(gomock.Any(), podKey).
+				Return(testutil.ToUnstructuredList(t, test.pods...), false, nil)
+
+			for _, object := range test.pods {
+				pod := object.(*corev1.Pod)
+				tpo.link.EXPECT().
+					ForObject(gomock.Any(), pod.Name).
+					Return(component.NewLink("", pod.Name, "/"+pod.Name), nil)
+				if node := pod.Spec.NodeName; node != "" {
+					tpo.PathForGVK("", "v1", "Node", node, node, "/"+node)
+				}
+			}
+
+			got, err := RolloutProgress(context.Background(), test.object, tpo.ToOptions())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
+
+func testRolloutControllerRevision(t *testing.T, owner runtime.Object, revision int64, image string) *appsv1.ControllerRevision {
+	_, ownerObject, ok := octant.ControllerRevisionOwner(owner)
+	require.True(t, ok)
+
+	controllerRevision := testutil.CreateControllerRevision(fmt.Sprintf("%s-%d", ownerObject.GetName(), revision))
+	controllerRevision.Labels = map[string]string{
+		appsv1.ControllerRevisionHashLabelKey: fmt.Sprintf("hash-%d", revision),
+	}
+	controllerRevision.SetOwnerReferences(testutil.ToOwnerReferences(t, owner))
+	controllerRevision.Revision = revision
+	controllerRevision.Data = runtime.RawExtension{Raw: []byte(fmt.Sprintf(
+		`{"spec":{"template":{"$patch":"replace","metadata":{"labels":{"app":%q}},"spec":{"containers":[{"name":"nginx","image":%q}]}}}}`,
+		ownerObject.GetName(), image))}
+
+	return controllerRevision
+}
+
+func expectRolloutControllerRevisions(t *testing.T, tpo *testPrinterOptions, controllerRevisions ...*appsv1.ControllerRevision) {
+	var objects []runtime.Object
+	for _, controllerRevision := range controllerRevisions {
+		objects = append(objects, controllerRevision)
+	}
+
+	key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ControllerRevision"}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(testutil.ToUnstructuredList(t, objects...), false, nil)
+}
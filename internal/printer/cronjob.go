@@ -29,6 +29,7 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 
 	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age")
 	ot := NewObjectTable("CronJobs", "We couldn't find any cron jobs!", cols, opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 
 	for _, c := range list.Items {
 		row := component.TableRow{}
@@ -56,7 +57,7 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func addCronJobActions(c batchv1beta1.CronJob, row component.TableRow) error {
@@ -197,7 +197,25 @@ func (c *customResourceHandler) Config() error {
 }
 
 func printCustomResourceConfig(crd, cr *unstructured.Unstructured) (*component.Summary, error) {
-	return printCustomResourceSummaryWithPrefix(crd, cr, "Configuration", ".spec")
+	summary, err := printCustomResourceSummaryWithPrefix(crd, cr, "Configuration", ".spec")
+	if err != nil {
+		return nil, err
+	}
+
+	crdVersion, err := crdVersion(crd, cr)
+	if err != nil {
+		return nil, fmt.Errorf("fetch crd version: %w", err)
+	}
+
+	if crdVersion.Scale != nil {
+		scale, err := scaleAction(cr, customResourceReplicas(cr, crdVersion.Scale.SpecReplicasPath))
+		if err != nil {
+			return nil, err
+		}
+		summary.AddAction(scale)
+	}
+
+	return summary, nil
 }
 
 func (c *customResourceHandler) Status() error {
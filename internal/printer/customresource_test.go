@@ -127,6 +127,23 @@ func Test_printCustomResourceConfig(t *testing.T) {
 			cr:       "crd-resource.yaml",
 			expected: component.NewSummary("Configuration"),
 		},
+		{
+			name: "with scale subresource",
+			crd:  "crd-scale.yaml",
+			cr:   "crd-resource.yaml",
+			expected: summaryWithActions(component.NewSummary("Configuration"), component.Action{
+				Name:  "Scale",
+				Title: "Scale CronTab",
+				Form: component.Form{Fields: []component.FormField{
+					component.NewFormFieldNumber("Replicas", "replicas", "1"),
+					component.NewFormFieldHidden("apiVersion", "stable.example.com/v1"),
+					component.NewFormFieldHidden("kind", "CronTab"),
+					component.NewFormFieldHidden("name", "my-crontab"),
+					component.NewFormFieldHidden("namespace", "default"),
+					component.NewFormFieldHidden("action", octant.ActionScale),
+				}},
+			}),
+		},
 	}
 
 	for _, tc := range cases {
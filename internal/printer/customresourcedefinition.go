@@ -35,6 +35,7 @@ func CustomResourceDefinitionListHandler(
 		"We couldn't find any custom resource definitions!",
 		cols,
 		opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 
 	for _, crd := range list.Items {
 		row := component.TableRow{}
@@ -52,7 +53,7 @@ func CustomResourceDefinitionListHandler(
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // CustomResourceDefinitionHandler is a print func that prints a custom resource definition.
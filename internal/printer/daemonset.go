@@ -25,6 +25,7 @@ func DaemonSetListHandler(ctx context.Context, list *appsv1.DaemonSetList, opts
 	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Ready",
 		"Up-To-Date", "Age", "Node Selector")
 	ot := NewObjectTable("Daemon Sets", "We couldn't find any daemon sets!", cols, opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 
 	for _, daemonSet := range list.Items {
 		row := component.TableRow{}
@@ -47,7 +48,7 @@ func DaemonSetListHandler(ctx context.Context, list *appsv1.DaemonSetList, opts
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // DaemonSetHandler is a printFunc that prints a daemon set
@@ -33,6 +33,7 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	ot := NewObjectTable("Deployments", "We couldn't find any deployments!", cols, opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 
 	for _, d := range list.Items {
 		row := component.TableRow{}
@@ -63,7 +64,7 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // DeploymentHandler is a printFunc that prints a Deployments.
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	deploymentRevisionColumns = component.NewTableCols("Revision", "Change Cause", "Images", "Age", "Changes")
+)
+
+// DeploymentRevisions prints the revisions of a deployment, newest first. Changes
+// are the differences between a revision's pod template and the previous
+// revision's. Revisions other than the current revision can be rolled back to.
+func DeploymentRevisions(ctx context.Context, deployment *appsv1.Deployment, options Options) (*component.Table, error) {
+	if deployment == nil {
+		return nil, fmt.Errorf("deployment is nil")
+	}
+
+	revisions, err := octant.DeploymentRevisions(ctx, options.DashConfig.ObjectStore(), deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := store.KeyFromObject(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRevision, _ := octant.Revision(deployment)
+
+	table := component.NewTable("Revisions", "There are no revisions!", deploymentRevisionColumns)
+
+	for i := len(revisions) - 1; i >= 0; i-- {
+		revision := revisions[i]
+
+		revisionText := fmt.Sprintf("%d", revision.Revision)
+		if revision.Revision == currentRevision {
+			revisionText += " (current)"
+		}
+
+		revisionLink, err := options.Link.ForObject(revision.ReplicaSet, revisionText)
+		if err != nil {
+			return nil, err
+		}
+
+		images := component.NewContainers()
+		for _, container := range revision.ReplicaSet.Spec.Template.Spec.Containers {
+			images.Add(container.Name, container.Image)
+		}
+
+		var changes component.Component = component.NewText("Initial revision")
+		if i > 0 {
+			previous := revisions[i-1]
+			diff, err := kubernetes.DiffYAML(
+				fmt.Sprintf("revision %d", previous.Revision), previous.Template(),
+				fmt.Sprintf("revision %d", revision.Revision), revision.Template())
+			if err != nil {
+				return nil, err
+			}
+
+			changes = component.NewText("No changes")
+			if diff != "" {
+				changes = component.NewCodeBlock(diff)
+			}
+		}
+
+		changeCause := revision.ChangeCause()
+		if changeCause == "" {
+			changeCause = "<none>"
+		}
+
+		row := component.TableRow{
+			"Revision":     revisionLink,
+			"Change Cause": component.NewText(changeCause),
+			"Images":       images,
+			"Age":          component.NewTimestamp(revision.ReplicaSet.CreationTimestamp.Time),
+			"Changes":      changes,
+		}
+
+		if revision.Revision != currentRevision {
+			payload := key.ToActionPayload()
+			payload["revision"] = revision.Revision
+
+			row.AddAction(component.GridAction{
+				Name:       "Rollback",
+				ActionPath: octant.ActionDeploymentRollback,
+				Payload:    payload,
+				Confirmation: &component.Confirmation{
+					Title: "Rollback Deployment",
+					Body: fmt.Sprintf("Are you sure you want to roll back *Deployment* **%s** to revision %d?",
+						deployment.Name, revision.Revision),
+				},
+				Type: component.GridActionDanger,
+			})
+		}
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestDeploymentRevisions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	now := testutil.Time()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{octant.RevisionAnnotation: "2"}
+
+	revisionReplicaSet := func(revision int, image string) *appsv1.ReplicaSet {
+		replicaSet := testutil.CreateAppReplicaSet(fmt.Sprintf("deployment-%d", revision))
+		replicaSet.CreationTimestamp = metav1.NewTime(now)
+		replicaSet.Annotations = map[string]string{octant.RevisionAnnotation: fmt.Sprintf("%d", revision)}
+		replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+		replicaSet.Spec.Template = corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+				appsv1.DefaultDeploymentUniqueLabelKey: fmt.Sprintf("hash-%d", revision),
+			}},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "nginx", Image: image}},
+			},
+		}
+		return replicaSet
+	}
+
+	first := revisionReplicaSet(1, "nginx:1.18")
+	first.Annotations[octant.ChangeCauseAnnotation] = "kubectl create"
+	second := revisionReplicaSet(2, "nginx:1.19")
+
+	key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet"}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(testutil.ToUnstructuredList(t, second, first), false, nil)
+
+	tpo.link.EXPECT().
+		ForObject(gomock.Any(), "2 (current)").
+		Return(component.NewLink("", "2 (current)", "/deployment-2"), nil)
+	tpo.link.EXPECT().
+		ForObject(gomock.Any(), "1").
+		Return(component.NewLink("", "1", "/deployment-1"), nil)
+
+	ctx := context.Background()
+	got, err := DeploymentRevisions(ctx, deployment, tpo.ToOptions())
+	require.NoError(t, err)
+
+	diff, err := kubernetes.DiffYAML(
+		"revision 1", octant.DeploymentRevision{Revision: 1, ReplicaSet: first}.Template(),
+		"revision 2", octant.DeploymentRevision{Revision: 2, ReplicaSet: second}.Template())
+	require.NoError(t, err)
+
+	deploymentKey, err := store.KeyFromObject(deployment)
+	require.NoError(t, err)
+	rollbackPayload := deploymentKey.ToActionPayload()
+	rollbackPayload["revision"] = int64(1)
+
+	expected := component.NewTable("Revisions", "There are no revisions!", deploymentRevisionColumns)
+	expected.Add(
+		component.TableRow{
+			"Revision":     component.NewLink("", "2 (current)", "/deployment-2"),
+			"Change Cause": component.NewText("<none>"),
+			"Images":       containersComponent("nginx", "nginx:1.19"),
+			"Age":          component.NewTimestamp(now),
+			"Changes":      component.NewCodeBlock(diff),
+		},
+		component.TableRow{
+			"Revision":     component.NewLink("", "1", "/deployment-1"),
+			"Change Cause": component.NewText("kubectl create"),
+			"Images":       containersComponent("nginx", "nginx:1.18"),
+			"Age":          component.NewTimestamp(now),
+			"Changes":      component.NewText("Initial revision"),
+			component.GridActionKey: gridActionsFactory([]component.GridAction{
+				{
+					Name:       "Rollback",
+					ActionPath: octant.ActionDeploymentRollback,
+					Payload:    rollbackPayload,
+					Confirmation: &component.Confirmation{
+						Title: "Rollback Deployment",
+						Body:  "Are you sure you want to roll back *Deployment* **deployment** to revision 1?",
+					},
+					Type: component.GridActionDanger,
+				},
+			}),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+	assert.Contains(t, diff, "-  - image: nginx:1.18")
+}
+
+func containersComponent(name, image string) *component.Containers {
+	containers := component.NewContainers()
+	containers.Add(name, image)
+	return containers
+}
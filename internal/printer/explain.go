@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/openapi"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	explainFieldColumns = component.NewTableCols("Field", "Type", "Required", "Set", "Description")
+)
+
+// Explain prints the description of an object's kind and its fields. Fields
+// are listed in the order of the schema, so sub-fields follow their parents.
+func Explain(explanation *openapi.Explanation) (*component.FlexLayout, error) {
+	if explanation == nil {
+		return nil, fmt.Errorf("explanation is nil")
+	}
+
+	gvk := explanation.GroupVersionKind
+
+	description := explanation.Description
+	if description == "" {
+		description = "There is no description for this kind."
+	}
+
+	summary := component.NewSummary(fmt.Sprintf("%s (%s)", gvk.Kind, gvk.GroupVersion().String()),
+		component.SummarySection{Header: "Description", Content: component.NewText(description)})
+
+	table := component.NewTable("Fields", "There are no fields!", explainFieldColumns)
+	for _, field := range explanation.Fields {
+		table.Add(component.TableRow{
+			"Field":       component.NewText(field.Path),
+			"Type":        component.NewText(field.Type),
+			"Required":    component.NewText(fmt.Sprintf("%t", field.Required)),
+			"Set":         component.NewText(fmt.Sprintf("%t", field.Set)),
+			"Description": component.NewText(field.Description),
+		})
+	}
+
+	layout := component.NewFlexLayout("Explain")
+	layout.AddSections(
+		component.FlexLayoutSection{{Width: component.WidthFull, View: summary}},
+		component.FlexLayoutSection{{Width: component.WidthFull, View: table}},
+	)
+
+	return layout, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/openapi"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestExplain(t *testing.T) {
+	explanation := &openapi.Explanation{
+		GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Description:      "Deployment enables declarative updates for Pods and ReplicaSets.",
+		Fields: []openapi.Field{
+			{Path: "spec", Type: "DeploymentSpec", Description: "Specification of the desired behavior of the Deployment.", Set: true},
+			{Path: "spec.replicas", Depth: 1, Type: "integer (int32)", Description: "Number of desired pods."},
+			{Path: "spec.selector", Depth: 1, Type: "map[string]string", Required: true, Set: true},
+		},
+	}
+
+	got, err := Explain(explanation)
+	require.NoError(t, err)
+
+	summary := component.NewSummary("Deployment (apps/v1)",
+		component.SummarySection{Header: "Description", Content: component.NewText("Deployment enables declarative updates for Pods and ReplicaSets.")})
+
+	table := component.NewTableWithRows("Fields", "There are no fields!", explainFieldColumns, []component.TableRow{
+		{
+			"Field":       component.NewText("spec"),
+			"Type":        component.NewText("DeploymentSpec"),
+			"Required":    component.NewText("false"),
+			"Set":         component.NewText("true"),
+			"Description": component.NewText("Specification of the desired behavior of the Deployment."),
+		},
+		{
+			"Field":       component.NewText("spec.replicas"),
+			"Type":        component.NewText("integer (int32)"),
+			"Required":    component.NewText("false"),
+			"Set":         component.NewText("false"),
+			"Description": component.NewText("Number of desired pods."),
+		},
+		{
+			"Field":       component.NewText("spec.selector"),
+			"Type":        component.NewText("map[string]string"),
+			"Required":    component.NewText("true"),
+			"Set":         component.NewText("true"),
+			"Description": component.NewText(""),
+		},
+	})
+
+	expected := component.NewFlexLayout("Explain")
+	expected.AddSections(
+		component.FlexLayoutSection{{Width: component.WidthFull, View: summary}},
+		component.FlexLayoutSection{{Width: component.WidthFull, View: table}},
+	)
+
+	component.AssertEqual(t, expected, got)
+
+	_, err = Explain(nil)
+	require.Error(t, err)
+}
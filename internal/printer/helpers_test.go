@@ -38,6 +38,12 @@ func newTestPrinterOptions(controller *gomock.Controller) *testPrinterOptions {
 	objectStore := objectStoreFake.NewMockStore(controller)
 
 	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		ListColumns(gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+	pluginManager.EXPECT().
+		ObjectActions(gomock.Any()).
+		Return(nil, nil).AnyTimes()
 
 	portForwarder := portForwardFake.NewMockPortForwarder(controller)
 
@@ -97,6 +103,21 @@ func buildObjectDeleteAction(t *testing.T, object runtime.Object) component.Grid
 	return action
 }
 
+func buildScaleAction(t *testing.T, object runtime.Object, replicas *int32) component.Action {
+	action, err := scaleAction(object, replicas)
+	require.NoError(t, err)
+
+	return action
+}
+
+func summaryWithActions(summary *component.Summary, actions ...component.Action) *component.Summary {
+	for _, action := range actions {
+		summary.AddAction(action)
+	}
+
+	return summary
+}
+
 // genObjectStatus generates object status for a link. It can be used
 // when testing list handlers. This will be needed until there is a
 // way to test that the list handlers are working without external
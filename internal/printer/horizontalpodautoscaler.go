@@ -31,6 +31,7 @@ func HorizontalPodAutoscalerListHandler(ctx context.Context, list *autoscalingv1
 	cols := component.NewTableCols("Name", "Labels", "Targets", "Minimum Pods", "Maximum Pods", "Replicas", "Age")
 	ot := NewObjectTable("Horizontal Pod Autoscalers",
 		"We couldn't find any horizontal pod autoscalers", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, horizontalPodAutoscaler := range list.Items {
 		row := component.TableRow{}
@@ -62,7 +63,7 @@ func HorizontalPodAutoscalerListHandler(ctx context.Context, list *autoscalingv1
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // HorizontalPodAutoscalerHandler is a printFunc that prints a HorizontalPodAutoscaler
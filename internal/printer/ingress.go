@@ -26,6 +26,8 @@ func IngressListHandler(ctx context.Context, list *extv1beta1.IngressList, optio
 
 	cols := component.NewTableCols("Name", "Labels", "Hosts", "Address", "Ports", "Age")
 	ot := NewObjectTable("Ingresses", "We couldn't find any ingresses!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
+	ot.SetCertificateExpiryWindow(options.certificateExpiryWindow())
 
 	for _, ingress := range list.Items {
 		ports := "80"
@@ -51,7 +53,7 @@ func IngressListHandler(ctx context.Context, list *extv1beta1.IngressList, optio
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // IngressHandler is a printFunc that prints an Ingress
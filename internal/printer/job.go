@@ -31,6 +31,7 @@ func JobListHandler(ctx context.Context, list *batchv1.JobList, opts Options) (c
 	}
 
 	ot := NewObjectTable("Jobs", "We couldn't find any jobs!", JobCols, opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 
 	for _, job := range list.Items {
 		row := component.TableRow{}
@@ -52,7 +53,7 @@ func JobListHandler(ctx context.Context, list *batchv1.JobList, opts Options) (c
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // JobHandler printers a job.
@@ -24,6 +24,8 @@ func MutatingWebhookConfigurationListHandler(ctx context.Context, list *admissio
 
 	cols := component.NewTableCols("Name", "Age")
 	ot := NewObjectTable("Mutating Webhook Configurations", "We couldn't find any mutating webhook configurations!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
+	ot.SetCertificateExpiryWindow(options.certificateExpiryWindow())
 
 	for _, mutatingWebhookConfiguration := range list.Items {
 		row := component.TableRow{}
@@ -41,7 +43,7 @@ func MutatingWebhookConfigurationListHandler(ctx context.Context, list *admissio
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // MutatingWebhookConfigurationHandler is a printFunc that prints a mutating webhook configurations
@@ -31,6 +31,7 @@ func NamespaceListHandler(ctx context.Context, list *corev1.NamespaceList, optio
 	}
 
 	ot := NewObjectTable("Namespaces", "We couldn't find any namespaces!", namespaceListCols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, namespace := range list.Items {
 		row := component.TableRow{}
@@ -44,7 +45,7 @@ func NamespaceListHandler(ctx context.Context, list *corev1.NamespaceList, optio
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func NamespaceHandler(ctx context.Context, namespace *corev1.Namespace, options Options) (component.Component, error) {
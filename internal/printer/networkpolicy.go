@@ -31,6 +31,7 @@ func NetworkPolicyListHandler(ctx context.Context, list *networkingv1.NetworkPol
 
 	cols := component.NewTableCols("Name", "Labels", "Age")
 	ot := NewObjectTable("Network Policies", "We couldn't find any network policies!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, networkPolicy := range list.Items {
 		row := component.TableRow{}
@@ -48,7 +49,7 @@ func NetworkPolicyListHandler(ctx context.Context, list *networkingv1.NetworkPol
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // NetworkPolicyHandler is a printFunc that prints NetworkPolicies
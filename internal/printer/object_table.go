@@ -8,12 +8,15 @@ package printer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/objectstatus"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -27,6 +30,14 @@ type ObjectTable struct {
 	filters     map[string]component.TableFilter
 	sortOrder   *tableSetOrder
 	store       store.Store
+
+	pluginManager plugin.ManagerInterface
+	// objects are the objects of the rows. Plugins are sent them all at once
+	// when the table is converted to a component.
+	objects []runtime.Object
+	// certificateExpiryWindow is how long before a certificate expires it is
+	// shown as expiring in the status of a row.
+	certificateExpiryWindow time.Duration
 }
 
 // NewObjectTable creates an instance of ObjectTable.
@@ -49,6 +60,20 @@ func (ol *ObjectTable) AddFilters(filters map[string]component.TableFilter) {
 	}
 }
 
+// EnablePlugins enables columns and actions supplied by plugins. Each row added
+// after this is called will include the columns and actions plugins return for its object.
+// Plugins are asked for the columns of all rows once, when the table is converted
+// to a component.
+func (ol *ObjectTable) EnablePlugins(pluginManager plugin.ManagerInterface) {
+	ol.pluginManager = pluginManager
+}
+
+// SetCertificateExpiryWindow sets how long before a certificate expires it is
+// shown as expiring in the status of a row.
+func (ol *ObjectTable) SetCertificateExpiryWindow(window time.Duration) {
+	ol.certificateExpiryWindow = window
+}
+
 type componentStatus interface {
 	SetStatus(status component.TextStatus, detail component.Component)
 }
@@ -69,7 +94,8 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 		row["_isDeleted"] = component.NewText("deleted")
 	}
 
-	status, err := objectstatus.Status(ctx, object, ol.store)
+	status, err := objectstatus.Status(ctx, object, ol.store,
+		objectstatus.WithCertificateExpiryWindow(ol.certificateExpiryWindow))
 	if err != nil {
 		return fmt.Errorf("get status for object: %w", err)
 	}
@@ -84,11 +110,104 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 
 	row.AddAction(gridAction)
 
+	ol.addPluginActions(ctx, object, row)
+
 	ol.rows = append(ol.rows, row)
+	ol.objects = append(ol.objects, object)
 
 	return nil
 }
 
+// addPluginColumns adds the columns plugins return for the objects of the rows.
+// If plugins fail, the error is logged and the rows are left without plugin columns
+// so plugins don't fail the whole list.
+func (ol *ObjectTable) addPluginColumns(ctx context.Context) {
+	if ol.pluginManager == nil || len(ol.objects) == 0 {
+		return
+	}
+
+	responses, err := ol.pluginManager.ListColumns(ctx, ol.objects)
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("list plugin columns for %s", ol.title)
+		return
+	}
+
+	for i := range responses {
+		if i < len(ol.rows) {
+			ol.setPluginColumns(responses[i].Columns, ol.rows[i])
+		}
+	}
+}
+
+func (ol *ObjectTable) setPluginColumns(columns []plugin.ListColumn, row component.TableRow) {
+	for _, column := range columns {
+		if column.Name == "" || column.Value == nil {
+			continue
+		}
+
+		if !ol.hasColumn(column.Name) {
+			ol.cols = append(ol.cols[:len(ol.cols):len(ol.cols)], component.TableCol{Name: column.Name, Accessor: column.Name})
+		}
+
+		if _, ok := row[column.Name]; !ok {
+			row[column.Name] = column.Value
+		}
+	}
+}
+
+// addPluginActions adds the actions plugins return for an object. If plugins
+// fail, the error is logged and the row only has its own actions.
+func (ol *ObjectTable) addPluginActions(ctx context.Context, object runtime.Object, row component.TableRow) {
+	if ol.pluginManager == nil {
+		return
+	}
+
+	objectActions, err := ol.pluginManager.ObjectActions(object)
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("list plugin actions for %s", ol.title)
+		return
+	}
+
+	if len(objectActions) == 0 {
+		return
+	}
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("create key for plugin actions in %s", ol.title)
+		return
+	}
+
+	for _, objectAction := range objectActions {
+		gridAction := component.GridAction{
+			Name:       objectAction.Label,
+			ActionPath: objectAction.ActionName,
+			Payload:    key.ToActionPayload(),
+			Form:       objectAction.Form,
+			Type:       component.GridActionPrimary,
+		}
+
+		if objectAction.Confirmation != "" {
+			gridAction.Confirmation = &component.Confirmation{
+				Title: objectAction.Label,
+				Body:  objectAction.Confirmation,
+			}
+		}
+
+		row.AddAction(gridAction)
+	}
+}
+
+func (ol *ObjectTable) hasColumn(name string) bool {
+	for _, col := range ol.cols {
+		if col.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func convertNodeStatusToTextStatus(nodeStatus component.NodeStatus) component.TextStatus {
 	switch nodeStatus {
 	case component.NodeStatusOK:
@@ -139,7 +258,9 @@ func (ol *ObjectTable) SetSortOrder(name string, reverse bool) {
 }
 
 // ToComponent converts the ObjectTable instance to a component.
-func (ol *ObjectTable) ToComponent() (component.Component, error) {
+func (ol *ObjectTable) ToComponent(ctx context.Context) (component.Component, error) {
+	ol.addPluginColumns(ctx)
+
 	table := component.NewTableWithRows(ol.title, ol.placeholder, ol.cols, ol.rows)
 
 	for name, filter := range ol.filters {
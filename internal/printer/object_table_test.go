@@ -16,6 +16,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/store/fake"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -144,9 +147,182 @@ func TestObjectTable(t *testing.T) {
 
 			test.mutateFn(ot)
 
-			actual, err := ot.ToComponent()
+			actual, err := ot.ToComponent(ctx)
 			require.NoError(t, err)
 			testutil.AssertJSONEqual(t, test.wanted(), actual)
 		})
 	}
 }
+
+func TestObjectTable_EnablePlugins(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cols := component.NewTableCols("A")
+
+	objectStore := fake.NewMockStore(ctrl)
+	pluginManager := pluginFake.NewMockManagerInterface(ctrl)
+
+	pod1 := testutil.CreatePod("pod1")
+	pod2 := testutil.CreatePod("pod2")
+
+	pluginManager.EXPECT().
+		ListColumns(gomock.Any(), []runtime.Object{pod1, pod2}).
+		Return([]plugin.ListColumnsResponse{
+			{
+				Columns: []plugin.ListColumn{
+					{Name: "Team", Value: component.NewText("platform")},
+				},
+			},
+			{},
+		}, nil)
+
+	objectAction := plugin.ObjectAction{
+		ActionName:   "example.com/restart",
+		Label:        "Restart",
+		Confirmation: "Restart this pod?",
+	}
+	pluginManager.EXPECT().
+		ObjectActions(pod1).
+		Return([]plugin.ObjectAction{objectAction}, nil)
+	pluginManager.EXPECT().
+		ObjectActions(pod2).
+		Return(nil, nil)
+
+	ot := NewObjectTable("table", "placeholder", cols, objectStore)
+	ot.EnablePlugins(pluginManager)
+
+	pod1A := component.NewLink("", "pod1", "/pod1")
+	pod2A := component.NewLink("", "pod2", "/pod2")
+
+	require.NoError(t, ot.AddRowForObject(ctx, pod1, component.TableRow{"A": pod1A}))
+	require.NoError(t, ot.AddRowForObject(ctx, pod2, component.TableRow{"A": pod2A}))
+
+	actual, err := ot.ToComponent(ctx)
+	require.NoError(t, err)
+
+	table, ok := actual.(*component.Table)
+	require.True(t, ok)
+
+	require.Equal(t, component.NewTableCols("A", "Team"), table.Columns())
+
+	rows := table.Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, component.NewText("platform"), rows[0]["Team"])
+	_, ok = rows[1]["Team"]
+	require.False(t, ok)
+
+	key, err := store.KeyFromObject(pod1)
+	require.NoError(t, err)
+
+	pod1Actions, ok := rows[0][component.GridActionKey].(*component.GridActions)
+	require.True(t, ok)
+	require.Len(t, pod1Actions.Config.Actions, 2)
+	require.Equal(t, component.GridAction{
+		Name:       "Restart",
+		ActionPath: "example.com/restart",
+		Payload:    key.ToActionPayload(),
+		Confirmation: &component.Confirmation{
+			Title: "Restart",
+			Body:  "Restart this pod?",
+		},
+		Type: component.GridActionPrimary,
+	}, pod1Actions.Config.Actions[1])
+
+	pod2Actions, ok := rows[1][component.GridActionKey].(*component.GridActions)
+	require.True(t, ok)
+	require.Len(t, pod2Actions.Config.Actions, 1)
+}
+
+func TestObjectTable_EnablePlugins_list_columns_error(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cols := component.NewTableCols("A")
+
+	objectStore := fake.NewMockStore(ctrl)
+	pluginManager := pluginFake.NewMockManagerInterface(ctrl)
+
+	pod1 := testutil.CreatePod("pod1")
+	pod2 := testutil.CreatePod("pod2")
+
+	pluginManager.EXPECT().
+		ListColumns(gomock.Any(), []runtime.Object{pod1, pod2}).
+		Return(nil, fmt.Errorf("plugin failed"))
+
+	for _, pod := range []*corev1.Pod{pod1, pod2} {
+		pluginManager.EXPECT().ObjectActions(pod).Return(nil, nil)
+	}
+
+	ot := NewObjectTable("table", "placeholder", cols, objectStore)
+	ot.EnablePlugins(pluginManager)
+
+	require.NoError(t, ot.AddRowForObject(ctx, pod1, component.TableRow{"A": component.NewText("pod1")}))
+	require.NoError(t, ot.AddRowForObject(ctx, pod2, component.TableRow{"A": component.NewText("pod2")}))
+
+	actual, err := ot.ToComponent(ctx)
+	require.NoError(t, err)
+
+	table, ok := actual.(*component.Table)
+	require.True(t, ok)
+
+	require.Equal(t, component.NewTableCols("A"), table.Columns())
+
+	rows := table.Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, component.NewText("pod1"), rows[0]["A"])
+	require.Equal(t, component.NewText("pod2"), rows[1]["A"])
+}
+
+func TestObjectTable_EnablePlugins_object_actions_error(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cols := component.NewTableCols("A")
+
+	objectStore := fake.NewMockStore(ctrl)
+	pluginManager := pluginFake.NewMockManagerInterface(ctrl)
+
+	pod1 := testutil.CreatePod("pod1")
+	pod2 := testutil.CreatePod("pod2")
+
+	pluginManager.EXPECT().
+		ListColumns(gomock.Any(), []runtime.Object{pod1, pod2}).
+		Return(make([]plugin.ListColumnsResponse, 2), nil)
+
+	pluginManager.EXPECT().
+		ObjectActions(pod1).
+		Return(nil, fmt.Errorf("plugin failed"))
+	pluginManager.EXPECT().
+		ObjectActions(pod2).
+		Return([]plugin.ObjectAction{{ActionName: "example.com/restart", Label: "Restart"}}, nil)
+
+	ot := NewObjectTable("table", "placeholder", cols, objectStore)
+	ot.EnablePlugins(pluginManager)
+
+	require.NoError(t, ot.AddRowForObject(ctx, pod1, component.TableRow{"A": component.NewText("pod1")}))
+	require.NoError(t, ot.AddRowForObject(ctx, pod2, component.TableRow{"A": component.NewText("pod2")}))
+
+	actual, err := ot.ToComponent(ctx)
+	require.NoError(t, err)
+
+	table, ok := actual.(*component.Table)
+	require.True(t, ok)
+
+	rows := table.Rows()
+	require.Len(t, rows, 2)
+
+	pod1Actions, ok := rows[0][component.GridActionKey].(*component.GridActions)
+	require.True(t, ok)
+	require.Len(t, pod1Actions.Config.Actions, 1)
+
+	pod2Actions, ok := rows[1][component.GridActionKey].(*component.GridActions)
+	require.True(t, ok)
+	require.Len(t, pod2Actions.Config.Actions, 2)
+}
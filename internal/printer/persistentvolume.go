@@ -25,6 +25,7 @@ func PersistentVolumeListHandler(ctx context.Context, list *corev1.PersistentVol
 
 	cols := component.NewTableCols("Name", "Capacity", "Access Modes", "Reclaim Policy", "Status", "Claim", "Storage Class", "Reason", "Age")
 	ot := NewObjectTable("Persistent Volumes", "We couldn't find any persistent volumes!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, pv := range list.Items {
 		row := component.TableRow{}
@@ -58,7 +59,7 @@ func PersistentVolumeListHandler(ctx context.Context, list *corev1.PersistentVol
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // PersistentVolumeHandler is a printFunc that creates a component to display a single Persistent Volume
@@ -28,6 +28,7 @@ func PersistentVolumeClaimListHandler(ctx context.Context, list *corev1.Persiste
 	cols := component.NewTableCols("Name", "Status", "Volume", "Capacity", "Access Modes", "Storage Class", "Age")
 	ot := NewObjectTable("Persistent Volume Claims",
 		"We couldn't find any persistent volume claims!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, persistentVolumeClaim := range list.Items {
 		row := component.TableRow{}
@@ -76,7 +77,7 @@ func PersistentVolumeClaimListHandler(ctx context.Context, list *corev1.Persiste
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
 	}
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // PersistentVolumeClaimHandler is a printFunc that prints a PersistentVolumeClaim
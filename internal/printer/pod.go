@@ -43,6 +43,7 @@ func PodListHandler(ctx context.Context, list *corev1.PodList, opts Options) (co
 	}
 
 	ot := NewObjectTable("Pods", "We couldn't find any pods!", cols, opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 	ot.AddFilters(podTableFilters())
 
 	for i := range list.Items {
@@ -94,7 +95,7 @@ func PodListHandler(ctx context.Context, list *corev1.PodList, opts Options) (co
 
 	ot.SetSortOrder("Name", false)
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func podNode(pod *corev1.Pod, linkGenerator link.Interface) (component.Component, error) {
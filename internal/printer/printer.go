@@ -10,9 +10,12 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/vmware-tanzu/octant/internal/certificates"
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/link"
+	"github.com/vmware-tanzu/octant/internal/octant"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -30,6 +33,25 @@ type Options struct {
 	DashConfig    config.Dash
 	Link          link.Interface
 	ObjectFactory ObjectFactory
+	// SecretReveals are the secret values which are revealed. No values are
+	// revealed if it is nil.
+	SecretReveals *octant.SecretReveals
+	// ConfigMapChanges are the config maps which were changed. No config maps
+	// are shown as changed if it is nil.
+	ConfigMapChanges *octant.ConfigMapChanges
+	// CertificateExpiryWindow is how long before a certificate expires it is
+	// shown as expiring. certificates.DefaultExpiryWindow is used if it is not
+	// positive.
+	CertificateExpiryWindow time.Duration
+}
+
+// certificateExpiryWindow returns how long before a certificate expires it is
+// shown as expiring.
+func (o Options) certificateExpiryWindow() time.Duration {
+	if o.CertificateExpiryWindow <= 0 {
+		return certificates.DefaultExpiryWindow
+	}
+	return o.CertificateExpiryWindow
 }
 
 // Printer is an interface for printing runtime objects.
@@ -40,18 +62,53 @@ type Printer interface {
 
 // Resource prints runtime objects.
 type Resource struct {
-	handlerMap map[reflect.Type]reflect.Value
-	dashConfig config.Dash
+	handlerMap       map[reflect.Type]reflect.Value
+	dashConfig       config.Dash
+	secretReveals    *octant.SecretReveals
+	configMapChanges *octant.ConfigMapChanges
+
+	certificateExpiryWindow time.Duration
 }
 
 var _ Printer = (*Resource)(nil)
 
+// ResourceOption is an option for configuring Resource.
+type ResourceOption func(p *Resource)
+
+// WithSecretReveals configures the secret values Resource reveals.
+func WithSecretReveals(secretReveals *octant.SecretReveals) ResourceOption {
+	return func(p *Resource) {
+		p.secretReveals = secretReveals
+	}
+}
+
+// WithConfigMapChanges configures the config map changes Resource shows.
+func WithConfigMapChanges(configMapChanges *octant.ConfigMapChanges) ResourceOption {
+	return func(p *Resource) {
+		p.configMapChanges = configMapChanges
+	}
+}
+
+// WithCertificateExpiryWindow configures how long before a certificate expires
+// Resource shows it as expiring.
+func WithCertificateExpiryWindow(window time.Duration) ResourceOption {
+	return func(p *Resource) {
+		p.certificateExpiryWindow = window
+	}
+}
+
 // NewResource creates an instance of ResourcePrinter.
-func NewResource(dashConfig config.Dash) *Resource {
-	return &Resource{
+func NewResource(dashConfig config.Dash, options ...ResourceOption) *Resource {
+	p := &Resource{
 		handlerMap: make(map[reflect.Type]reflect.Value),
 		dashConfig: dashConfig,
 	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
 }
 
 // Print prints a runtime object. If not handler can be found for the type,
@@ -63,9 +120,13 @@ func (p *Resource) Print(ctx context.Context, object runtime.Object) (component.
 	}
 
 	printOptions := Options{
-		DashConfig:    p.dashConfig,
-		Link:          l,
-		ObjectFactory: NewDefaultObjectFactory(),
+		DashConfig:       p.dashConfig,
+		Link:             l,
+		ObjectFactory:    NewDefaultObjectFactory(),
+		SecretReveals:    p.secretReveals,
+		ConfigMapChanges: p.configMapChanges,
+
+		CertificateExpiryWindow: p.certificateExpiryWindow,
 	}
 
 	t := reflect.TypeOf(object)
@@ -28,6 +28,7 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	ot := NewObjectTable("ReplicaSets", "We couldn't find any replica sets!", cols, opts.DashConfig.ObjectStore())
+	ot.EnablePlugins(opts.DashConfig.PluginManager())
 
 	for _, rs := range list.Items {
 		row := component.TableRow{}
@@ -57,7 +58,7 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ReplicaSetHandler is a printFunc that prints a ReplicaSets.
@@ -131,6 +132,12 @@ func (rc *ReplicaSetConfiguration) Create(options Options) (*component.Summary,
 
 	summary := component.NewSummary("Configuration", sections...)
 
+	scale, err := scaleAction(rs, rs.Spec.Replicas)
+	if err != nil {
+		return nil, err
+	}
+	summary.AddAction(scale)
+
 	return summary, nil
 }
 
@@ -146,7 +146,7 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 		{
 			name:       "replicaset",
 			replicaset: rs,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
+			expected: summaryWithActions(component.NewSummary("Configuration", []component.SummarySection{
 				{
 					Header:  "Controlled By",
 					Content: component.NewLink("", "replicaset-controller", "/owner"),
@@ -160,6 +160,7 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 					Content: component.NewText("3"),
 				},
 			}...),
+				buildScaleAction(t, rs, &replicas)),
 		},
 		{
 			name:       "replicaset is nil",
@@ -28,6 +28,7 @@ func ReplicationControllerListHandler(ctx context.Context, list *corev1.Replicat
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	ot := NewObjectTable("ReplicationControllers",
 		"We couldn't find any replication controllers!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, rc := range list.Items {
 		row := component.TableRow{}
@@ -59,7 +60,7 @@ func ReplicationControllerListHandler(ctx context.Context, list *corev1.Replicat
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ReplicationControllerHandler is a printFunc that prints a ReplicationController
@@ -133,6 +134,12 @@ func (rcc *ReplicationControllerConfiguration) Create(options Options) (*compone
 	sections.AddText("Replicas", replicas)
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	scale, err := scaleAction(replicationController, replicationController.Spec.Replicas)
+	if err != nil {
+		return nil, err
+	}
+	summary.AddAction(scale)
 	return summary, nil
 }
 
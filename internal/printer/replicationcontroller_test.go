@@ -122,7 +122,7 @@ func Test_ReplicationControllerConfiguration(t *testing.T) {
 		{
 			name:                  "replicationcontroller",
 			replicationController: rc,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
+			expected: summaryWithActions(component.NewSummary("Configuration", []component.SummarySection{
 				{
 					Header:  "Replica Status",
 					Content: component.NewText("Current 3 / Desired 3"),
@@ -132,6 +132,7 @@ func Test_ReplicationControllerConfiguration(t *testing.T) {
 					Content: component.NewText("3"),
 				},
 			}...),
+				buildScaleAction(t, rc, &replicas)),
 		},
 		{
 			name:                  "replicationcontroller is nil",
@@ -24,6 +24,7 @@ func RoleListHandler(ctx context.Context, roleList *rbacv1.RoleList, options Opt
 
 	columns := component.NewTableCols("Name", "Age")
 	ot := NewObjectTable("Roles", "We couldn't find any roles!", columns, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, role := range roleList.Items {
 		row := component.TableRow{}
@@ -40,7 +41,7 @@ func RoleListHandler(ctx context.Context, roleList *rbacv1.RoleList, options Opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // RoleHandler is a printFunc that prints roles
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// scaleAction creates an action which scales an object using its scale subresource.
+func scaleAction(object runtime.Object, replicas *int32) (component.Action, error) {
+	// unset replicas default to one, as they do for the built in kinds which can be scaled.
+	current := int32(1)
+	if replicas != nil {
+		current = *replicas
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionScale, object,
+		component.NewFormFieldNumber("Replicas", "replicas", fmt.Sprintf("%d", current)),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	kind := object.GetObjectKind().GroupVersionKind().Kind
+
+	return component.Action{
+		Name:  "Scale",
+		Title: fmt.Sprintf("Scale %s", kind),
+		Form:  form,
+	}, nil
+}
+
+// customResourceReplicas returns the replicas of a custom resource. The path is
+// the spec replicas path from its CRD's scale subresource, e.g. .spec.replicas.
+// It returns nil if the custom resource does not set replicas.
+func customResourceReplicas(cr *unstructured.Unstructured, path string) *int32 {
+	fields := strings.Split(strings.TrimPrefix(path, "."), ".")
+	value, found, err := unstructured.NestedFieldNoCopy(cr.Object, fields...)
+	if err != nil || !found {
+		return nil
+	}
+
+	var replicas int32
+	switch v := value.(type) {
+	case int64:
+		replicas = int32(v)
+	case float64:
+		replicas = int32(v)
+	default:
+		return nil
+	}
+
+	return &replicas
+}
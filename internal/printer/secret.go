@@ -8,25 +8,41 @@ package printer
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 var (
-	secretTableCols = component.NewTableCols("Name", "Labels", "Type", "Data", "Age")
-	secretDataCols  = component.NewTableCols("Key")
+	// secretTableCols does not include the Type and Data columns because secret
+	// lists only contain metadata. See SecretListHandler.
+	secretTableCols = component.NewTableCols("Name", "Labels", "Age")
+	secretDataCols  = component.NewTableCols("Key", "Size", "Value")
 )
 
-// SecretListHandler is a printFunc that lists secrets.
+// secretHiddenValue is shown in place of secret values which are not revealed.
+const secretHiddenValue = "••••••••"
+
+// SecretListHandler is a printFunc that lists secrets. Secret lists only contain
+// metadata, so the list no longer has Type and Data columns. The type and data of
+// secrets are shown on their own pages.
 func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Options) (component.Component, error) {
 	if list == nil {
 		return nil, errors.New("list of secrets is nil")
 	}
 
 	ot := NewObjectTable("Secrets", "We couldn't find any secrets!", secretTableCols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
+	ot.SetCertificateExpiryWindow(options.certificateExpiryWindow())
 
 	for _, secret := range list.Items {
 		row := component.TableRow{}
@@ -38,8 +54,6 @@ func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Opt
 		row["Name"] = nameLink
 
 		row["Labels"] = component.NewLabels(secret.ObjectMeta.Labels)
-		row["Type"] = component.NewText(string(secret.Type))
-		row["Data"] = component.NewText(fmt.Sprintf("%d", len(secret.Data)))
 		row["Age"] = component.NewTimestamp(secret.ObjectMeta.CreationTimestamp.Time)
 
 		if err := ot.AddRowForObject(ctx, &secret, row); err != nil {
@@ -47,7 +61,7 @@ func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // SecretHandler is a printFunc for printing a secret summary.
@@ -63,10 +77,22 @@ func SecretHandler(ctx context.Context, secret *corev1.Secret, options Options)
 		return nil, errors.Wrap(err, "print secret configuration")
 	}
 
-	if err := sh.Data(options); err != nil {
+	if err := sh.Data(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print secret data")
 	}
 
+	if err := sh.Certificates(options); err != nil {
+		return nil, errors.Wrap(err, "print secret certificates")
+	}
+
+	if err := sh.Registries(options); err != nil {
+		return nil, errors.Wrap(err, "print secret registries")
+	}
+
+	o.AddButton("Add Key",
+		action.CreatePayload(octant.ActionSecretDataUpdate, secretKey(secret).ToActionPayload()),
+		component.WithButtonForm(secretDataForm(secret, "", "")))
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -97,36 +123,171 @@ func (s *SecretConfiguration) Create(options Options) (*component.Summary, error
 	})
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	if alert, ok := secretCertificatesAlert(secret, time.Now(), options.certificateExpiryWindow()); ok {
+		summary.SetAlert(alert)
+	}
+
 	return summary, nil
 }
 
-func describeSecretData(secret corev1.Secret) (*component.Table, error) {
+// describeSecretData describes the keys of a secret. Values are decoded and
+// shown if they are revealed in the session.
+func describeSecretData(secret corev1.Secret, session octant.Session, reveals *octant.SecretReveals) (*component.Table, error) {
 	table := component.NewTable("Data", "This secret has no data!", secretDataCols)
 
-	for key := range secret.Data {
+	key := secretKey(&secret)
+
+	var dataKeys []string
+	for dataKey := range secret.Data {
+		dataKeys = append(dataKeys, dataKey)
+	}
+	sort.Strings(dataKeys)
+
+	for _, dataKey := range dataKeys {
+		value := secret.Data[dataKey]
+		revealed := reveals != nil && reveals.IsRevealed(session, key, dataKey)
+
 		row := component.TableRow{}
-		row["Key"] = component.NewText(key)
+		row["Key"] = component.NewText(dataKey)
+		row["Size"] = component.NewText(fmt.Sprintf("%d bytes", len(value)))
+
+		payload := key.ToActionPayload()
+		payload["secretKey"] = dataKey
+		payload["reveal"] = !revealed
+
+		currentValue := ""
+		if revealed {
+			row["Value"] = secretValueComponent(value)
+			row.AddAction(component.GridAction{
+				Name:       "Hide",
+				ActionPath: octant.ActionSecretReveal,
+				Payload:    payload,
+			})
+			if utf8.Valid(value) {
+				currentValue = string(value)
+			}
+		} else {
+			row["Value"] = component.NewText(secretHiddenValue)
+			row.AddAction(component.GridAction{
+				Name:       "Reveal",
+				ActionPath: octant.ActionSecretReveal,
+				Payload:    payload,
+			})
+		}
+
+		// the edit form only contains the current value if it is revealed.
+		form := secretDataForm(&secret, dataKey, currentValue)
+		row.AddAction(component.GridAction{
+			Name:       "Edit",
+			ActionPath: octant.ActionSecretDataUpdate,
+			Payload:    key.ToActionPayload(),
+			Form:       &form,
+		})
+
+		deletePayload := key.ToActionPayload()
+		deletePayload["secretKey"] = dataKey
+		row.AddAction(component.GridAction{
+			Name:       "Delete",
+			ActionPath: octant.ActionSecretDataDelete,
+			Payload:    deletePayload,
+			Confirmation: &component.Confirmation{
+				Title: "Delete Key",
+				Body:  fmt.Sprintf("Are you sure you want to delete **%s** from secret **%s**?", dataKey, secret.Name),
+			},
+			Type: component.GridActionDanger,
+		})
 
 		table.Add(row)
 	}
 
-	table.Sort("Key", false)
-
 	return table, nil
 }
 
+// secretValueComponent shows a decoded secret value.
+func secretValueComponent(value []byte) component.Component {
+	if !utf8.Valid(value) {
+		return component.NewText(fmt.Sprintf("<binary data, %d bytes>", len(value)))
+	}
+
+	s := string(value)
+	if strings.Contains(s, "\n") {
+		return component.NewCodeBlock(s)
+	}
+	return component.NewText(s)
+}
+
+// secretDataForm creates a form which sets a secret value. The key can be
+// entered if dataKey is blank.
+func secretDataForm(secret *corev1.Secret, dataKey, value string) component.Form {
+	var fields []component.FormField
+	if dataKey == "" {
+		fields = append(fields, component.NewFormFieldText("Key", "secretKey", ""))
+	} else {
+		fields = append(fields, component.NewFormFieldHidden("secretKey", dataKey))
+	}
+
+	fields = append(fields,
+		component.NewFormFieldTextarea("Value", "value", value),
+		component.NewFormFieldSelect("Encoding", "encoding", []component.InputChoice{
+			{Label: "Text (encoded by Octant)", Value: octant.SecretEncodingText, Checked: true},
+			{Label: "Base64", Value: octant.SecretEncodingBase64},
+		}, false),
+	)
+
+	fields = append(fields, keyFormFields(secretKey(secret))...)
+	fields = append(fields, component.NewFormFieldHidden("action", octant.ActionSecretDataUpdate))
+
+	return component.Form{Fields: fields}
+}
+
+// keyFormFields returns hidden form fields for an object's store key, sorted so
+// forms are rendered consistently.
+func keyFormFields(key store.Key) []component.FormField {
+	payload := key.ToActionPayload()
+
+	var names []string
+	for name := range payload {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var fields []component.FormField
+	for _, name := range names {
+		fields = append(fields, component.NewFormFieldHidden(name, fmt.Sprintf("%v", payload[name])))
+	}
+	return fields
+}
+
+// secretKey returns the store key of a secret. It does not depend on the
+// secret's type meta, which is not set on every secret.
+func secretKey(secret *corev1.Secret) store.Key {
+	return store.Key{
+		Namespace:  secret.Namespace,
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Name:       secret.Name,
+	}
+}
+
 type secretObject interface {
 	Config(options Options) error
-	Data(options Options) error
+	Data(ctx context.Context, options Options) error
+	Certificates(options Options) error
+	Registries(options Options) error
 }
 
 type secretHandler struct {
-	secret     *corev1.Secret
-	configFunc func(*corev1.Secret, Options) (*component.Summary, error)
-	dataFunc   func(*corev1.Secret, Options) (*component.Table, error)
-	object     *Object
+	secret           *corev1.Secret
+	configFunc       func(*corev1.Secret, Options) (*component.Summary, error)
+	dataFunc         func(context.Context, *corev1.Secret, Options) (*component.Table, error)
+	certificatesFunc func(*corev1.Secret, Options) (*component.Table, error)
+	registriesFunc   func(*corev1.Secret, Options) (*component.Table, error)
+	object           *Object
 }
 
+var _ secretObject = (*secretHandler)(nil)
+
 func newSecretHandler(secret *corev1.Secret, object *Object) (*secretHandler, error) {
 	if secret == nil {
 		return nil, errors.New("can't print a nil secret")
@@ -137,10 +298,12 @@ func newSecretHandler(secret *corev1.Secret, object *Object) (*secretHandler, er
 	}
 
 	sh := &secretHandler{
-		secret:     secret,
-		configFunc: defaultSecretConfig,
-		dataFunc:   defaultSecretData,
-		object:     object,
+		secret:           secret,
+		configFunc:       defaultSecretConfig,
+		dataFunc:         defaultSecretData,
+		certificatesFunc: defaultSecretCertificates,
+		registriesFunc:   defaultSecretRegistries,
+		object:           object,
 	}
 
 	return sh, nil
@@ -159,7 +322,7 @@ func defaultSecretConfig(secret *corev1.Secret, options Options) (*component.Sum
 	return NewSecretConfiguration(secret).Create(options)
 }
 
-func (s *secretHandler) Data(options Options) error {
+func (s *secretHandler) Data(ctx context.Context, options Options) error {
 	if s.secret == nil {
 		return errors.New("can't display data for nil secret")
 	}
@@ -167,12 +330,59 @@ func (s *secretHandler) Data(options Options) error {
 	s.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthFull,
 		Func: func() (component.Component, error) {
-			return s.dataFunc(s.secret, options)
+			return s.dataFunc(ctx, s.secret, options)
+		},
+	})
+	return nil
+}
+
+func defaultSecretData(ctx context.Context, secret *corev1.Secret, options Options) (*component.Table, error) {
+	session := octant.NewSession(ctx, options.DashConfig.ContextName())
+	return describeSecretData(*secret, session, options.SecretReveals)
+}
+
+// Certificates shows the certificates of TLS secrets.
+func (s *secretHandler) Certificates(options Options) error {
+	if s.secret == nil {
+		return errors.New("can't display certificates for nil secret")
+	}
+
+	if s.secret.Type != corev1.SecretTypeTLS {
+		return nil
+	}
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.certificatesFunc(s.secret, options)
+		},
+	})
+	return nil
+}
+
+func defaultSecretCertificates(secret *corev1.Secret, options Options) (*component.Table, error) {
+	return describeSecretCertificates(secret, time.Now(), options.certificateExpiryWindow())
+}
+
+// Registries shows the registries of docker config secrets.
+func (s *secretHandler) Registries(options Options) error {
+	if s.secret == nil {
+		return errors.New("can't display registries for nil secret")
+	}
+
+	if s.secret.Type != corev1.SecretTypeDockerConfigJson && s.secret.Type != corev1.SecretTypeDockercfg {
+		return nil
+	}
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.registriesFunc(s.secret, options)
 		},
 	})
 	return nil
 }
 
-func defaultSecretData(secret *corev1.Secret, options Options) (*component.Table, error) {
-	return describeSecretData(*secret)
+func defaultSecretRegistries(secret *corev1.Secret, options Options) (*component.Table, error) {
+	return describeSecretRegistries(secret)
 }
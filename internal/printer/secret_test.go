@@ -8,12 +8,16 @@ package printer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/octant/internal/certificates"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -46,10 +50,6 @@ func Test_SecretListHandler(t *testing.T) {
 					},
 					Labels: labels,
 				},
-				Data: map[string][]byte{
-					"key": []byte("value"),
-				},
-				Type: corev1.SecretTypeOpaque,
 			},
 		},
 	}
@@ -67,8 +67,6 @@ func Test_SecretListHandler(t *testing.T) {
 				"v1 Secret is OK",
 			})),
 		"Labels": component.NewLabels(labels),
-		"Type":   component.NewText("Opaque"),
-		"Data":   component.NewText("1"),
 		"Age":    component.NewTimestamp(now),
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
 			buildObjectDeleteAction(t, &object.Items[0]),
@@ -127,19 +125,129 @@ func Test_SecretConfig(t *testing.T) {
 func Test_describeSecretData(t *testing.T) {
 	secret := testutil.CreateSecret("secret")
 	secret.Data = map[string][]byte{
-		"foo": {0, 1, 2, 3},
-		"bar": {0, 1, 2, 3},
+		"foo": {0xff, 1, 2, 3},
+		"bar": []byte("hello"),
+	}
+
+	reveals := octant.NewSecretReveals()
+	session := octant.Session{ClientID: "client", ContextName: "cluster"}
+
+	got, err := describeSecretData(*secret, session, reveals)
+	require.NoError(t, err)
+
+	rows := got.Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, component.NewText("bar"), rows[0]["Key"])
+	assert.Equal(t, component.NewText("5 bytes"), rows[0]["Size"])
+	assert.Equal(t, component.NewText(secretHiddenValue), rows[0]["Value"])
+	assert.Equal(t, []string{"Reveal", "Edit", "Delete"}, gridActionNames(rows[0]))
+
+	reveals.Reveal(session, secretKey(secret), "bar")
+	reveals.Reveal(session, secretKey(secret), "foo")
+
+	got, err = describeSecretData(*secret, octant.Session{ClientID: "other", ContextName: "cluster"}, reveals)
+	require.NoError(t, err)
+	assert.Equal(t, component.NewText(secretHiddenValue), got.Rows()[0]["Value"])
+
+	got, err = describeSecretData(*secret, session, reveals)
+	require.NoError(t, err)
+
+	rows = got.Rows()
+	assert.Equal(t, component.NewText("hello"), rows[0]["Value"])
+	assert.Equal(t, []string{"Hide", "Edit", "Delete"}, gridActionNames(rows[0]))
+	assert.Equal(t, component.NewText("<binary data, 4 bytes>"), rows[1]["Value"])
+
+	hide := rows[0][component.GridActionKey].(*component.GridActions).Config.Actions[0]
+	assert.Equal(t, false, hide.Payload["reveal"])
+	assert.Equal(t, "bar", hide.Payload["secretKey"])
+}
+
+func Test_secretDataForm(t *testing.T) {
+	secret := testutil.CreateSecret("secret")
+
+	got := secretDataForm(secret, "foo", "bar")
+
+	var names []string
+	for _, field := range got.Fields {
+		names = append(names, field.Name())
+	}
+
+	expected := []string{"secretKey", "value", "encoding", "apiVersion", "kind", "name", "namespace", "action"}
+	assert.Equal(t, expected, names)
+}
+
+func gridActionNames(row component.TableRow) []string {
+	var names []string
+	for _, gridAction := range row[component.GridActionKey].(*component.GridActions).Config.Actions {
+		names = append(names, gridAction.Name)
+	}
+	return names
+}
+
+func Test_describeSecretCertificates(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	leaf, ca := testutil.CreateCertificateChain(t, "example.com", now.AddDate(0, 0, 10))
+
+	secret := testutil.CreateSecret("secret")
+	secret.Type = corev1.SecretTypeTLS
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey: append(leaf, ca...),
 	}
 
-	got, err := describeSecretData(*secret)
+	got, err := describeSecretCertificates(secret, now, certificates.DefaultExpiryWindow)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Key")
-	expected := component.NewTable("Data", "This secret has no data!", cols)
-	expected.Add([]component.TableRow{
-		{"Key": component.NewText("bar")},
-		{"Key": component.NewText("foo")},
-	}...)
+	status := component.NewText("Expiring: expires in 10 days")
+	status.SetStatus(component.TextStatusWarning)
+
+	rows := got.Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, component.TableRow{
+		"Subject":                   component.NewText("CN=example.com"),
+		"Issuer":                    component.NewText("CN=Test CA"),
+		"Subject Alternative Names": component.NewText("example.com, www.example.com, 10.0.0.1"),
+		"Not After":                 component.NewText("2020-06-11T00:00:00Z"),
+		"Status":                    status,
+	}, rows[0])
+	assert.Equal(t, component.NewText("<none>"), rows[1]["Subject Alternative Names"])
+
+	alert, ok := secretCertificatesAlert(secret, now, certificates.DefaultExpiryWindow)
+	require.True(t, ok)
+	assert.Equal(t, component.NewAlert(component.AlertTypeWarning, "Certificate CN=example.com expires in 10 days"), alert)
+
+	_, ok = secretCertificatesAlert(secret, now.AddDate(0, 0, -60), certificates.DefaultExpiryWindow)
+	require.False(t, ok)
+
+	_, ok = secretCertificatesAlert(secret, now.AddDate(0, 0, -60), 90*24*time.Hour)
+	require.True(t, ok)
+}
+
+func Test_describeSecretRegistries(t *testing.T) {
+	secret := testutil.CreateSecret("secret")
+	secret.Type = corev1.SecretTypeDockerConfigJson
+	secret.Data = map[string][]byte{
+		corev1.DockerConfigJsonKey: []byte(`{"auths": {
+  "registry.example.com": {"username": "user", "password": "secret", "email": "user@example.com"},
+  "https://index.docker.io/v1/": {"auth": "ZG9ja2VyOnNlY3JldA=="}
+}}`),
+	}
+
+	got, err := describeSecretRegistries(secret)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Registries", "This secret has no registries!", secretRegistryCols)
+	expected.Add(
+		component.TableRow{
+			"Registry": component.NewText("https://index.docker.io/v1/"),
+			"Username": component.NewText("docker"),
+			"Email":    component.NewText(""),
+		},
+		component.TableRow{
+			"Registry": component.NewText("registry.example.com"),
+			"Username": component.NewText("user"),
+			"Email":    component.NewText("user@example.com"),
+		},
+	)
 
 	component.AssertEqual(t, expected, got)
 }
@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/certificates"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	secretCertificateCols = component.NewTableCols("Subject", "Issuer", "Subject Alternative Names", "Not After", "Status")
+	secretRegistryCols    = component.NewTableCols("Registry", "Username", "Email")
+)
+
+// describeSecretCertificates describes the certificate chain of a TLS secret.
+func describeSecretCertificates(secret *corev1.Secret, now time.Time, window time.Duration) (*component.Table, error) {
+	table := component.NewTable("Certificates", "This secret has no certificates!", secretCertificateCols)
+
+	data, ok := secret.Data[corev1.TLSCertKey]
+	if !ok {
+		return table, nil
+	}
+
+	list, err := certificates.ParsePEM(data)
+	if err != nil {
+		table.SetPlaceholder(fmt.Sprintf("Unable to parse %s: %s", corev1.TLSCertKey, err))
+		return table, nil
+	}
+
+	for _, cert := range list {
+		sans := "<none>"
+		if names := cert.SubjectAlternativeNames(); len(names) > 0 {
+			sans = strings.Join(names, ", ")
+		}
+
+		certStatus := cert.Status(now, window)
+		status := component.NewText(fmt.Sprintf("%s: %s", certStatus, cert.Message(now)))
+		status.SetStatus(certificateTextStatus(certStatus))
+
+		table.Add(component.TableRow{
+			"Subject":                   component.NewText(cert.Subject),
+			"Issuer":                    component.NewText(cert.Issuer),
+			"Subject Alternative Names": component.NewText(sans),
+			"Not After":                 component.NewText(cert.NotAfter.UTC().Format(time.RFC3339)),
+			"Status":                    status,
+		})
+	}
+
+	return table, nil
+}
+
+func certificateTextStatus(status certificates.Status) component.TextStatus {
+	switch status {
+	case certificates.StatusValid:
+		return component.TextStatusOK
+	case certificates.StatusExpiring:
+		return component.TextStatusWarning
+	default:
+		return component.TextStatusError
+	}
+}
+
+// secretCertificatesAlert returns an alert if a certificate of a TLS secret is
+// expired, expiring, or not valid yet.
+func secretCertificatesAlert(secret *corev1.Secret, now time.Time, window time.Duration) (component.Alert, bool) {
+	if secret.Type != corev1.SecretTypeTLS {
+		return component.Alert{}, false
+	}
+
+	list, err := certificates.ParsePEM(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return component.Alert{}, false
+	}
+
+	for _, cert := range list {
+		switch cert.Status(now, window) {
+		case certificates.StatusValid:
+			continue
+		case certificates.StatusExpiring:
+			return component.NewAlert(component.AlertTypeWarning,
+				fmt.Sprintf("Certificate %s %s", cert.Subject, cert.Message(now))), true
+		default:
+			return component.NewAlert(component.AlertTypeError,
+				fmt.Sprintf("Certificate %s %s", cert.Subject, cert.Message(now))), true
+		}
+	}
+
+	return component.Alert{}, false
+}
+
+// dockerConfigEntry is a registry's credentials in a docker config. The
+// password is not read.
+type dockerConfigEntry struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Auth     string `json:"auth"`
+}
+
+// describeSecretRegistries describes the registries of a docker config
+// secret. Passwords are not shown.
+func describeSecretRegistries(secret *corev1.Secret) (*component.Table, error) {
+	table := component.NewTable("Registries", "This secret has no registries!", secretRegistryCols)
+
+	entries, err := secretDockerConfig(secret)
+	if err != nil {
+		table.SetPlaceholder(fmt.Sprintf("Unable to parse docker config: %s", err))
+		return table, nil
+	}
+
+	var registries []string
+	for registry := range entries {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	for _, registry := range registries {
+		entry := entries[registry]
+
+		username := entry.Username
+		if username == "" && entry.Auth != "" {
+			if auth, err := base64.StdEncoding.DecodeString(entry.Auth); err == nil {
+				username = strings.SplitN(string(auth), ":", 2)[0]
+			}
+		}
+
+		table.Add(component.TableRow{
+			"Registry": component.NewText(registry),
+			"Username": component.NewText(username),
+			"Email":    component.NewText(entry.Email),
+		})
+	}
+
+	return table, nil
+}
+
+func secretDockerConfig(secret *corev1.Secret) (map[string]dockerConfigEntry, error) {
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		var config struct {
+			Auths map[string]dockerConfigEntry `json:"auths"`
+		}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return nil, err
+		}
+		return config.Auths, nil
+	case corev1.SecretTypeDockercfg:
+		entries := map[string]dockerConfigEntry{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &entries); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	default:
+		return nil, fmt.Errorf("secret type %s is not a docker config", secret.Type)
+	}
+}
@@ -30,6 +30,7 @@ func ServiceListHandler(ctx context.Context, list *corev1.ServiceList, options O
 
 	cols := component.NewTableCols("Name", "Labels", "Type", "Cluster IP", "External IP", "Ports", "Age", "Selector")
 	ot := NewObjectTable("Services", "We couldn't find any services!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, s := range list.Items {
 		row := component.TableRow{}
@@ -54,7 +55,7 @@ func ServiceListHandler(ctx context.Context, list *corev1.ServiceList, options O
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
 	}
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ServiceHandler is a printFunc that prints a Services.
@@ -29,6 +29,7 @@ func ServiceAccountListHandler(ctx context.Context, list *corev1.ServiceAccountL
 	cols := component.NewTableCols("Name", "Labels", "Secrets", "Age")
 	ot := NewObjectTable("Service Accounts",
 		"We couldn't find any service accounts!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, serviceAccount := range list.Items {
 		row := component.TableRow{}
@@ -47,7 +48,7 @@ func ServiceAccountListHandler(ctx context.Context, list *corev1.ServiceAccountL
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 type serviceAccountObject interface {
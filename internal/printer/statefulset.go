@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -27,6 +28,7 @@ func StatefulSetListHandler(ctx context.Context, list *appsv1.StatefulSetList, o
 
 	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Age", "Selector")
 	ot := NewObjectTable("StatefulSets", "We couldn't find any stateful sets!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
 
 	for _, statefulSet := range list.Items {
 		row := component.TableRow{}
@@ -54,7 +56,7 @@ func StatefulSetListHandler(ctx context.Context, list *appsv1.StatefulSetList, o
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // StatefulSetHandler is a printFunc that prints a StatefulSet
@@ -106,6 +108,10 @@ func (sc *StatefulSetConfiguration) Create(options Options) (*component.Summary,
 
 	sections.AddText("Update Strategy", string(statefulSet.Spec.UpdateStrategy.Type))
 
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		sections.AddText("Partition", fmt.Sprintf("%d", *rollingUpdate.Partition))
+	}
+
 	if selector := statefulSet.Spec.Selector; selector != nil {
 		var selectors []component.Selector
 
@@ -141,9 +147,46 @@ func (sc *StatefulSetConfiguration) Create(options Options) (*component.Summary,
 	sections.AddText("Pod Management Policy", string(statefulSet.Spec.PodManagementPolicy))
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	scale, err := scaleAction(statefulSet, statefulSet.Spec.Replicas)
+	if err != nil {
+		return nil, err
+	}
+	summary.AddAction(scale)
+
+	if strategy := statefulSet.Spec.UpdateStrategy.Type; strategy == "" || strategy == appsv1.RollingUpdateStatefulSetStrategyType {
+		partition, err := statefulSetPartitionAction(statefulSet)
+		if err != nil {
+			return nil, err
+		}
+		summary.AddAction(partition)
+	}
+
 	return summary, nil
 }
 
+// statefulSetPartitionAction creates an action which sets the partition of a
+// StatefulSet's rolling update.
+func statefulSetPartitionAction(statefulSet *appsv1.StatefulSet) (component.Action, error) {
+	var partition int32
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		partition = *rollingUpdate.Partition
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionStatefulSetPartition, statefulSet,
+		component.NewFormFieldNumber("Partition (pods with a lower ordinal are not updated)", "partition", fmt.Sprintf("%d", partition)),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Partition",
+		Title: "Set Rolling Update Partition",
+		Form:  form,
+	}, nil
+}
+
 // StatefulSetStatus generates a statefulset status
 type StatefulSetStatus struct {
 	context     context.Context
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -169,6 +170,7 @@ func Test_StatefulSetStatus(t *testing.T) {
 
 func Test_StatefulSetConfiguration(t *testing.T) {
 	now := testutil.Time()
+	var partition int32 = 1
 	validStatefulSet := &appsv1.StatefulSet{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "apps/v1",
@@ -205,6 +207,9 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 			},
 			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
 				Type: appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+					Partition: &partition,
+				},
 			},
 			PodManagementPolicy: appsv1.OrderedReadyPodManagement,
 		},
@@ -219,11 +224,15 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 		{
 			name:        "default",
 			statefulSet: validStatefulSet,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
+			expected: summaryWithActions(component.NewSummary("Configuration", []component.SummarySection{
 				{
 					Header:  "Update Strategy",
 					Content: component.NewText("RollingUpdate"),
 				},
+				{
+					Header:  "Partition",
+					Content: component.NewText("1"),
+				},
 				{
 					Header:  "Selectors",
 					Content: component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
@@ -237,6 +246,19 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 					Content: component.NewText("OrderedReady"),
 				},
 			}...),
+				buildScaleAction(t, validStatefulSet, validStatefulSet.Spec.Replicas),
+				component.Action{
+					Name:  "Partition",
+					Title: "Set Rolling Update Partition",
+					Form: component.Form{Fields: []component.FormField{
+						component.NewFormFieldNumber("Partition (pods with a lower ordinal are not updated)", "partition", "1"),
+						component.NewFormFieldHidden("apiVersion", "apps/v1"),
+						component.NewFormFieldHidden("kind", "StatefulSet"),
+						component.NewFormFieldHidden("name", "web"),
+						component.NewFormFieldHidden("namespace", ""),
+						component.NewFormFieldHidden("action", octant.ActionStatefulSetPartition),
+					}},
+				}),
 		},
 		{
 			name:        "statefulset is nil",
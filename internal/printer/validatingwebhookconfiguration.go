@@ -24,6 +24,8 @@ func ValidatingWebhookConfigurationListHandler(ctx context.Context, list *admiss
 
 	cols := component.NewTableCols("Name", "Age")
 	ot := NewObjectTable("Validating Webhook Configurations", "We couldn't find any validating webhook configurations!", cols, options.DashConfig.ObjectStore())
+	ot.EnablePlugins(options.DashConfig.PluginManager())
+	ot.SetCertificateExpiryWindow(options.certificateExpiryWindow())
 
 	for _, validatingWebhookConfiguration := range list.Items {
 		row := component.TableRow{}
@@ -41,7 +43,7 @@ func ValidatingWebhookConfigurationListHandler(ctx context.Context, list *admiss
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ValidatingWebhookConfigurationHandler is a printFunc that prints a validating webhook configurations
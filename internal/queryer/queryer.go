@@ -262,15 +262,15 @@ func (osq *ObjectStoreQueryer) Children(ctx context.Context, owner *unstructured
 					return err
 				}
 				defer sem.Release(1)
-				objects, _, err := osq.objectStore.List(ctx, key)
+				objects, err := store.ListMatching(ctx, osq.objectStore, key, func(object metav1.Object) bool {
+					return metav1.IsControlledBy(object, owner)
+				})
 				if err != nil {
 					return errors.Wrapf(err, "unable to retrieve %+v", key)
 				}
 
 				for i := range objects.Items {
-					if metav1.IsControlledBy(&objects.Items[i], owner) {
-						ch <- &objects.Items[i]
-					}
+					ch <- &objects.Items[i]
 				}
 
 				return nil
@@ -330,6 +330,8 @@ func (osq *ObjectStoreQueryer) Events(ctx context.Context, object metav1.Object)
 
 	u := &unstructured.Unstructured{Object: m}
 
+	// Events are listed for the whole namespace and filtered below. A field selector
+	// for the involved object would create a store key for every object.
 	key := store.Key{
 		Namespace:  u.GetNamespace(),
 		APIVersion: "v1",
@@ -875,7 +877,10 @@ func (osq *ObjectStoreQueryer) SecretsForPod(ctx context.Context, pod *corev1.Po
 		APIVersion: "v1",
 		Kind:       "Secret",
 	}
-	ul, _, err := osq.objectStore.List(ctx, key)
+	secretNames := podSecretNames(pod)
+	ul, err := store.ListMatching(ctx, osq.objectStore, key, func(object metav1.Object) bool {
+		return secretNames[object.GetName()]
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "retrieving secrets")
 	}
@@ -918,6 +923,34 @@ func (osq *ObjectStoreQueryer) SecretsForPod(ctx context.Context, pod *corev1.Po
 	return secrets, nil
 }
 
+// podSecretNames returns the names of secrets a pod references in volumes and
+// container environments.
+func podSecretNames(pod *corev1.Pod) map[string]bool {
+	names := make(map[string]bool)
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Secret != nil {
+			names[volume.Secret.SecretName] = true
+		}
+	}
+
+	for _, container := range pod.Spec.Containers {
+		for _, e := range container.Env {
+			if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil {
+				names[e.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+
+		for _, e := range container.EnvFrom {
+			if e.SecretRef != nil {
+				names[e.SecretRef.Name] = true
+			}
+		}
+	}
+
+	return names
+}
+
 func (osq *ObjectStoreQueryer) getSelector(object runtime.Object) (*metav1.LabelSelector, error) {
 	switch t := object.(type) {
 	case *appsv1.DaemonSet:
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -38,6 +39,14 @@ func SetHandlerObjectStatus(objectStatus ObjectStatus) HandlerOption {
 	}
 }
 
+// WithCertificateExpiryWindow configures how long before a certificate expires
+// the handler's default object status shows it as expiring.
+func WithCertificateExpiryWindow(window time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.certificateExpiryWindow = window
+	}
+}
+
 type nodesStorage map[types.UID]*unstructured.Unstructured
 
 type adjListStorage map[string]map[string]*unstructured.Unstructured
@@ -93,6 +102,8 @@ type Handler struct {
 
 	mu           sync.Mutex
 	objectStatus ObjectStatus
+
+	certificateExpiryWindow time.Duration
 }
 
 var _ objectvisitor.ObjectHandler = (*Handler)(nil)
@@ -110,13 +121,18 @@ func NewHandler(dashConfig config.Dash, options ...HandlerOption) (*Handler, err
 		pluginPrinter: dashConfig.PluginManager(),
 		adjList:       adjListStorage{},
 		nodes:         nodesStorage{},
-		objectStatus:  NewHandlerObjectStatus(dashConfig.ObjectStore(), dashConfig.PluginManager()),
 	}
 
 	for _, option := range options {
 		option(h)
 	}
 
+	if h.objectStatus == nil {
+		objectStatus := NewHandlerObjectStatus(dashConfig.ObjectStore(), dashConfig.PluginManager())
+		objectStatus.certificateExpiryWindow = h.certificateExpiryWindow
+		h.objectStatus = objectStatus
+	}
+
 	return h, nil
 }
 
@@ -388,6 +404,8 @@ type ObjectStatus interface {
 type HandlerObjectStatus struct {
 	objectStore   store.Store
 	pluginManager plugin.ManagerInterface
+
+	certificateExpiryWindow time.Duration
 }
 
 var _ ObjectStatus = (*HandlerObjectStatus)(nil)
@@ -400,7 +418,8 @@ func NewHandlerObjectStatus(objectStore store.Store, pluginManager plugin.Manage
 }
 
 func (h *HandlerObjectStatus) Status(ctx context.Context, object runtime.Object) (*objectstatus.ObjectStatus, error) {
-	status, err := objectstatus.Status(ctx, object, h.objectStore)
+	status, err := objectstatus.Status(ctx, object, h.objectStore,
+		objectstatus.WithCertificateExpiryWindow(h.certificateExpiryWindow))
 	if err != nil {
 		return nil, err
 	}
@@ -49,14 +49,15 @@ type ResourceViewer struct {
 	visitor    objectvisitor.Visitor
 }
 
-// Create creates a resource viewer given a list objects.
-func Create(ctx context.Context, dashConfig config.Dash, q queryer.Queryer, objects ...*unstructured.Unstructured) (*component.ResourceViewer, error) {
+// Create creates a resource viewer given a list objects. Its handler is
+// configured with handlerOptions.
+func Create(ctx context.Context, dashConfig config.Dash, q queryer.Queryer, objects []*unstructured.Unstructured, handlerOptions ...HandlerOption) (*component.ResourceViewer, error) {
 	rv, err := New(dashConfig, WithDefaultQueryer(dashConfig, q))
 	if err != nil {
 		return nil, fmt.Errorf("create resource viewer: %w", err)
 	}
 
-	handler, err := NewHandler(dashConfig)
+	handler, err := NewHandler(dashConfig, handlerOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("create resource viewer handler: %w", err)
 	}
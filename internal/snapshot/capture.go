@@ -0,0 +1,503 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// ManifestName is the name of the manifest file in a captured snapshot.
+	ManifestName = "manifest.json"
+
+	// ManifestVersion is the version of the snapshot format written by Capture.
+	ManifestVersion = "v1"
+
+	// RedactedAnnotation is set on Secrets whose data was redacted by Capture.
+	RedactedAnnotation = "octant.dev/redacted"
+
+	// DefaultLogTailLines is the number of log lines captured for each container.
+	DefaultLogTailLines = 500
+
+	capturePageSize = 500
+
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Manifest describes how a snapshot was captured.
+type Manifest struct {
+	Version           string             `json:"version"`
+	OctantVersion     string             `json:"octantVersion,omitempty"`
+	KubernetesVersion string             `json:"kubernetesVersion,omitempty"`
+	Context           string             `json:"context,omitempty"`
+	CapturedAt        time.Time          `json:"capturedAt"`
+	Namespaces        []string           `json:"namespaces,omitempty"`
+	SecretsRedacted   bool               `json:"secretsRedacted"`
+	Logs              bool               `json:"logs"`
+	Resources         []ManifestResource `json:"resources,omitempty"`
+	Errors            []string           `json:"errors,omitempty"`
+}
+
+// ManifestResource is the number of objects captured for a resource.
+type ManifestResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Resource   string `json:"resource"`
+	Count      int    `json:"count"`
+}
+
+// CaptureOptions are options for Capture.
+type CaptureOptions struct {
+	// Namespaces limits the capture to these namespaces. Cluster scoped objects,
+	// apart from the namespaces themselves, are only captured when it is empty.
+	Namespaces []string
+	// IncludeLogs captures recent logs for the containers of captured pods.
+	IncludeLogs bool
+	// LogTailLines is the number of log lines captured for each container.
+	LogTailLines int64
+	// RevealSecrets captures Secret data. By default, it is redacted.
+	RevealSecrets bool
+	// OctantVersion is recorded in the manifest.
+	OctantVersion string
+	// Context is the name of the context recorded in the manifest.
+	Context string
+	// Now returns the capture time. It defaults to time.Now.
+	Now func() time.Time
+}
+
+// Capture writes every object the object store can list to w as a gzipped
+// tarball which can be loaded with Load. Objects are written as lists to
+// `<namespace>/<resource>.json`, or `<resource>.json` for cluster scoped
+// resources, container logs to `<namespace>/<pod>/<container>.log`, and a
+// Manifest to `manifest.json`. Resources which can't be listed are recorded in
+// the manifest's errors rather than failing the capture.
+func Capture(ctx context.Context, client cluster.ClientInterface, objectStore store.Store, w io.Writer, options CaptureOptions) (*Manifest, error) {
+	if client == nil {
+		return nil, fmt.Errorf("cluster client is nil")
+	}
+	if objectStore == nil {
+		return nil, fmt.Errorf("object store is nil")
+	}
+
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	if options.LogTailLines <= 0 {
+		options.LogTailLines = DefaultLogTailLines
+	}
+
+	discoveryClient, err := client.DiscoveryClient()
+	if err != nil {
+		return nil, fmt.Errorf("create discovery client: %w", err)
+	}
+
+	manifest := &Manifest{
+		Version:         ManifestVersion,
+		OctantVersion:   options.OctantVersion,
+		Context:         options.Context,
+		CapturedAt:      options.Now().UTC(),
+		Namespaces:      options.Namespaces,
+		SecretsRedacted: !options.RevealSecrets,
+		Logs:            options.IncludeLogs,
+	}
+
+	if serverVersion, err := discoveryClient.ServerVersion(); err == nil {
+		manifest.KubernetesVersion = serverVersion.String()
+	} else {
+		manifest.addError("get server version: %v", err)
+	}
+
+	resourceLists, err := discoveryClient.ServerPreferredResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, fmt.Errorf("discover resources: %w", err)
+		}
+		manifest.addError("discover resources: %v", err)
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	c := &capturer{
+		client:      client,
+		objectStore: objectStore,
+		tarWriter:   tarWriter,
+		manifest:    manifest,
+		options:     options,
+	}
+
+	for _, resource := range listableResources(resourceLists) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := c.captureResource(ctx, resource); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(manifest.Resources, func(i, j int) bool {
+		a, b := manifest.Resources[i], manifest.Resources[j]
+		if a.APIVersion != b.APIVersion {
+			return a.APIVersion < b.APIVersion
+		}
+		return a.Kind < b.Kind
+	})
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode manifest: %w", err)
+	}
+	if err := c.writeFile(ManifestName, data); err != nil {
+		return nil, err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, fmt.Errorf("close snapshot archive: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("close snapshot archive: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// CaptureFile captures a snapshot to a file. The file is removed if the capture fails.
+func CaptureFile(ctx context.Context, client cluster.ClientInterface, objectStore store.Store, path string, options CaptureOptions) (*Manifest, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("create snapshot file: %w", err)
+	}
+
+	manifest, err := Capture(ctx, client, objectStore, f, options)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("close snapshot file: %w", closeErr)
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// FileName returns the default file name for a snapshot of a context captured at a time.
+// Characters which are not safe in file names, e.g. those in EKS context ARNs, are
+// replaced with dashes.
+func FileName(contextName string, capturedAt time.Time) string {
+	safe := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '-'
+		}
+	}, contextName)
+	if safe == "" {
+		safe = "cluster"
+	}
+
+	return fmt.Sprintf("octant-snapshot-%s-%s.tar.gz", safe, capturedAt.UTC().Format("20060102T150405Z"))
+}
+
+func (m *Manifest) addError(format string, args ...interface{}) {
+	m.Errors = append(m.Errors, fmt.Sprintf(format, args...))
+}
+
+type capturer struct {
+	client      cluster.ClientInterface
+	objectStore store.Store
+	tarWriter   *tar.Writer
+	manifest    *Manifest
+	options     CaptureOptions
+}
+
+type captureResource struct {
+	groupVersionKind schema.GroupVersionKind
+	resource         schema.GroupResource
+	namespaced       bool
+}
+
+// fileName returns the name of the file objects of the resource are written to.
+// The group is included so resources with the same name in different groups,
+// e.g. events, do not overwrite each other.
+func (r captureResource) fileName() string {
+	if r.resource.Group == "" {
+		return r.resource.Resource + ".json"
+	}
+	return r.resource.Resource + "." + r.resource.Group + ".json"
+}
+
+func (c *capturer) captureResource(ctx context.Context, resource captureResource) error {
+	namespaces := []string{""}
+	switch {
+	case resource.namespaced && len(c.options.Namespaces) > 0:
+		namespaces = c.options.Namespaces
+	case !resource.namespaced && len(c.options.Namespaces) > 0 && resource.groupVersionKind != gvk.Namespace:
+		return nil
+	}
+
+	count := 0
+	for _, namespace := range namespaces {
+		objects, err := c.list(ctx, resource.groupVersionKind, namespace)
+		if err != nil {
+			c.manifest.addError("list %s: %v", resource.resource, err)
+			continue
+		}
+
+		if !resource.namespaced && resource.groupVersionKind == gvk.Namespace && len(c.options.Namespaces) > 0 {
+			objects = filterByName(objects, c.options.Namespaces)
+		}
+
+		byNamespace := make(map[string][]unstructured.Unstructured)
+		var names []string
+		for i := range objects {
+			object := c.prepare(&objects[i])
+
+			objectNamespace := object.GetNamespace()
+			if _, ok := byNamespace[objectNamespace]; !ok {
+				names = append(names, objectNamespace)
+			}
+			byNamespace[objectNamespace] = append(byNamespace[objectNamespace], *object)
+		}
+		sort.Strings(names)
+
+		for _, objectNamespace := range names {
+			items := byNamespace[objectNamespace]
+			if err := c.writeList(path.Join(objectNamespace, resource.fileName()), resource.groupVersionKind, items); err != nil {
+				return err
+			}
+			count += len(items)
+
+			if c.options.IncludeLogs && resource.groupVersionKind == gvk.Pod {
+				if err := c.captureLogs(ctx, items); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if count > 0 {
+		c.manifest.Resources = append(c.manifest.Resources, ManifestResource{
+			APIVersion: resource.groupVersionKind.GroupVersion().String(),
+			Kind:       resource.groupVersionKind.Kind,
+			Resource:   resource.resource.String(),
+			Count:      count,
+		})
+	}
+
+	return nil
+}
+
+// list lists objects a page at a time, so large resources are not cached by
+// the object store just to be captured.
+func (c *capturer) list(ctx context.Context, groupVersionKind schema.GroupVersionKind, namespace string) ([]unstructured.Unstructured, error) {
+	apiVersion, kind := groupVersionKind.ToAPIVersionAndKind()
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Limit:      capturePageSize,
+	}
+
+	var objects []unstructured.Unstructured
+	for {
+		list, _, err := c.objectStore.List(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, list.Items...)
+
+		key.Continue = list.GetContinue()
+		if key.Continue == "" {
+			return objects, nil
+		}
+	}
+}
+
+// prepare returns the object to write. Secrets are redacted unless they are
+// revealed. They are copied first, so objects cached by the store are not modified.
+func (c *capturer) prepare(object *unstructured.Unstructured) *unstructured.Unstructured {
+	if object.GroupVersionKind() != gvk.Secret || c.options.RevealSecrets {
+		return object
+	}
+
+	object = object.DeepCopy()
+	RedactSecret(object)
+	return object
+}
+
+// RedactSecret replaces the values of a Secret's data with empty strings. Its keys
+// are kept. The last applied configuration annotation, which can contain the
+// data, is removed.
+func RedactSecret(object *unstructured.Unstructured) {
+	data, _, _ := unstructured.NestedMap(object.Object, "data")
+	for key := range data {
+		data[key] = ""
+	}
+	if data != nil {
+		_ = unstructured.SetNestedMap(object.Object, data, "data")
+	}
+	unstructured.RemoveNestedField(object.Object, "stringData")
+
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	delete(annotations, lastAppliedAnnotation)
+	annotations[RedactedAnnotation] = "true"
+	object.SetAnnotations(annotations)
+}
+
+func (c *capturer) captureLogs(ctx context.Context, pods []unstructured.Unstructured) error {
+	kubernetesClient, err := c.client.KubernetesClient()
+	if err != nil {
+		c.manifest.addError("create kubernetes client: %v", err)
+		return nil
+	}
+
+	for i := range pods {
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(pods[i].Object, pod); err != nil {
+			c.manifest.addError("convert pod %s/%s: %v", pods[i].GetNamespace(), pods[i].GetName(), err)
+			continue
+		}
+
+		var containers []string
+		for _, container := range pod.Spec.InitContainers {
+			containers = append(containers, container.Name)
+		}
+		for _, container := range pod.Spec.Containers {
+			containers = append(containers, container.Name)
+		}
+
+		for _, container := range containers {
+			tailLines := c.options.LogTailLines
+			request := kubernetesClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container:  container,
+				TailLines:  &tailLines,
+				Timestamps: true,
+			})
+
+			data, err := request.DoRaw(ctx)
+			if err != nil {
+				c.manifest.addError("get logs for %s/%s container %s: %v", pod.Namespace, pod.Name, container, err)
+				continue
+			}
+
+			if err := c.writeFile(path.Join(pod.Namespace, pod.Name, container+".log"), data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *capturer) writeList(name string, groupVersionKind schema.GroupVersionKind, items []unstructured.Unstructured) error {
+	list := &unstructured.UnstructuredList{Object: map[string]interface{}{}}
+	list.SetAPIVersion(groupVersionKind.GroupVersion().String())
+	list.SetKind(groupVersionKind.Kind + "List")
+	list.Items = items
+
+	data, err := list.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+
+	return c.writeFile(name, data)
+}
+
+func (c *capturer) writeFile(name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: c.manifest.CapturedAt,
+	}
+
+	if err := c.tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	if _, err := c.tarWriter.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// listableResources returns the resources which can be listed. Subresources are skipped.
+func listableResources(lists []*metav1.APIResourceList) []captureResource {
+	var out []captureResource
+	for _, list := range lists {
+		groupVersion, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, resource := range list.APIResources {
+			if strings.Contains(resource.Name, "/") || !hasVerb(resource.Verbs, "list") {
+				continue
+			}
+
+			out = append(out, captureResource{
+				groupVersionKind: groupVersion.WithKind(resource.Kind),
+				resource:         groupVersion.WithResource(resource.Name).GroupResource(),
+				namespaced:       resource.Namespaced,
+			})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].resource.String() < out[j].resource.String()
+	})
+
+	return out
+}
+
+func hasVerb(verbs metav1.Verbs, verb string) bool {
+	for _, v := range verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+func filterByName(objects []unstructured.Unstructured, names []string) []unstructured.Unstructured {
+	var out []unstructured.Unstructured
+	for i := range objects {
+		for _, name := range names {
+			if objects[i].GetName() == name {
+				out = append(out, objects[i])
+				break
+			}
+		}
+	}
+	return out
+}
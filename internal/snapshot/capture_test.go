@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/gvk"
+)
+
+func TestCapture(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		options        CaptureOptions
+		expectedGVKs   int
+		expectedPods   int
+		expectedSecret string
+		expectedLogs   bool
+	}{
+		{
+			name:           "whole cluster",
+			options:        CaptureOptions{},
+			expectedGVKs:   6,
+			expectedPods:   2,
+			expectedSecret: "",
+		},
+		{
+			name:           "namespace",
+			options:        CaptureOptions{Namespaces: []string{"other"}},
+			expectedGVKs:   1,
+			expectedPods:   0,
+			expectedSecret: "",
+		},
+		{
+			name:           "reveal secrets",
+			options:        CaptureOptions{RevealSecrets: true},
+			expectedGVKs:   6,
+			expectedPods:   2,
+			expectedSecret: "c2VjcmV0",
+		},
+		{
+			name:           "logs",
+			options:        CaptureOptions{IncludeLogs: true},
+			expectedGVKs:   6,
+			expectedPods:   2,
+			expectedSecret: "",
+			expectedLogs:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			source := loadTestSnapshot(t)
+			source.add(testSecret())
+			snapshotClient := NewClient(source)
+
+			kubernetesClient, closeServer := logServer(t)
+			defer closeServer()
+
+			client := clusterFake.NewMockClientInterface(controller)
+			client.EXPECT().DiscoveryClient().Return(snapshotClient.DiscoveryClient())
+			client.EXPECT().KubernetesClient().Return(kubernetesClient, nil).AnyTimes()
+
+			test.options.Now = func() time.Time { return now }
+			test.options.Context = "test-context"
+
+			var buf bytes.Buffer
+			manifest, err := Capture(context.Background(), client, NewStore(source), &buf, test.options)
+			require.NoError(t, err)
+
+			assert.Equal(t, now, manifest.CapturedAt)
+			assert.Equal(t, "test-context", manifest.Context)
+			assert.Equal(t, !test.options.RevealSecrets, manifest.SecretsRedacted)
+			assert.Empty(t, manifest.Errors)
+
+			dir, err := ioutil.TempDir("", "capture")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			archive := filepath.Join(dir, "snapshot.tar.gz")
+			require.NoError(t, ioutil.WriteFile(archive, buf.Bytes(), 0600))
+
+			captured, err := Load(archive)
+			require.NoError(t, err)
+
+			require.NotNil(t, captured.Manifest())
+			assert.Equal(t, manifest.Resources, captured.Manifest().Resources)
+			assert.Len(t, captured.GroupVersionKinds(), test.expectedGVKs)
+			assert.Len(t, captured.Objects(gvk.Pod), test.expectedPods)
+
+			secrets := captured.Objects(gvk.Secret)
+			if test.options.Namespaces != nil {
+				assert.Empty(t, secrets)
+				return
+			}
+			require.Len(t, secrets, 1)
+			data, _, _ := unstructured.NestedString(secrets[0].Object, "data", "password")
+			assert.Equal(t, test.expectedSecret, data)
+			_, hasLastApplied := secrets[0].GetAnnotations()[lastAppliedAnnotation]
+			assert.Equal(t, test.options.RevealSecrets, hasLastApplied)
+
+			// the source snapshot is not redacted
+			data, _, _ = unstructured.NestedString(source.Objects(gvk.Secret)[0].Object, "data", "password")
+			assert.Equal(t, "c2VjcmV0", data)
+
+			files := archiveFiles(t, buf.Bytes())
+			assert.Contains(t, files, ManifestName)
+			assert.Contains(t, files, "default/pods.json")
+			if test.expectedLogs {
+				assert.Equal(t, "web logs", files["default/pod-1/web.log"])
+				assert.Equal(t, "worker logs", files["default/pod-2/worker.log"])
+			} else {
+				assert.NotContains(t, files, "default/pod-1/web.log")
+			}
+		})
+	}
+}
+
+// logServer returns a Kubernetes client whose pod log requests return the
+// container name followed by "logs", and a function which closes its server.
+func logServer(t *testing.T) (kubernetes.Interface, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Query().Get("container") + " logs"))
+	}))
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	return client, server.Close
+}
+
+func TestFileName(t *testing.T) {
+	capturedAt := time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		contextName string
+		expected    string
+	}{
+		{name: "context", contextName: "kind-kind", expected: "octant-snapshot-kind-kind-20200601T123000Z.tar.gz"},
+		{name: "unsafe characters", contextName: "arn:aws:eks:us-west-2:1234:cluster/prod", expected: "octant-snapshot-arn-aws-eks-us-west-2-1234-cluster-prod-20200601T123000Z.tar.gz"},
+		{name: "no context", contextName: "", expected: "octant-snapshot-cluster-20200601T123000Z.tar.gz"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, FileName(test.contextName, capturedAt))
+		})
+	}
+}
+
+func archiveFiles(t *testing.T, data []byte) map[string]string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+
+		content, err := ioutil.ReadAll(tarReader)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+}
+
+func testSecret() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      "secret",
+			"namespace": "default",
+			"annotations": map[string]interface{}{
+				lastAppliedAnnotation: `{"data":{"password":"c2VjcmV0"}}`,
+			},
+		},
+		"data": map[string]interface{}{
+			"password": "c2VjcmV0",
+		},
+	}}
+}
@@ -0,0 +1,376 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/scale"
+	clienttesting "k8s.io/client-go/testing"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/openapi"
+)
+
+// ErrNoAPIServer is returned by requests made with a snapshot's clients.
+var ErrNoAPIServer = errors.New("snapshots do not have an API server")
+
+// Client is a cluster client for a snapshot. Discovery is synthesized from the
+// kinds of objects in the snapshot. Requests made with its API clients fail with
+// ErrNoAPIServer, so objects should be read with the snapshot's Store.
+type Client struct {
+	snapshot  *Snapshot
+	resources []*metav1.APIResourceList
+	mapper    meta.RESTMapper
+	config    *rest.Config
+}
+
+var _ cluster.ClientInterface = (*Client)(nil)
+
+// NewClient creates an instance of Client.
+func NewClient(snapshot *Snapshot) *Client {
+	install.Install(scheme.Scheme)
+	_ = admissionregistrationv1beta1.AddToScheme(scheme.Scheme)
+	_ = apiregistrationv1.AddToScheme(scheme.Scheme)
+
+	resources := apiResources(snapshot)
+
+	var groupVersions []schema.GroupVersion
+	for _, list := range resources {
+		groupVersion, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		groupVersions = append(groupVersions, groupVersion)
+	}
+
+	mapper := meta.NewDefaultRESTMapper(groupVersions)
+	for _, list := range resources {
+		groupVersion, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, resource := range list.APIResources {
+			scope := meta.RESTScopeRoot
+			if resource.Namespaced {
+				scope = meta.RESTScopeNamespace
+			}
+			mapper.AddSpecific(groupVersion.WithKind(resource.Kind),
+				groupVersion.WithResource(resource.Name),
+				groupVersion.WithResource(resource.SingularName),
+				scope)
+		}
+	}
+
+	return &Client{
+		snapshot:  snapshot,
+		resources: resources,
+		mapper:    mapper,
+		config: &rest.Config{
+			Host:    "snapshot.invalid",
+			APIPath: "/api",
+			ContentConfig: rest.ContentConfig{
+				GroupVersion:         &corev1.SchemeGroupVersion,
+				NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+			},
+			Transport: noAPIServer{},
+		},
+	}
+}
+
+// DefaultNamespace returns the default namespace, or the first namespace in the
+// snapshot if it does not have a default namespace.
+func (c *Client) DefaultNamespace() string {
+	namespaces := c.snapshot.Namespaces()
+	for _, namespace := range namespaces {
+		if namespace == metav1.NamespaceDefault {
+			return namespace
+		}
+	}
+
+	if len(namespaces) > 0 {
+		return namespaces[0]
+	}
+
+	return metav1.NamespaceDefault
+}
+
+// ResourceExists returns true if the snapshot contains objects for a resource.
+func (c *Client) ResourceExists(gvr schema.GroupVersionResource) bool {
+	_, err := c.mapper.KindFor(gvr)
+	return err == nil
+}
+
+// Resource returns the resource for a group kind and whether it is namespaced.
+func (c *Client) Resource(gk schema.GroupKind) (schema.GroupVersionResource, bool, error) {
+	restMapping, err := c.mapper.RESTMapping(gk)
+	if err != nil {
+		return schema.GroupVersionResource{}, false, err
+	}
+	return restMapping.Resource, restMapping.Scope.Name() == meta.RESTScopeNameNamespace, nil
+}
+
+// ResetMapper does nothing. Snapshots do not change.
+func (c *Client) ResetMapper() {
+}
+
+// KubernetesClient returns a Kubernetes client. Its requests fail with ErrNoAPIServer.
+func (c *Client) KubernetesClient() (kubernetes.Interface, error) {
+	return kubernetes.NewForConfig(c.config)
+}
+
+// DynamicClient returns a dynamic client. Its requests fail with ErrNoAPIServer.
+func (c *Client) DynamicClient() (dynamic.Interface, error) {
+	return dynamic.NewForConfig(c.config)
+}
+
+// MetadataClient returns a metadata client. Its requests fail with ErrNoAPIServer.
+func (c *Client) MetadataClient() (metadata.Interface, error) {
+	return metadata.NewForConfig(c.config)
+}
+
+// DiscoveryClient returns a discovery client for the kinds in the snapshot.
+func (c *Client) DiscoveryClient() (discovery.DiscoveryInterface, error) {
+	return &snapshotDiscovery{
+		FakeDiscovery: &discoveryfake.FakeDiscovery{
+			Fake: &clienttesting.Fake{Resources: c.resources},
+		},
+	}, nil
+}
+
+// NamespaceClient returns a namespace client for the namespaces in the snapshot.
+func (c *Client) NamespaceClient() (cluster.NamespaceInterface, error) {
+	return &namespaceClient{
+		namespaces:       c.snapshot.Namespaces(),
+		initialNamespace: c.DefaultNamespace(),
+	}, nil
+}
+
+// InfoClient returns an InfoClient describing the snapshot.
+func (c *Client) InfoClient() (cluster.InfoInterface, error) {
+	return &info{path: c.snapshot.Path()}, nil
+}
+
+// ScaleClient returns a scale client. Its requests fail with ErrNoAPIServer.
+func (c *Client) ScaleClient() (scale.ScalesGetter, error) {
+	discoveryClient, err := c.DiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	return scale.NewForConfig(c.config, c.mapper, dynamic.LegacyAPIPathResolverFunc,
+		scale.NewDiscoveryScaleKindResolver(discoveryClient))
+}
+
+// OpenAPIResources returns ErrNoAPIServer. Snapshots do not capture the
+// cluster's OpenAPI document.
+func (c *Client) OpenAPIResources() (*openapi.Resources, error) {
+	return nil, ErrNoAPIServer
+}
+
+// Close does nothing.
+func (c *Client) Close() {
+}
+
+// RESTClient returns a REST client. Its requests fail with ErrNoAPIServer.
+func (c *Client) RESTClient() (rest.Interface, error) {
+	return rest.RESTClientFor(c.config)
+}
+
+// RESTConfig returns configuration for the client. Requests made with it fail
+// with ErrNoAPIServer.
+func (c *Client) RESTConfig() *rest.Config {
+	return c.config
+}
+
+// apiResources synthesizes discovery for the kinds in a snapshot. Resource names
+// and scopes are read from CRDs in the snapshot. For other kinds, names are
+// guessed from the kind, and kinds are namespaced if their objects have a namespace.
+func apiResources(snapshot *Snapshot) []*metav1.APIResourceList {
+	type crdNames struct {
+		plural, singular string
+		namespaced       bool
+	}
+
+	crds := make(map[schema.GroupKind]crdNames)
+	for _, groupVersionKind := range snapshot.GroupVersionKinds() {
+		if groupVersionKind.Group != "apiextensions.k8s.io" || groupVersionKind.Kind != "CustomResourceDefinition" {
+			continue
+		}
+
+		for _, crd := range snapshot.Objects(groupVersionKind) {
+			group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+			kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+			plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+			singular, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "singular")
+			scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+
+			crds[schema.GroupKind{Group: group, Kind: kind}] = crdNames{
+				plural:     plural,
+				singular:   singular,
+				namespaced: scope == "Namespaced",
+			}
+		}
+	}
+
+	lists := make(map[string]*metav1.APIResourceList)
+	var groupVersions []string
+
+	for _, groupVersionKind := range snapshot.GroupVersionKinds() {
+		plural, singular := meta.UnsafeGuessKindToResource(groupVersionKind)
+
+		namespaced := false
+		for _, object := range snapshot.Objects(groupVersionKind) {
+			if object.GetNamespace() != "" {
+				namespaced = true
+				break
+			}
+		}
+
+		resource := metav1.APIResource{
+			Name:         plural.Resource,
+			SingularName: singular.Resource,
+			Namespaced:   namespaced,
+			Kind:         groupVersionKind.Kind,
+			Verbs:        metav1.Verbs{"get", "list", "watch"},
+		}
+
+		if names, ok := crds[groupVersionKind.GroupKind()]; ok {
+			resource.Namespaced = names.namespaced
+			if names.plural != "" {
+				resource.Name = names.plural
+			}
+			if names.singular != "" {
+				resource.SingularName = names.singular
+			}
+		}
+
+		groupVersion := groupVersionKind.GroupVersion().String()
+		list, ok := lists[groupVersion]
+		if !ok {
+			list = &metav1.APIResourceList{GroupVersion: groupVersion}
+			lists[groupVersion] = list
+			groupVersions = append(groupVersions, groupVersion)
+		}
+		list.APIResources = append(list.APIResources, resource)
+	}
+
+	var out []*metav1.APIResourceList
+	for _, groupVersion := range groupVersions {
+		out = append(out, lists[groupVersion])
+	}
+
+	return out
+}
+
+// snapshotDiscovery is discovery for the kinds in a snapshot.
+type snapshotDiscovery struct {
+	*discoveryfake.FakeDiscovery
+}
+
+// ServerPreferredResources returns the resources in the snapshot.
+func (d *snapshotDiscovery) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return d.Resources, nil
+}
+
+// ServerPreferredNamespacedResources returns the namespaced resources in the snapshot.
+func (d *snapshotDiscovery) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	var out []*metav1.APIResourceList
+	for _, list := range d.Resources {
+		namespaced := &metav1.APIResourceList{GroupVersion: list.GroupVersion}
+		for _, resource := range list.APIResources {
+			if resource.Namespaced {
+				namespaced.APIResources = append(namespaced.APIResources, resource)
+			}
+		}
+
+		if len(namespaced.APIResources) > 0 {
+			out = append(out, namespaced)
+		}
+	}
+
+	return out, nil
+}
+
+type namespaceClient struct {
+	namespaces       []string
+	initialNamespace string
+}
+
+var _ cluster.NamespaceInterface = (*namespaceClient)(nil)
+
+func (n *namespaceClient) Names() ([]string, error) {
+	return n.namespaces, nil
+}
+
+func (n *namespaceClient) InitialNamespace() string {
+	return n.initialNamespace
+}
+
+func (n *namespaceClient) ProvidedNamespaces() []string {
+	return nil
+}
+
+func (n *namespaceClient) HasNamespace(namespace string) bool {
+	for _, name := range n.namespaces {
+		if name == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+type info struct {
+	path string
+}
+
+var _ cluster.InfoInterface = (*info)(nil)
+
+func (i *info) Context() string {
+	return ContextName(i.path)
+}
+
+func (i *info) Cluster() string {
+	return filepath.Base(i.path)
+}
+
+func (i *info) Server() string {
+	return i.path
+}
+
+func (i *info) User() string {
+	return ""
+}
+
+// ContextName returns the name of the context for a snapshot.
+func ContextName(path string) string {
+	return fmt.Sprintf("snapshot:%s", filepath.Base(path))
+}
+
+// noAPIServer is a transport which fails all requests with ErrNoAPIServer.
+type noAPIServer struct{}
+
+func (noAPIServer) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, ErrNoAPIServer
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+)
+
+func TestClient_Resource(t *testing.T) {
+	client := NewClient(loadTestSnapshot(t))
+
+	tests := []struct {
+		name       string
+		groupKind  schema.GroupKind
+		expected   schema.GroupVersionResource
+		namespaced bool
+		isErr      bool
+	}{
+		{
+			name:       "namespaced",
+			groupKind:  gvk.Pod.GroupKind(),
+			expected:   schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			namespaced: true,
+		},
+		{
+			name:      "cluster scoped",
+			groupKind: gvk.Node.GroupKind(),
+			expected:  schema.GroupVersionResource{Version: "v1", Resource: "nodes"},
+		},
+		{
+			name:       "custom resource",
+			groupKind:  widgetGVK.GroupKind(),
+			expected:   schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "gizmos"},
+			namespaced: true,
+		},
+		{
+			name:      "not in snapshot",
+			groupKind: gvk.Service.GroupKind(),
+			isErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gvr, namespaced, err := client.Resource(test.groupKind)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, gvr)
+			assert.Equal(t, test.namespaced, namespaced)
+			assert.True(t, client.ResourceExists(gvr))
+		})
+	}
+}
+
+func TestClient_DiscoveryClient(t *testing.T) {
+	client := NewClient(loadTestSnapshot(t))
+
+	discoveryClient, err := client.DiscoveryClient()
+	require.NoError(t, err)
+
+	lists, err := discoveryClient.ServerPreferredNamespacedResources()
+	require.NoError(t, err)
+
+	var got []string
+	for _, list := range lists {
+		for _, resource := range list.APIResources {
+			got = append(got, list.GroupVersion+" "+resource.Name)
+		}
+	}
+	assert.ElementsMatch(t, []string{"apps/v1 deployments", "v1 pods", "example.com/v1 gizmos"}, got)
+
+	lists, err = discoveryClient.ServerPreferredResources()
+	require.NoError(t, err)
+	assert.Len(t, lists, 4)
+}
+
+func TestClient_NamespaceClient(t *testing.T) {
+	client := NewClient(loadTestSnapshot(t))
+
+	namespaceClient, err := client.NamespaceClient()
+	require.NoError(t, err)
+
+	names, err := namespaceClient.Names()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "other"}, names)
+	assert.Equal(t, "default", namespaceClient.InitialNamespace())
+	assert.True(t, namespaceClient.HasNamespace("other"))
+	assert.False(t, namespaceClient.HasNamespace("missing"))
+}
+
+func TestClient_KubernetesClient(t *testing.T) {
+	client := NewClient(loadTestSnapshot(t))
+
+	kubernetesClient, err := client.KubernetesClient()
+	require.NoError(t, err)
+
+	_, err = kubernetesClient.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNoAPIServer))
+}
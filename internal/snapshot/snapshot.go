@@ -0,0 +1,304 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ErrReadOnly is returned when a snapshot is modified.
+var ErrReadOnly = errors.New("snapshot is read-only")
+
+// Snapshot is a set of objects loaded from a cluster dump.
+type Snapshot struct {
+	path     string
+	manifest *Manifest
+	objects  map[schema.GroupVersionKind]map[string]*unstructured.Unstructured
+}
+
+// Load loads a snapshot from a path. The path can be a directory of YAML or JSON
+// files, e.g. the output of `kubectl cluster-info dump --output-directory`, a
+// tarball of such a directory, e.g. one written by Capture, or a single YAML or
+// JSON file. Lists are expanded into their items. Files which are not YAML or
+// JSON, e.g. container logs, are ignored.
+func Load(path string) (*Snapshot, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot: %w", err)
+	}
+
+	s := &Snapshot{
+		path:    path,
+		objects: make(map[schema.GroupVersionKind]map[string]*unstructured.Unstructured),
+	}
+
+	switch {
+	case info.IsDir():
+		err = s.loadDir(path)
+	case isTarball(path):
+		err = s.loadTarball(path)
+	default:
+		err = s.loadFile(path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Path returns the path the snapshot was loaded from.
+func (s *Snapshot) Path() string {
+	return s.path
+}
+
+// Manifest returns the snapshot's manifest. It is nil if the snapshot was not
+// written by Capture.
+func (s *Snapshot) Manifest() *Manifest {
+	return s.manifest
+}
+
+// GroupVersionKinds returns the kinds of objects in the snapshot.
+func (s *Snapshot) GroupVersionKinds() []schema.GroupVersionKind {
+	var list []schema.GroupVersionKind
+	for groupVersionKind := range s.objects {
+		list = append(list, groupVersionKind)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+
+	return list
+}
+
+// Objects returns the objects of a kind, sorted by namespace and name.
+func (s *Snapshot) Objects(groupVersionKind schema.GroupVersionKind) []*unstructured.Unstructured {
+	var list []*unstructured.Unstructured
+	for _, object := range s.objects[groupVersionKind] {
+		list = append(list, object)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return objectID(list[i]) < objectID(list[j])
+	})
+
+	return list
+}
+
+// Namespaces returns the namespaces in the snapshot. They include namespaces
+// which contain objects, even if the namespace object is not in the snapshot.
+func (s *Snapshot) Namespaces() []string {
+	seen := make(map[string]bool)
+	for groupVersionKind, objects := range s.objects {
+		for _, object := range objects {
+			if groupVersionKind.Group == "" && groupVersionKind.Kind == "Namespace" {
+				seen[object.GetName()] = true
+			}
+			if namespace := object.GetNamespace(); namespace != "" {
+				seen[namespace] = true
+			}
+		}
+	}
+
+	var list []string
+	for namespace := range seen {
+		list = append(list, namespace)
+	}
+	sort.Strings(list)
+
+	return list
+}
+
+func (s *Snapshot) get(groupVersionKind schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, bool) {
+	object, ok := s.objects[groupVersionKind][namespace+"/"+name]
+	return object, ok
+}
+
+func (s *Snapshot) add(object *unstructured.Unstructured) {
+	groupVersionKind := object.GroupVersionKind()
+	if groupVersionKind.Kind == "" || object.GetName() == "" {
+		return
+	}
+
+	if _, ok := s.objects[groupVersionKind]; !ok {
+		s.objects[groupVersionKind] = make(map[string]*unstructured.Unstructured)
+	}
+
+	s.objects[groupVersionKind][objectID(object)] = object
+}
+
+func (s *Snapshot) loadDir(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || !isManifest(path) {
+			return nil
+		}
+
+		if filepath.Base(path) == ManifestName {
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			return s.decodeManifest(f)
+		}
+
+		return s.loadFile(path)
+	})
+}
+
+func (s *Snapshot) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := s.decode(f); err != nil {
+		return fmt.Errorf("decode %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (s *Snapshot) loadTarball(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") || strings.HasSuffix(path, ".tgz") {
+		gzipReader, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		r = gzipReader
+	}
+
+	tarReader := tar.NewReader(r)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg || !isManifest(header.Name) {
+			continue
+		}
+
+		if filepath.Base(header.Name) == ManifestName {
+			if err := s.decodeManifest(tarReader); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := s.decode(tarReader); err != nil {
+			return fmt.Errorf("decode %s: %w", header.Name, err)
+		}
+	}
+}
+
+func (s *Snapshot) decodeManifest(r io.Reader) error {
+	manifest := &Manifest{}
+	if err := json.NewDecoder(r).Decode(manifest); err != nil {
+		return fmt.Errorf("decode %s: %w", ManifestName, err)
+	}
+
+	s.manifest = manifest
+	return nil
+}
+
+// decode decodes YAML or JSON documents. Documents which are lists are expanded
+// into their items.
+func (s *Snapshot) decode(r io.Reader) error {
+	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
+
+	for {
+		var content map[string]interface{}
+		if err := decoder.Decode(&content); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if content == nil {
+			continue
+		}
+
+		object := &unstructured.Unstructured{Object: content}
+		if !object.IsList() {
+			s.add(object)
+			continue
+		}
+
+		list, err := object.ToList()
+		if err != nil {
+			return err
+		}
+
+		// items of typed lists, e.g. those written by kubectl cluster-info dump, do not
+		// have a kind. It is derived from the list's kind.
+		itemKind := strings.TrimSuffix(object.GetKind(), "List")
+		for i := range list.Items {
+			item := &list.Items[i]
+			if item.GetKind() == "" && itemKind != "" {
+				item.SetKind(itemKind)
+			}
+			if item.GetAPIVersion() == "" {
+				item.SetAPIVersion(object.GetAPIVersion())
+			}
+			s.add(item)
+		}
+	}
+}
+
+func objectID(object *unstructured.Unstructured) string {
+	return object.GetNamespace() + "/" + object.GetName()
+}
+
+func isManifest(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+func isTarball(path string) bool {
+	for _, suffix := range []string{".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
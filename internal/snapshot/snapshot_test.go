@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+)
+
+var widgetGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name string
+		path func(t *testing.T) (string, func())
+	}{
+		{
+			name: "directory",
+			path: func(t *testing.T) (string, func()) {
+				return filepath.Join("testdata", "dump"), func() {}
+			},
+		},
+		{
+			name: "tarball",
+			path: func(t *testing.T) (string, func()) {
+				path := createTarball(t, filepath.Join("testdata", "dump"))
+				return path, func() { _ = os.RemoveAll(filepath.Dir(path)) }
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, cleanup := test.path(t)
+			defer cleanup()
+
+			s, err := Load(path)
+			require.NoError(t, err)
+
+			expected := []schema.GroupVersionKind{
+				gvk.Deployment,
+				gvk.Node,
+				gvk.Pod,
+				{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"},
+				widgetGVK,
+			}
+			assert.ElementsMatch(t, expected, s.GroupVersionKinds())
+
+			pods := s.Objects(gvk.Pod)
+			require.Len(t, pods, 2)
+			assert.Equal(t, "pod-1", pods[0].GetName())
+			assert.Equal(t, "Pod", pods[0].GetKind())
+			assert.Equal(t, "v1", pods[0].GetAPIVersion())
+
+			assert.Equal(t, []string{"default", "other"}, s.Namespaces())
+		})
+	}
+}
+
+func TestLoad_missing(t *testing.T) {
+	_, err := Load(filepath.Join("testdata", "missing"))
+	require.Error(t, err)
+}
+
+func loadTestSnapshot(t *testing.T) *Snapshot {
+	s, err := Load(filepath.Join("testdata", "dump"))
+	require.NoError(t, err)
+	return s
+}
+
+func createTarball(t *testing.T, dir string) string {
+	tempDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+
+	path := filepath.Join(tempDir, "dump.tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gzipWriter := gzip.NewWriter(f)
+	defer gzipWriter.Close()
+	tarWriter := tar.NewWriter(gzipWriter)
+	defer tarWriter.Close()
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name, err = filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+
+		source, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer source.Close()
+
+		_, err = io.Copy(tarWriter, source)
+		return err
+	})
+	require.NoError(t, err)
+
+	return path
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Store is a read-only object store for a snapshot.
+type Store struct {
+	snapshot *Snapshot
+}
+
+var _ store.Store = (*Store)(nil)
+
+// NewStore creates an instance of Store.
+func NewStore(snapshot *Snapshot) *Store {
+	return &Store{
+		snapshot: snapshot,
+	}
+}
+
+// List lists objects in the snapshot.
+func (s *Store) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	list := &unstructured.UnstructuredList{}
+
+	for _, object := range s.snapshot.Objects(key.GroupVersionKind()) {
+		if !matches(object, key) {
+			continue
+		}
+		list.Items = append(list.Items, *object.DeepCopy())
+	}
+
+	if key.IsPaged() {
+		page, err := store.Paginate(list, key)
+		if err != nil {
+			return nil, false, err
+		}
+		return page, false, nil
+	}
+
+	return list, false, nil
+}
+
+// Get gets an object from the snapshot.
+func (s *Store) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	object, ok := s.snapshot.get(key.GroupVersionKind(), key.Namespace, key.Name)
+	if !ok {
+		plural, _ := meta.UnsafeGuessKindToResource(key.GroupVersionKind())
+		return nil, kerrors.NewNotFound(plural.GroupResource(), key.Name)
+	}
+
+	return object.DeepCopy(), nil
+}
+
+// Delete returns ErrReadOnly.
+func (s *Store) Delete(ctx context.Context, key store.Key) error {
+	return ErrReadOnly
+}
+
+// Watch calls the handler's OnAdd for the objects matching the key. Snapshots do
+// not change, so the handler is not called again.
+func (s *Store) Watch(ctx context.Context, key store.Key, handler cache.ResourceEventHandler) error {
+	for _, object := range s.snapshot.Objects(key.GroupVersionKind()) {
+		if matches(object, key) {
+			handler.OnAdd(object.DeepCopy())
+		}
+	}
+
+	return nil
+}
+
+// Unwatch does nothing.
+func (s *Store) Unwatch(ctx context.Context, groupVersionKinds ...schema.GroupVersionKind) error {
+	return nil
+}
+
+// UpdateClusterClient does nothing. Snapshots are not backed by a cluster.
+func (s *Store) UpdateClusterClient(ctx context.Context, client cluster.ClientInterface) error {
+	return nil
+}
+
+// RegisterOnUpdate does nothing. Snapshots do not change.
+func (s *Store) RegisterOnUpdate(fn store.UpdateFn) {
+}
+
+// Update returns ErrReadOnly.
+func (s *Store) Update(ctx context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+	return ErrReadOnly
+}
+
+// IsLoading returns false. Snapshots are loaded before the store is created.
+func (s *Store) IsLoading(ctx context.Context, key store.Key) bool {
+	return false
+}
+
+// Create returns ErrReadOnly.
+func (s *Store) Create(ctx context.Context, object *unstructured.Unstructured) error {
+	return ErrReadOnly
+}
+
+// CreateOrUpdateFromYAML returns ErrReadOnly.
+func (s *Store) CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error) {
+	return nil, ErrReadOnly
+}
+
+func matches(object *unstructured.Unstructured, key store.Key) bool {
+	if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+		return false
+	}
+
+	if key.Selector != nil && !key.Selector.AsSelector().Matches(labels.Set(object.GetLabels())) {
+		return false
+	}
+
+	return store.MatchesFieldSelector(object, key.FieldSelector)
+}
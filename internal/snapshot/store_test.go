@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestStore_List(t *testing.T) {
+	s := NewStore(loadTestSnapshot(t))
+
+	tests := []struct {
+		name     string
+		key      store.Key
+		expected []string
+		hasMore  bool
+	}{
+		{
+			name:     "namespace",
+			key:      store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"},
+			expected: []string{"pod-1", "pod-2"},
+		},
+		{
+			name:     "other namespace",
+			key:      store.Key{Namespace: "other", APIVersion: "v1", Kind: "Pod"},
+			expected: nil,
+		},
+		{
+			name:     "label selector",
+			key:      store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: &labels.Set{"app": "worker"}},
+			expected: []string{"pod-2"},
+		},
+		{
+			name:     "field selector",
+			key:      store.Key{APIVersion: "v1", Kind: "Pod", FieldSelector: &fields.Set{"spec.nodeName": "node-1"}},
+			expected: []string{"pod-1"},
+		},
+		{
+			name:     "limit",
+			key:      store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Limit: 1},
+			expected: []string{"pod-1"},
+			hasMore:  true,
+		},
+		{
+			name:     "custom resource",
+			key:      store.Key{APIVersion: "example.com/v1", Kind: "Widget"},
+			expected: []string{"widget"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list, loading, err := s.List(context.Background(), test.key)
+			require.NoError(t, err)
+			assert.False(t, loading)
+
+			var got []string
+			for i := range list.Items {
+				got = append(got, list.Items[i].GetName())
+			}
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, test.hasMore, list.GetContinue() != "")
+		})
+	}
+}
+
+func TestStore_Get(t *testing.T) {
+	s := NewStore(loadTestSnapshot(t))
+	ctx := context.Background()
+
+	object, err := s.Get(ctx, store.Key{APIVersion: "v1", Kind: "Node", Name: "node-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", object.GetName())
+
+	object.SetName("changed")
+	object, err = s.Get(ctx, store.Key{APIVersion: "v1", Kind: "Node", Name: "node-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", object.GetName(), "snapshot object was modified")
+
+	_, err = s.Get(ctx, store.Key{APIVersion: "v1", Kind: "Node", Name: "missing"})
+	assert.True(t, kerrors.IsNotFound(err))
+}
+
+func TestStore_readOnly(t *testing.T) {
+	s := NewStore(loadTestSnapshot(t))
+	ctx := context.Background()
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod-1"}
+
+	assert.Equal(t, ErrReadOnly, s.Delete(ctx, key))
+	assert.Equal(t, ErrReadOnly, s.Update(ctx, key, func(*unstructured.Unstructured) error { return nil }))
+	assert.Equal(t, ErrReadOnly, s.Create(ctx, &unstructured.Unstructured{}))
+
+	_, err := s.CreateOrUpdateFromYAML(ctx, "default", "")
+	assert.Equal(t, ErrReadOnly, err)
+}
+
+func TestStore_Watch(t *testing.T) {
+	s := NewStore(loadTestSnapshot(t))
+
+	var added []string
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			added = append(added, obj.(*unstructured.Unstructured).GetName())
+		},
+	}
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	require.NoError(t, s.Watch(context.Background(), key, handler))
+	assert.Equal(t, []string{"pod-1", "pod-2"}, added)
+}
@@ -0,0 +1,286 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package templates
+
+var nameField = Field{Name: "name", Label: "Name", Type: FieldText, Required: true}
+
+// Builtin returns the templates which are built in to Octant.
+func Builtin() []Template {
+	return []Template{
+		{
+			Name:        "Deployment and Service",
+			Description: "A deployment which runs an image, and a service which exposes its port.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "image", Label: "Image", Type: FieldText, Required: true},
+				{Name: "replicas", Label: "Replicas", Type: FieldNumber, Default: "1", Required: true},
+				{Name: "port", Label: "Port", Type: FieldNumber, Default: "80", Required: true},
+				{Name: "serviceType", Label: "Service Type", Type: FieldSelect, Default: "ClusterIP",
+					Choices: []string{"ClusterIP", "NodePort", "LoadBalancer"}, Required: true},
+			},
+			Template: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+  labels:
+    app: {{ quote .Values.name }}
+spec:
+  replicas: {{ .Values.replicas }}
+  selector:
+    matchLabels:
+      app: {{ quote .Values.name }}
+  template:
+    metadata:
+      labels:
+        app: {{ quote .Values.name }}
+    spec:
+      containers:
+      - name: {{ quote .Values.name }}
+        image: {{ quote .Values.image }}
+        ports:
+        - containerPort: {{ .Values.port }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+  labels:
+    app: {{ quote .Values.name }}
+spec:
+  type: {{ quote .Values.serviceType }}
+  selector:
+    app: {{ quote .Values.name }}
+  ports:
+  - port: {{ .Values.port }}
+    targetPort: {{ .Values.port }}
+`,
+		},
+		{
+			Name:        "ConfigMap",
+			Description: "A config map from key=value lines.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "data", Label: "Data (key=value per line)", Type: FieldTextarea},
+			},
+			Template: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+data:
+{{- range $key, $value := keyValues .Values.data }}
+  {{ quote $key }}: {{ quote $value }}
+{{- end }}
+`,
+		},
+		{
+			Name:        "ConfigMap from File",
+			Description: "A config map with a key which contains a file.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "key", Label: "File Name", Type: FieldText, Required: true},
+				{Name: "contents", Label: "File Contents", Type: FieldTextarea, Required: true},
+			},
+			Template: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+data:
+  {{ quote .Values.key }}: {{ quote .Values.contents }}
+`,
+		},
+		{
+			Name:        "Secret",
+			Description: "An opaque secret from key=value lines.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "data", Label: "Data (key=value per line)", Type: FieldTextarea},
+			},
+			Template: `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+type: Opaque
+data:
+{{- range $key, $value := keyValues .Values.data }}
+  {{ quote $key }}: {{ quote (b64enc $value) }}
+{{- end }}
+`,
+		},
+		{
+			Name:        "Docker Registry Secret",
+			Description: "A secret which pods use to pull images from a private registry.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "server", Label: "Registry Server", Type: FieldText, Default: "https://index.docker.io/v1/", Required: true},
+				{Name: "username", Label: "Username", Type: FieldText, Required: true},
+				{Name: "password", Label: "Password", Type: FieldPassword, Required: true},
+				{Name: "email", Label: "Email", Type: FieldText},
+			},
+			Template: `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+type: kubernetes.io/dockerconfigjson
+data:
+  .dockerconfigjson: {{ quote (b64enc (dockerConfigJSON .Values.server .Values.username .Values.password .Values.email)) }}
+`,
+		},
+		{
+			Name:        "TLS Secret",
+			Description: "A secret which contains a PEM encoded certificate and private key.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "certificate", Label: "Certificate (PEM)", Type: FieldTextarea, Required: true},
+				{Name: "key", Label: "Private Key (PEM)", Type: FieldTextarea, Required: true},
+			},
+			Template: `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+type: kubernetes.io/tls
+data:
+  tls.crt: {{ quote (b64enc .Values.certificate) }}
+  tls.key: {{ quote (b64enc .Values.key) }}
+`,
+		},
+		{
+			Name:        "Job",
+			Description: "A job which runs an image to completion.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "image", Label: "Image", Type: FieldText, Required: true},
+				{Name: "command", Label: "Command (one argument per line)", Type: FieldTextarea},
+				{Name: "backoffLimit", Label: "Backoff Limit", Type: FieldNumber, Default: "6", Required: true},
+			},
+			Template: `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+spec:
+  backoffLimit: {{ .Values.backoffLimit }}
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: {{ quote .Values.name }}
+        image: {{ quote .Values.image }}
+{{- with lines .Values.command }}
+        command:
+{{- range . }}
+        - {{ quote . }}
+{{- end }}
+{{- end }}
+`,
+		},
+		{
+			Name:        "CronJob",
+			Description: "A cron job which runs an image on a schedule.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "schedule", Label: "Schedule", Type: FieldText, Default: "*/5 * * * *", Required: true},
+				{Name: "image", Label: "Image", Type: FieldText, Required: true},
+				{Name: "command", Label: "Command (one argument per line)", Type: FieldTextarea},
+				{Name: "concurrencyPolicy", Label: "Concurrency Policy", Type: FieldSelect, Default: "Allow",
+					Choices: []string{"Allow", "Forbid", "Replace"}, Required: true},
+			},
+			Template: `apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+spec:
+  schedule: {{ quote .Values.schedule }}
+  concurrencyPolicy: {{ quote .Values.concurrencyPolicy }}
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: OnFailure
+          containers:
+          - name: {{ quote .Values.name }}
+            image: {{ quote .Values.image }}
+{{- with lines .Values.command }}
+            command:
+{{- range . }}
+            - {{ quote . }}
+{{- end }}
+{{- end }}
+`,
+		},
+		{
+			Name:        "Ingress",
+			Description: "An ingress which routes a host and path to a service.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "host", Label: "Host", Type: FieldText},
+				{Name: "path", Label: "Path", Type: FieldText, Default: "/", Required: true},
+				{Name: "serviceName", Label: "Service Name", Type: FieldText, Required: true},
+				{Name: "servicePort", Label: "Service Port", Type: FieldNumber, Default: "80", Required: true},
+			},
+			Template: `apiVersion: networking.k8s.io/v1beta1
+kind: Ingress
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+spec:
+  rules:
+  - http:
+      paths:
+      - path: {{ quote .Values.path }}
+        backend:
+          serviceName: {{ quote .Values.serviceName }}
+          servicePort: {{ .Values.servicePort }}
+{{- if .Values.host }}
+    host: {{ quote .Values.host }}
+{{- end }}
+`,
+		},
+		{
+			Name:        "PersistentVolumeClaim",
+			Description: "A claim for storage.",
+			Namespaced:  true,
+			Fields: []Field{
+				nameField,
+				{Name: "size", Label: "Size", Type: FieldText, Default: "1Gi", Required: true},
+				{Name: "accessMode", Label: "Access Mode", Type: FieldSelect, Default: "ReadWriteOnce",
+					Choices: []string{"ReadWriteOnce", "ReadOnlyMany", "ReadWriteMany"}, Required: true},
+				{Name: "storageClass", Label: "Storage Class", Type: FieldText},
+			},
+			Template: `apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: {{ quote .Values.name }}
+  namespace: {{ quote .Namespace }}
+spec:
+  accessModes:
+  - {{ quote .Values.accessMode }}
+{{- if .Values.storageClass }}
+  storageClassName: {{ quote .Values.storageClass }}
+{{- end }}
+  resources:
+    requests:
+      storage: {{ quote .Values.size }}
+`,
+		},
+	}
+}
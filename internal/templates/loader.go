@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package templates
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+// DefaultDir returns the templates directory, which is next to Octant's
+// configuration file. It returns an empty string if there is no home
+// directory, e.g. in a container.
+func DefaultDir() string {
+	configFile := plugin.DefaultConfig.ConfigFile(plugin.DefaultConfig.Home())
+	if configFile == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(configFile), "templates")
+}
+
+// Loader loads the built in templates and the templates in a directory.
+type Loader struct {
+	fs  afero.Fs
+	dir func() string
+}
+
+// NewLoader creates an instance of Loader. The directory is looked up each
+// time templates are loaded, since it depends on configuration which is read
+// after the loader is created.
+func NewLoader(fs afero.Fs, dir func() string) *Loader {
+	return &Loader{
+		fs:  fs,
+		dir: dir,
+	}
+}
+
+// Load loads the templates, sorted by name. The directory is read each time,
+// so templates can be added without restarting Octant. Templates which can't
+// be loaded are returned as errors, and the other templates are still loaded.
+func (l *Loader) Load() ([]Template, []error) {
+	list := Builtin()
+	names := map[string]bool{}
+	for _, t := range list {
+		names[t.Name] = true
+	}
+
+	var errs []error
+
+	dir := ""
+	if l.dir != nil {
+		dir = l.dir()
+	}
+
+	if dir != "" {
+		files, err := afero.ReadDir(l.fs, dir)
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, fmt.Errorf("read templates directory %s: %w", dir, err))
+		}
+
+		for _, file := range files {
+			ext := strings.ToLower(filepath.Ext(file.Name()))
+			if file.IsDir() || (ext != ".yaml" && ext != ".yml") {
+				continue
+			}
+
+			filename := filepath.Join(dir, file.Name())
+			t, err := l.loadFile(filename)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("load template %s: %w", filename, err))
+				continue
+			}
+
+			if names[t.Name] {
+				errs = append(errs, fmt.Errorf("load template %s: template %q already exists", filename, t.Name))
+				continue
+			}
+			names[t.Name] = true
+
+			list = append(list, t)
+		}
+	}
+
+	sortTemplates(list)
+	return list, errs
+}
+
+// Get loads a template by name. It returns false if the template does not
+// exist.
+func (l *Loader) Get(name string) (Template, bool) {
+	list, _ := l.Load()
+	for _, t := range list {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Template{}, false
+}
+
+func (l *Loader) loadFile(filename string) (Template, error) {
+	data, err := afero.ReadFile(l.fs, filename)
+	if err != nil {
+		return Template{}, err
+	}
+
+	var t Template
+	if err := yaml.UnmarshalStrict(data, &t); err != nil {
+		return Template{}, err
+	}
+
+	if err := t.Validate(); err != nil {
+		return Template{}, err
+	}
+
+	return t, nil
+}
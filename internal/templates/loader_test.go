@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package templates
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoader_Load(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	redis := `name: Redis
+description: A redis server.
+namespaced: true
+fields:
+- name: name
+  required: true
+template: |
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: {{ quote .Values.name }}
+    namespace: {{ quote .Namespace }}
+`
+	require.NoError(t, afero.WriteFile(fs, "/templates/redis.yaml", []byte(redis), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/templates/README.md", []byte("# templates"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/templates/invalid.yaml", []byte("name: Invalid\nunknown: field\n"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/templates/duplicate.yml", []byte("name: Job\ntemplate: ''\n"), 0644))
+
+	loader := NewLoader(fs, func() string { return "/templates" })
+
+	list, errs := loader.Load()
+	require.Len(t, errs, 2)
+	assert.Contains(t, errs[0].Error(), "/templates/duplicate.yml")
+	assert.Contains(t, errs[1].Error(), "/templates/invalid.yaml")
+
+	require.Len(t, list, len(Builtin())+1)
+	for i := 1; i < len(list); i++ {
+		assert.True(t, list[i-1].Name < list[i].Name, "templates are sorted by name")
+	}
+
+	got, ok := loader.Get("Redis")
+	require.True(t, ok)
+	assert.Equal(t, "A redis server.", got.Description)
+
+	rendered, err := got.Render("default", map[string]string{"name": "cache"})
+	require.NoError(t, err)
+	assert.Contains(t, rendered, `name: "cache"`)
+
+	_, ok = loader.Get("Missing")
+	assert.False(t, ok)
+}
+
+func TestLoader_Load_missingDir(t *testing.T) {
+	loader := NewLoader(afero.NewMemMapFs(), func() string { return "/missing" })
+
+	list, errs := loader.Load()
+	assert.Empty(t, errs)
+	assert.Len(t, list, len(Builtin()))
+
+	loader = NewLoader(afero.NewMemMapFs(), func() string { return "" })
+	list, errs = loader.Load()
+	assert.Empty(t, errs)
+	assert.Len(t, list, len(Builtin()))
+}
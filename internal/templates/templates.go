@@ -0,0 +1,296 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package templates renders resources from templates. Octant has built in
+// templates for common resources, and users can add their own templates to
+// the templates directory in Octant's configuration directory.
+package templates
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// FieldType is the type of a template field's input.
+type FieldType string
+
+const (
+	FieldText     FieldType = "text"
+	FieldNumber   FieldType = "number"
+	FieldTextarea FieldType = "textarea"
+	FieldPassword FieldType = "password"
+	FieldSelect   FieldType = "select"
+)
+
+// Field is a value a template is rendered with.
+type Field struct {
+	Name     string    `json:"name"`
+	Label    string    `json:"label,omitempty"`
+	Type     FieldType `json:"type,omitempty"`
+	Default  string    `json:"default,omitempty"`
+	Choices  []string  `json:"choices,omitempty"`
+	Required bool      `json:"required,omitempty"`
+}
+
+// Template renders resources from the values of its fields. The template is a
+// Go text template which renders YAML. It is executed with .Namespace and
+// .Values, which are the values of its fields.
+type Template struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Namespaced templates are rendered with a namespace.
+	Namespaced bool    `json:"namespaced,omitempty"`
+	Fields     []Field `json:"fields,omitempty"`
+	Template   string  `json:"template"`
+}
+
+// Validate returns an error if the template can't be rendered.
+func (t Template) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("template name is required")
+	}
+
+	seen := map[string]bool{}
+	for _, field := range t.Fields {
+		if field.Name == "" {
+			return fmt.Errorf("field name is required")
+		}
+		if seen[field.Name] {
+			return fmt.Errorf("field %q is defined more than once", field.Name)
+		}
+		seen[field.Name] = true
+
+		switch field.Type {
+		case "", FieldText, FieldNumber, FieldTextarea, FieldPassword:
+		case FieldSelect:
+			if len(field.Choices) == 0 {
+				return fmt.Errorf("select field %q has no choices", field.Name)
+			}
+		default:
+			return fmt.Errorf("field %q has unknown type %q", field.Name, field.Type)
+		}
+	}
+
+	if _, err := t.parse(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DisplayLabel returns the label of a field, which defaults to its name.
+func (f Field) DisplayLabel() string {
+	if f.Label != "" {
+		return f.Label
+	}
+	return f.Name
+}
+
+// Render renders the template. Fields which are not in values are set to their
+// defaults.
+func (t Template) Render(namespace string, values map[string]string) (string, error) {
+	if t.Namespaced && namespace == "" {
+		return "", fmt.Errorf("namespace is required")
+	}
+
+	data := map[string]string{}
+	for _, field := range t.Fields {
+		value, ok := values[field.Name]
+		if !ok {
+			value = field.Default
+		}
+		// textareas keep their whitespace, e.g. the trailing newline of a file.
+		if field.Type != FieldTextarea {
+			value = strings.TrimSpace(value)
+		}
+
+		if strings.TrimSpace(value) == "" && field.Required {
+			return "", fmt.Errorf("%s is required", field.DisplayLabel())
+		}
+
+		switch field.Type {
+		case FieldNumber:
+			if value != "" {
+				if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+					return "", fmt.Errorf("%s must be an integer", field.DisplayLabel())
+				}
+			}
+		case FieldSelect:
+			if value != "" && !containsString(field.Choices, value) {
+				return "", fmt.Errorf("%s must be one of %s", field.DisplayLabel(), strings.Join(field.Choices, ", "))
+			}
+		}
+
+		data[field.Name] = value
+	}
+
+	tmpl, err := t.parse()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"Namespace": namespace,
+		"Values":    data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("render template %s: %w", t.Name, err)
+	}
+
+	return buf.String(), nil
+}
+
+// Objects renders the template and decodes the objects it renders.
+func (t Template) Objects(namespace string, values map[string]string) ([]*unstructured.Unstructured, string, error) {
+	rendered, err := t.Render(namespace, values)
+	if err != nil {
+		return nil, "", err
+	}
+
+	objects, err := decodeObjects(rendered)
+	if err != nil {
+		return nil, "", fmt.Errorf("template %s: %w", t.Name, err)
+	}
+
+	return objects, rendered, nil
+}
+
+func (t Template) parse() (*template.Template, error) {
+	tmpl, err := template.New(t.Name).
+		Option("missingkey=error").
+		Funcs(funcMap).
+		Parse(t.Template)
+	if err != nil {
+		return nil, fmt.Errorf("parse template %s: %w", t.Name, err)
+	}
+	return tmpl, nil
+}
+
+func decodeObjects(input string) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(input), 4096)
+
+	var objects []*unstructured.Unstructured
+	for {
+		doc := map[string]interface{}{}
+		if err := decoder.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("unable to parse rendered yaml: %w", err)
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		// the decoder decodes numbers as floats, and unstructured objects
+		// expect integers as int64.
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+		object := &unstructured.Unstructured{}
+		if err := object.UnmarshalJSON(data); err != nil {
+			return nil, fmt.Errorf("unable to decode rendered object: %w", err)
+		}
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+var funcMap = template.FuncMap{
+	"quote":            quote,
+	"b64enc":           b64enc,
+	"lines":            lines,
+	"keyValues":        keyValues,
+	"dockerConfigJSON": dockerConfigJSON,
+}
+
+// quote quotes a string, so it is a YAML string whatever it contains.
+func quote(s string) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(s); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func b64enc(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+// lines returns the lines of a string which are not blank.
+func lines(s string) []string {
+	var list []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			list = append(list, line)
+		}
+	}
+	return list
+}
+
+// keyValues parses key=value lines.
+func keyValues(s string) (map[string]string, error) {
+	m := map[string]string{}
+	for _, line := range lines(s) {
+		parts := strings.SplitN(line, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || key == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", line)
+		}
+		m[key] = strings.TrimSpace(parts[1])
+	}
+	return m, nil
+}
+
+// dockerConfigJSON creates the .dockerconfigjson of a docker-registry secret.
+func dockerConfigJSON(server, username, password, email string) (string, error) {
+	auth := map[string]string{
+		"username": username,
+		"password": password,
+		"auth":     b64enc(username + ":" + password),
+	}
+	if email != "" {
+		auth["email"] = email
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{server: auth},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// sortTemplates sorts templates by name.
+func sortTemplates(list []Template) {
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+}
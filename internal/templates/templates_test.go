@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package templates
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func builtinTemplate(t *testing.T, name string) Template {
+	for _, template := range Builtin() {
+		if template.Name == name {
+			return template
+		}
+	}
+	t.Fatalf("template %q does not exist", name)
+	return Template{}
+}
+
+func TestBuiltin(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]string
+		check  func(t *testing.T, objects []*unstructured.Unstructured)
+	}{
+		{
+			name:   "Deployment and Service",
+			values: map[string]string{"name": "web", "image": "nginx:1.19"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				require.Len(t, objects, 2)
+				assert.Equal(t, "Deployment", objects[0].GetKind())
+				replicas, _, _ := unstructured.NestedInt64(objects[0].Object, "spec", "replicas")
+				assert.Equal(t, int64(1), replicas)
+				assert.Equal(t, "Service", objects[1].GetKind())
+				serviceType, _, _ := unstructured.NestedString(objects[1].Object, "spec", "type")
+				assert.Equal(t, "ClusterIP", serviceType)
+			},
+		},
+		{
+			name:   "ConfigMap",
+			values: map[string]string{"name": "settings", "data": "color = blue\nsize=\"large\"\n\n"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				require.Len(t, objects, 1)
+				data, _, _ := unstructured.NestedStringMap(objects[0].Object, "data")
+				assert.Equal(t, map[string]string{"color": "blue", "size": `"large"`}, data)
+			},
+		},
+		{
+			name:   "ConfigMap from File",
+			values: map[string]string{"name": "settings", "key": "app.yaml", "contents": "a: b\n"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				data, _, _ := unstructured.NestedStringMap(objects[0].Object, "data")
+				assert.Equal(t, map[string]string{"app.yaml": "a: b\n"}, data)
+			},
+		},
+		{
+			name:   "Secret",
+			values: map[string]string{"name": "credentials", "data": "password=hunter2"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				data, _, _ := unstructured.NestedStringMap(objects[0].Object, "data")
+				assert.Equal(t, map[string]string{"password": "aHVudGVyMg=="}, data)
+			},
+		},
+		{
+			name:   "Docker Registry Secret",
+			values: map[string]string{"name": "registry", "username": "user", "password": "pass"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				encoded, _, _ := unstructured.NestedString(objects[0].Object, "data", ".dockerconfigjson")
+				decoded, err := base64.StdEncoding.DecodeString(encoded)
+				require.NoError(t, err)
+				assert.JSONEq(t,
+					`{"auths":{"https://index.docker.io/v1/":{"username":"user","password":"pass","auth":"dXNlcjpwYXNz"}}}`,
+					string(decoded))
+			},
+		},
+		{
+			name:   "TLS Secret",
+			values: map[string]string{"name": "tls", "certificate": "cert\n", "key": "key\n"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				assert.Equal(t, "kubernetes.io/tls", objects[0].Object["type"])
+				data, _, _ := unstructured.NestedStringMap(objects[0].Object, "data")
+				assert.Equal(t, map[string]string{"tls.crt": "Y2VydAo=", "tls.key": "a2V5Cg=="}, data)
+			},
+		},
+		{
+			name:   "Job",
+			values: map[string]string{"name": "migrate", "image": "app", "command": "migrate\n--all"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				containers, _, _ := unstructured.NestedSlice(objects[0].Object, "spec", "template", "spec", "containers")
+				require.Len(t, containers, 1)
+				assert.Equal(t, []interface{}{"migrate", "--all"}, containers[0].(map[string]interface{})["command"])
+			},
+		},
+		{
+			name:   "CronJob",
+			values: map[string]string{"name": "report", "image": "app"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				schedule, _, _ := unstructured.NestedString(objects[0].Object, "spec", "schedule")
+				assert.Equal(t, "*/5 * * * *", schedule)
+				containers, _, _ := unstructured.NestedSlice(objects[0].Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+				require.Len(t, containers, 1)
+				assert.NotContains(t, containers[0], "command")
+			},
+		},
+		{
+			name:   "Ingress",
+			values: map[string]string{"name": "web", "host": "example.com", "serviceName": "web"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				rules, _, _ := unstructured.NestedSlice(objects[0].Object, "spec", "rules")
+				require.Len(t, rules, 1)
+				assert.Equal(t, "example.com", rules[0].(map[string]interface{})["host"])
+			},
+		},
+		{
+			name:   "PersistentVolumeClaim",
+			values: map[string]string{"name": "data", "storageClass": "fast"},
+			check: func(t *testing.T, objects []*unstructured.Unstructured) {
+				storageClass, _, _ := unstructured.NestedString(objects[0].Object, "spec", "storageClassName")
+				assert.Equal(t, "fast", storageClass)
+				storage, _, _ := unstructured.NestedString(objects[0].Object, "spec", "resources", "requests", "storage")
+				assert.Equal(t, "1Gi", storage)
+			},
+		},
+	}
+
+	require.Len(t, Builtin(), len(tests))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			template := builtinTemplate(t, test.name)
+			require.NoError(t, template.Validate())
+
+			objects, _, err := template.Objects("default", test.values)
+			require.NoError(t, err)
+			for _, object := range objects {
+				assert.Equal(t, "default", object.GetNamespace())
+			}
+			test.check(t, objects)
+		})
+	}
+}
+
+func TestTemplate_Render(t *testing.T) {
+	template := Template{
+		Name:       "test",
+		Namespaced: true,
+		Fields: []Field{
+			{Name: "name", Required: true},
+			{Name: "replicas", Type: FieldNumber, Default: "1"},
+			{Name: "tier", Type: FieldSelect, Choices: []string{"free", "paid"}},
+		},
+		Template: "name: {{ quote .Values.name }}\nreplicas: {{ .Values.replicas }}\ntier: {{ quote .Values.tier }}\n",
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		values    map[string]string
+		expected  string
+		wantErr   string
+	}{
+		{
+			name:      "defaults",
+			namespace: "default",
+			values:    map[string]string{"name": ` "quoted": value`},
+			expected:  "name: \"\\\"quoted\\\": value\"\nreplicas: 1\ntier: \"\"\n",
+		},
+		{
+			name:    "missing namespace",
+			values:  map[string]string{"name": "web"},
+			wantErr: "namespace is required",
+		},
+		{
+			name:      "missing required field",
+			namespace: "default",
+			values:    map[string]string{"name": " "},
+			wantErr:   "name is required",
+		},
+		{
+			name:      "invalid number",
+			namespace: "default",
+			values:    map[string]string{"name": "web", "replicas": "1\nextra: true"},
+			wantErr:   "replicas must be an integer",
+		},
+		{
+			name:      "invalid choice",
+			namespace: "default",
+			values:    map[string]string{"name": "web", "tier": "gold"},
+			wantErr:   "tier must be one of free, paid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := template.Render(test.namespace, test.values)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template Template
+	}{
+		{name: "missing name", template: Template{}},
+		{name: "missing field name", template: Template{Name: "t", Fields: []Field{{}}}},
+		{name: "duplicate field", template: Template{Name: "t", Fields: []Field{{Name: "a"}, {Name: "a"}}}},
+		{name: "select without choices", template: Template{Name: "t", Fields: []Field{{Name: "a", Type: FieldSelect}}}},
+		{name: "unknown type", template: Template{Name: "t", Fields: []Field{{Name: "a", Type: "date"}}}},
+		{name: "invalid template", template: Template{Name: "t", Template: "{{ .Values.a "}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Error(t, test.template.Validate())
+		})
+	}
+}
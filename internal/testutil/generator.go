@@ -73,6 +73,14 @@ func WithGenericCRD() CRDOption {
 	}
 }
 
+// CreateControllerRevision creates a controller revision
+func CreateControllerRevision(name string) *appsv1.ControllerRevision {
+	return &appsv1.ControllerRevision{
+		TypeMeta:   genTypeMeta(gvk.ControllerRevision),
+		ObjectMeta: genObjectMeta(name, true),
+	}
+}
+
 // CreateCRD creates a CRD
 func CreateCRD(name string, options ...CRDOption) *apiextv1.CustomResourceDefinition {
 	crd := &apiextv1.CustomResourceDefinition{
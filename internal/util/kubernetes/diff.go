@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+)
+
+// DiffYAML returns a unified diff of two values marshaled as YAML. It returns
+// an empty string if their YAML is the same. A nil value is diffed as an empty
+// document, e.g. to show an object which will be created.
+func DiffYAML(fromName string, from interface{}, toName string, to interface{}) (string, error) {
+	fromLines, err := yamlLines(from)
+	if err != nil {
+		return "", fmt.Errorf("marshal %s: %w", fromName, err)
+	}
+
+	toLines, err := yamlLines(to)
+	if err != nil {
+		return "", fmt.Errorf("marshal %s: %w", toName, err)
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        fromLines,
+		B:        toLines,
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+}
+
+func yamlLines(value interface{}) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return difflib.SplitLines(strings.TrimSuffix(string(data), "\n")), nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     interface{}
+		to       interface{}
+		expected string
+	}{
+		{
+			name: "changed",
+			from: map[string]interface{}{"image": "nginx:1.18", "name": "web"},
+			to:   map[string]interface{}{"image": "nginx:1.19", "name": "web"},
+			expected: "--- before\n" +
+				"+++ after\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				"-image: nginx:1.18\n" +
+				"+image: nginx:1.19\n" +
+				" name: web\n",
+		},
+		{
+			name: "created",
+			to:   map[string]interface{}{"name": "web"},
+			expected: "--- before\n" +
+				"+++ after\n" +
+				"@@ -0,0 +1 @@\n" +
+				"+name: web\n",
+		},
+		{
+			name:     "same",
+			from:     map[string]interface{}{"name": "web"},
+			to:       map[string]interface{}{"name": "web"},
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := DiffYAML("before", test.from, "after", test.to)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -10,4 +10,9 @@ const (
 	// The ActionRequest.Payload for this action contains a single string entry `namespace` with a value
 	// of the new current namespace.
 	RequestSetNamespace = "action.octant.dev/setNamespace"
+
+	// RequestSetPluginConfiguration is the action for when a plugin's configuration is updated.
+	// The ActionRequest.Payload for this action contains a string entry for each setting in the
+	// plugin's new configuration.
+	RequestSetPluginConfiguration = "action.octant.dev/setPluginConfiguration"
 )
@@ -29,6 +29,7 @@ import (
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/modules/applications"
@@ -36,9 +37,11 @@ import (
 	"github.com/vmware-tanzu/octant/internal/modules/configuration"
 	"github.com/vmware-tanzu/octant/internal/modules/localcontent"
 	"github.com/vmware-tanzu/octant/internal/modules/overview"
+	"github.com/vmware-tanzu/octant/internal/modules/tlscertificates"
 	"github.com/vmware-tanzu/octant/internal/modules/workloads"
 	"github.com/vmware-tanzu/octant/internal/objectstore"
 	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/internal/snapshot"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/octant"
@@ -61,6 +64,13 @@ type Options struct {
 	ClientBurst            int
 	UserAgent              string
 	BuildInfo              config.BuildInfo
+	InformerIdleTTL        time.Duration
+	InformerMaxObjects     int
+	InformerMaxBytes       int64
+	Snapshot               string
+	// CertificateExpiryWindow is how long before expiry certificates
+	// are flagged.
+	CertificateExpiryWindow time.Duration
 }
 
 type Runner struct {
@@ -101,7 +111,15 @@ func NewRunner(ctx context.Context, logger log.Logger, options Options) (*Runner
 
 	r.fs = afero.NewOsFs()
 
-	if options.KubeConfig, err = ValidateKubeConfig(logger, options.KubeConfig, r.fs); err == nil {
+	if options.Snapshot != "" {
+		logger.With("snapshot", options.Snapshot).Infof("browsing snapshot, changes to objects are disabled")
+		options.KubeConfig = ""
+		options.Context = snapshot.ContextName(options.Snapshot)
+	} else {
+		options.KubeConfig, err = ValidateKubeConfig(logger, options.KubeConfig, r.fs)
+	}
+
+	if err == nil {
 		apiService, pluginService, apiErr = r.initAPI(ctx, logger, options)
 		if apiErr != nil {
 			return nil, fmt.Errorf("failed to start service api: %w", apiErr)
@@ -179,16 +197,18 @@ func (r *Runner) initLoadingAPI(ctx context.Context, logger log.Logger, _ Option
 }
 
 func (r *Runner) initAPI(ctx context.Context, logger log.Logger, options Options) (*api.API, *pluginAPI.GRPCService, error) {
-	frontendProxy := pluginAPI.FrontendProxy{}
+	frontendProxy := pluginAPI.FrontendProxy{
+		ContentPusher: r.websocketClientManager.ContentPushHub(),
+	}
 
 	restConfigOptions := cluster.RESTConfigOptions{
 		QPS:       options.ClientQPS,
 		Burst:     options.ClientBurst,
 		UserAgent: options.UserAgent,
 	}
-	clusterClient, err := cluster.FromKubeConfig(ctx, options.KubeConfig, options.Context, options.Namespace, options.Namespaces, restConfigOptions)
+	clusterClient, appObjectStore, err := initCluster(ctx, options, restConfigOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to init cluster client, does your kube config have a current-context set?: %w", err)
+		return nil, nil, err
 	}
 
 	if options.EnableOpenCensus {
@@ -210,11 +230,6 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, options Options
 
 	logger.Debugf("initial namespace for dashboard is %s", options.Namespace)
 
-	appObjectStore, err := initObjectStore(ctx, clusterClient)
-	if err != nil {
-		return nil, nil, fmt.Errorf("initializing store: %w", err)
-	}
-
 	errorStore, err := oerrors.NewErrorStore()
 	if err != nil {
 		return nil, nil, fmt.Errorf("initializing error store: %w", err)
@@ -310,20 +325,55 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, options Options
 	}
 
 	apiService := api.New(ctx, api.PathPrefix, r.actionManager, r.websocketClientManager, dashConfig)
-	frontendProxy.FrontendUpdateController = apiService
+	pluginDashboardService.FrontendProxy.FrontendUpdateController = apiService
 
 	r.apiCreated = true
 	return apiService, pluginDashboardService, nil
 }
 
+// initCluster initializes the cluster client and object store. If a snapshot is
+// being browsed, they are read-only and load objects from the snapshot.
+func initCluster(ctx context.Context, options Options, restConfigOptions cluster.RESTConfigOptions) (cluster.ClientInterface, store.Store, error) {
+	if options.Snapshot != "" {
+		s, err := snapshot.Load(options.Snapshot)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if manifest := s.Manifest(); manifest != nil {
+			internalLog.From(ctx).
+				With("context", manifest.Context, "captured-at", manifest.CapturedAt, "secrets-redacted", manifest.SecretsRedacted).
+				Infof("loaded snapshot captured by octant %s", manifest.OctantVersion)
+		}
+
+		return snapshot.NewClient(s), snapshot.NewStore(s), nil
+	}
+
+	clusterClient, err := cluster.FromKubeConfig(ctx, options.KubeConfig, options.Context, options.Namespace, options.Namespaces, restConfigOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to init cluster client, does your kube config have a current-context set?: %w", err)
+	}
+
+	appObjectStore, err := initObjectStore(ctx, clusterClient, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("initializing store: %w", err)
+	}
+
+	return clusterClient, appObjectStore, nil
+}
+
 // initObjectStore initializes the cluster object store interface
-func initObjectStore(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+func initObjectStore(ctx context.Context, client cluster.ClientInterface, options Options) (store.Store, error) {
 	if client == nil {
 		return nil, fmt.Errorf("nil cluster client")
 	}
 
 	resourceAccess := objectstore.NewResourceAccess(client)
-	appObjectStore, err := objectstore.NewDynamicCache(ctx, client, objectstore.Access(resourceAccess))
+	appObjectStore, err := objectstore.NewDynamicCache(ctx, client,
+		objectstore.Access(resourceAccess),
+		objectstore.MetadataOnly(gvk.Secret),
+		objectstore.InformerIdleTTL(options.InformerIdleTTL),
+		objectstore.InformerBudget(options.InformerMaxObjects, options.InformerMaxBytes))
 
 	if err != nil {
 		return nil, fmt.Errorf("creating object store for app: %w", err)
@@ -337,7 +387,7 @@ func initPortForwarder(ctx context.Context, client cluster.ClientInterface, appO
 }
 
 type moduleOptions struct {
-	clusterClient  *cluster.Cluster
+	clusterClient  cluster.ClientInterface
 	crdWatcher     config.CRDWatcher
 	namespace      string
 	logger         log.Logger
@@ -371,6 +421,8 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string,
 	overviewOptions := overview.Options{
 		Namespace:  namespace,
 		DashConfig: dashConfig,
+
+		CertificateExpiryWindow: options.CertificateExpiryWindow,
 	}
 	overviewModule, err := overview.New(ctx, overviewOptions)
 	if err != nil {
@@ -381,7 +433,8 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string,
 
 	if !options.DisableClusterOverview {
 		clusterOverviewOptions := clusteroverview.Options{
-			DashConfig: dashConfig,
+			DashConfig:              dashConfig,
+			CertificateExpiryWindow: options.CertificateExpiryWindow,
 		}
 		clusterOverviewModule, err := clusteroverview.New(ctx, clusterOverviewOptions)
 		if err != nil {
@@ -399,6 +452,17 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string,
 
 	list = append(list, configurationModule)
 
+	tlsCertificatesOptions := tlscertificates.Options{
+		DashConfig:   dashConfig,
+		ExpiryWindow: options.CertificateExpiryWindow,
+	}
+	tlsCertificatesModule, err := tlscertificates.New(ctx, tlsCertificatesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("create TLS certificates module: %w", err)
+	}
+
+	list = append(list, tlsCertificatesModule)
+
 	localContentPath := viper.GetString("local-content")
 	if localContentPath != "" {
 		localContentModule := localcontent.New(localContentPath)
@@ -475,13 +539,6 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 
 	d.server = http.Server{Handler: handler}
 
-	// Enable serving the plugin API on the same endpoint as the Octant streaming API.
-	// This enables remote gRPC plugins.
-	// if d.pluginService != nil {
-	//     grpcl := d.mux.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
-	//     go serveGRPC(grpcl, d.pluginService)
-	// }
-
 	http1 := d.mux.Match(cmux.Any())
 	go func() {
 		if err = d.server.Serve(http1); err != nil && err != http.ErrServerClosed {
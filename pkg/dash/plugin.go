@@ -20,7 +20,12 @@ func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Man
 		return nil, fmt.Errorf("create dashboard api: %w", err)
 	}
 
-	m := plugin.NewManager(apiService, moduleManager, actionManager)
+	configurations, err := plugin.LoadConfigurations(plugin.DefaultConfig)
+	if err != nil {
+		return nil, fmt.Errorf("loading plugin configurations: %w", err)
+	}
+
+	m := plugin.NewManager(apiService, moduleManager, actionManager, plugin.WithConfigurations(configurations))
 
 	pluginList, err := plugin.AvailablePlugins(plugin.DefaultConfig)
 	if err != nil {
@@ -28,7 +33,7 @@ func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Man
 	}
 
 	for _, pluginPath := range pluginList {
-		if plugin.IsJavaScriptPlugin(pluginPath) {
+		if plugin.IsInProcessPlugin(pluginPath) {
 			continue
 		}
 		if err := m.Load(pluginPath); err != nil {
@@ -29,8 +29,11 @@ const (
 	ClusterOverviewNode               = "node"
 	ClusterOverviewPersistentVolume   = "pv"
 
-	Configuration       = "cog"
-	ConfigurationPlugin = "plugin"
+	Configuration            = "cog"
+	ConfigurationPlugin      = "plugin"
+	ConfigurationDiagnostics = "bug"
 
 	CustomResourceDefinition = "dna"
+
+	TLSCertificates = "lock"
 )
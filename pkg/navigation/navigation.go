@@ -57,6 +57,47 @@ type Navigation struct {
 	Loading  bool         `json:"isLoading"`
 }
 
+// Contribution is a navigation entry a module adds to a section generated by
+// another module, e.g. a "Certificates" entry under the overview module's
+// "Config and Storage" section.
+type Contribution struct {
+	// Module is the name of the module generating the section, e.g. overview or clusteroverview.
+	Module string `json:"module"`
+	// Section is the title of the section the entry is added to.
+	Section string `json:"section"`
+	// Title is the title of the entry.
+	Title string `json:"title"`
+	// Path is the path of the entry. Modules that contribute entries resolve it
+	// relative to their content path.
+	Path string `json:"path"`
+	// IconName is the name of the entry's icon. It is optional.
+	IconName string `json:"iconName,omitempty"`
+}
+
+// Contribute adds contributed entries to the sections they target. Entries whose
+// module or section does not exist are ignored. The contributions are added to
+// sections in place.
+func Contribute(sections map[string][]Navigation, contributions []Contribution) {
+	for _, contribution := range contributions {
+		list, ok := sections[contribution.Module]
+		if !ok {
+			continue
+		}
+
+		for i := range list {
+			if list[i].Title != contribution.Section {
+				continue
+			}
+
+			list[i].Children = append(list[i].Children, Navigation{
+				Title:    contribution.Title,
+				Path:     contribution.Path,
+				IconName: contribution.IconName,
+			})
+		}
+	}
+}
+
 // New creates a Navigation.
 func New(title, navigationPath string, options ...Option) (*Navigation, error) {
 	navigation := &Navigation{Title: title, Path: navigationPath}
@@ -92,7 +133,7 @@ func CRDEntries(ctx context.Context, prefix, namespace string, objectStore store
 			continue
 		}
 
-		objects, isLoading, err := ListCustomResources(ctx, crds[i], namespace, objectStore, nil)
+		count, isLoading, err := countCustomResources(ctx, crds[i], namespace, objectStore)
 		if err != nil {
 			return nil, false, err
 		}
@@ -101,7 +142,7 @@ func CRDEntries(ctx context.Context, prefix, namespace string, objectStore store
 			loading = true
 		}
 
-		if len(objects.Items) > 0 {
+		if count > 0 {
 			navigation, err := New(crds[i].Name, path.Join(prefix, crds[i].Name),
 				SetNavigationIcon(icon.CustomResourceDefinition),
 				SetLoading(isLoading))
@@ -165,6 +206,40 @@ func ListCustomResources(
 
 	list := new(unstructured.UnstructuredList)
 
+	for _, key := range customResourceKeys(crd, namespace, selector) {
+		objects, _, err := o.List(ctx, key)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "listing custom resources for %q", crd.Name)
+		}
+
+		list.Items = append(list.Items, objects.Items...)
+	}
+
+	return list, false, nil
+}
+
+// countCustomResources counts custom resources for a CRD. Only metadata is listed, so
+// full objects are not cached for it.
+func countCustomResources(ctx context.Context, crd *apiextv1beta1.CustomResourceDefinition, namespace string, o store.Store) (int, bool, error) {
+	count := 0
+	loading := false
+
+	for _, key := range customResourceKeys(crd, namespace, nil) {
+		objects, isLoading, err := store.ListMetadata(ctx, o, key)
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "listing custom resources for %q", crd.Name)
+		}
+
+		count += len(objects.Items)
+		loading = loading || isLoading
+	}
+
+	return count, loading, nil
+}
+
+func customResourceKeys(crd *apiextv1beta1.CustomResourceDefinition, namespace string, selector *labels.Set) []store.Key {
+	var keys []store.Key
+
 	for _, version := range crd.Spec.Versions {
 		if !version.Served {
 			continue
@@ -188,15 +263,10 @@ func ListCustomResources(
 			key.Namespace = namespace
 		}
 
-		objects, _, err := o.List(ctx, key)
-		if err != nil {
-			return nil, false, errors.Wrapf(err, "listing custom resources for %q", crd.Name)
-		}
-
-		list.Items = append(list.Items, objects.Items...)
+		keys = append(keys, key)
 	}
 
-	return list, false, nil
+	return keys
 }
 
 type navConfig struct {
@@ -22,12 +22,19 @@ type API interface {
 	Addr() string
 	// Start starts the API. To stop the API, cancel the context.
 	Start(context.Context) error
+	// IssueToken creates a token a plugin identifies itself with.
+	IssueToken() (string, error)
+	// SetContentRoot lets the plugin with token push content for paths under contentRoot.
+	SetContentRoot(token, contentRoot string)
+	// RevokeToken removes a token issued to a plugin.
+	RevokeToken(token string)
 }
 
 // grpcAPI is in implementation of API backed by GRPC.
 type grpcAPI struct {
 	Service  Service
 	listener net.Listener
+	roots    *contentRoots
 }
 
 var _ API = (*grpcAPI)(nil)
@@ -42,6 +49,7 @@ func New(service Service) (API, error) {
 	return &grpcAPI{
 		Service:  service,
 		listener: listener,
+		roots:    newContentRoots(),
 	}, nil
 }
 
@@ -51,6 +59,7 @@ func (a *grpcAPI) Start(ctx context.Context) error {
 
 	dashboardServer := &grpcServer{
 		service: a.Service,
+		roots:   a.roots,
 	}
 
 	s := grpc.NewServer()
@@ -76,3 +85,18 @@ func (a *grpcAPI) Start(ctx context.Context) error {
 func (a *grpcAPI) Addr() string {
 	return a.listener.Addr().String()
 }
+
+// IssueToken creates a token a plugin identifies itself with.
+func (a *grpcAPI) IssueToken() (string, error) {
+	return a.roots.issue()
+}
+
+// SetContentRoot lets the plugin with token push content for paths under contentRoot.
+func (a *grpcAPI) SetContentRoot(token, contentRoot string) {
+	a.roots.set(token, contentRoot)
+}
+
+// RevokeToken removes a token issued to a plugin.
+func (a *grpcAPI) RevokeToken(token string) {
+	a.roots.revoke(token)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/portforward"
@@ -22,6 +23,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 type apiMocks struct {
@@ -40,6 +42,20 @@ func TestAPI(t *testing.T) {
 		testutil.CreateDeployment("deployment"),
 	)
 
+	pageKey := store.Key{
+		Namespace:     "default",
+		APIVersion:    "apps/v1",
+		Kind:          "Deployment",
+		FieldSelector: &fields.Set{"metadata.name": "deployment"},
+		Limit:         1,
+		Continue:      "token",
+	}
+
+	page := testutil.ToUnstructuredList(t,
+		testutil.CreateDeployment("deployment"),
+	)
+	page.SetContinue("next")
+
 	getKey := store.Key{
 		Namespace:  "default",
 		APIVersion: "apps/v1",
@@ -82,6 +98,23 @@ func TestAPI(t *testing.T) {
 				assert.Equal(t, expected, got)
 			},
 		},
+		{
+			name: "list page",
+			initFunc: func(t *testing.T, mocks *apiMocks) {
+				mocks.objectStore.EXPECT().
+					List(gomock.Any(), gomock.Eq(pageKey)).Return(page, false, nil)
+			},
+			doFunc: func(t *testing.T, client *api.Client) {
+				clientCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+				defer cancel()
+
+				got, err := client.List(clientCtx, pageKey)
+				require.NoError(t, err)
+
+				assert.Equal(t, page, got)
+				assert.Equal(t, "next", got.GetContinue())
+			},
+		},
 		{
 			name: "create",
 			initFunc: func(t *testing.T, mocks *apiMocks) {
@@ -227,3 +260,136 @@ func checkPort(t *testing.T, isListen bool, addr string) {
 
 	require.NoError(t, err)
 }
+
+type contentPush struct {
+	contentPath string
+	response    *component.ContentResponse
+}
+
+type fakeContentPusher struct {
+	ch chan contentPush
+}
+
+func (p *fakeContentPusher) PushContent(contentPath string, response component.ContentResponse) {
+	p.ch <- contentPush{contentPath: contentPath, response: &response}
+}
+
+func (p *fakeContentPusher) EndContentPush(contentPath string) {
+	p.ch <- contentPush{contentPath: contentPath}
+}
+
+func (p *fakeContentPusher) next(t *testing.T) contentPush {
+	select {
+	case push := <-p.ch:
+		return push
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for pushed content")
+		return contentPush{}
+	}
+}
+
+func TestAPI_PushContent(t *testing.T) {
+	pusher := &fakeContentPusher{ch: make(chan contentPush, 10)}
+
+	service := &api.GRPCService{
+		FrontendProxy: api.FrontendProxy{ContentPusher: pusher},
+	}
+
+	a, err := api.New(service)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, a.Start(ctx))
+
+	token, err := a.IssueToken()
+	require.NoError(t, err)
+	a.SetContentRoot(token, "plugin")
+
+	client, err := api.NewClient(a.Addr(), api.WithToken(token))
+	require.NoError(t, err)
+
+	response := component.NewContentResponse(component.TitleFromString("Pushed"))
+	response.Add(component.NewText("content"))
+
+	require.NoError(t, client.PushContent(ctx, "plugin/path", *response))
+	push := pusher.next(t)
+	assert.Equal(t, "plugin/path", push.contentPath)
+	require.NotNil(t, push.response)
+	assert.Equal(t, response.Components, push.response.Components)
+
+	client.EndContentPush(ctx, "plugin/path")
+	push = pusher.next(t)
+	assert.Equal(t, "plugin/path", push.contentPath)
+	assert.Nil(t, push.response)
+
+	require.NoError(t, client.PushContent(ctx, "plugin/other", *response))
+	push = pusher.next(t)
+	assert.Equal(t, "plugin/other", push.contentPath)
+
+	// Closing the client ends the stream and the content it pushed.
+	require.NoError(t, client.Close())
+	push = pusher.next(t)
+	assert.Equal(t, "plugin/other", push.contentPath)
+	assert.Nil(t, push.response)
+}
+
+func TestAPI_PushContent_outside_content_root(t *testing.T) {
+	pusher := &fakeContentPusher{ch: make(chan contentPush, 10)}
+
+	service := &api.GRPCService{
+		FrontendProxy: api.FrontendProxy{ContentPusher: pusher},
+	}
+
+	a, err := api.New(service)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, a.Start(ctx))
+
+	token, err := a.IssueToken()
+	require.NoError(t, err)
+	a.SetContentRoot(token, "plugin")
+
+	unregistered, err := a.IssueToken()
+	require.NoError(t, err)
+
+	revoked, err := a.IssueToken()
+	require.NoError(t, err)
+	a.SetContentRoot(revoked, "plugin")
+	a.RevokeToken(revoked)
+
+	tests := []struct {
+		name        string
+		token       string
+		contentPath string
+	}{
+		{name: "other plugin", token: token, contentPath: "other-plugin/path"},
+		{name: "shared prefix", token: token, contentPath: "plugin-other/path"},
+		{name: "built in module", token: token, contentPath: "overview/namespace/default"},
+		{name: "no content root", token: unregistered, contentPath: "plugin/path"},
+		{name: "revoked token", token: revoked, contentPath: "plugin/path"},
+		{name: "no token", contentPath: "plugin/path"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := api.NewClient(a.Addr(), api.WithToken(test.token))
+			require.NoError(t, err)
+			defer client.Close()
+
+			response := component.NewContentResponse(component.TitleFromString("Pushed"))
+
+			// The server rejects the push after the client sends it, so the
+			// error surfaces on a later send.
+			require.Eventually(t, func() bool {
+				return client.PushContent(ctx, test.contentPath, *response) != nil
+			}, 5*time.Second, 10*time.Millisecond)
+
+			select {
+			case push := <-pusher.ch:
+				require.FailNow(t, "content was pushed", push.contentPath)
+			default:
+			}
+		})
+	}
+}
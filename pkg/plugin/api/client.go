@@ -7,13 +7,18 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api/proto"
 	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 //go:generate mockgen -destination=./fake/mock_dashboard_client.go -package=fake github.com/vmware-tanzu/octant/pkg/plugin/api/proto DashboardClient
@@ -40,9 +45,22 @@ func (d *defaultDashboardConnection) Client() proto.DashboardClient {
 
 type ClientOption func(c *Client)
 
+// WithToken sets the token the client identifies its plugin with.
+func WithToken(token string) ClientOption {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
 // Client is a dashboard service API client.
 type Client struct {
 	DashboardConnection DashboardConnection
+
+	token string
+
+	contentStreamMu     sync.Mutex
+	contentStream       proto.Dashboard_PushContentClient
+	contentStreamCancel context.CancelFunc
 }
 
 var _ Service = (*Client)(nil)
@@ -73,6 +91,10 @@ func NewClient(address string, options ...ClientOption) (*Client, error) {
 
 // Close closes the client's connection.
 func (c *Client) Close() error {
+	c.contentStreamMu.Lock()
+	c.closeContentStream()
+	c.contentStreamMu.Unlock()
+
 	return c.DashboardConnection.Close()
 }
 
@@ -94,6 +116,9 @@ func (c *Client) List(ctx context.Context, key store.Key) (*unstructured.Unstruc
 	if err != nil {
 		return nil, err
 	}
+	if resp.Continue != "" {
+		objects.SetContinue(resp.Continue)
+	}
 
 	return objects, nil
 }
@@ -213,3 +238,68 @@ func (c *Client) ForceFrontendUpdate(ctx context.Context) error {
 	_, err := client.ForceFrontendUpdate(ctx, &proto.Empty{})
 	return err
 }
+
+// PushContent pushes content for a content path to the clients viewing it. Octant uses
+// pushed content for the path instead of polling the plugin until EndContentPush is
+// called or the client is closed.
+func (c *Client) PushContent(ctx context.Context, contentPath string, response component.ContentResponse) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("marshal content: %w", err)
+	}
+
+	return c.sendContent(&proto.PushContentRequest{
+		ContentPath: contentPath,
+		Content:     data,
+	})
+}
+
+// EndContentPush ends pushed content for a content path. Octant goes back to polling the
+// plugin for the path's content.
+func (c *Client) EndContentPush(ctx context.Context, contentPath string) {
+	_ = c.sendContent(&proto.PushContentRequest{
+		ContentPath: contentPath,
+		End:         true,
+	})
+}
+
+// sendContent sends a request on the content stream. The stream is opened on first use
+// and reopened if a send fails.
+func (c *Client) sendContent(req *proto.PushContentRequest) error {
+	c.contentStreamMu.Lock()
+	defer c.contentStreamMu.Unlock()
+
+	if c.contentStream == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx = metadata.AppendToOutgoingContext(ctx, tokenMetadataKey, c.token)
+		stream, err := c.DashboardConnection.Client().PushContent(ctx)
+		if err != nil {
+			cancel()
+			return fmt.Errorf("open content stream: %w", err)
+		}
+
+		c.contentStream = stream
+		c.contentStreamCancel = cancel
+	}
+
+	if err := c.contentStream.Send(req); err != nil {
+		c.contentStreamCancel()
+		c.closeContentStream()
+		return fmt.Errorf("push content: %w", err)
+	}
+
+	return nil
+}
+
+// closeContentStream closes the content stream. The caller must hold contentStreamMu.
+func (c *Client) closeContentStream() {
+	if c.contentStream == nil {
+		return
+	}
+
+	_, _ = c.contentStream.CloseAndRecv()
+	c.contentStreamCancel()
+
+	c.contentStream = nil
+	c.contentStreamCancel = nil
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/vmware-tanzu/octant/pkg/plugin/api/proto"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -24,12 +26,18 @@ func convertFromKey(in store.Key) (*proto.KeyRequest, error) {
 		ApiVersion: in.APIVersion,
 		Kind:       in.Kind,
 		Name:       in.Name,
+		Limit:      in.Limit,
+		Continue:   in.Continue,
 	}
 
 	if in.Selector != nil {
 		keyRequest.LabelSelector = &wrappers.BytesValue{Value: []byte(in.Selector.String())}
 	}
 
+	if in.FieldSelector != nil {
+		keyRequest.FieldSelector = &wrappers.BytesValue{Value: []byte(in.FieldSelector.String())}
+	}
+
 	return &keyRequest, nil
 }
 
@@ -43,6 +51,8 @@ func convertToKey(in *proto.KeyRequest) (store.Key, error) {
 		APIVersion: in.ApiVersion,
 		Kind:       in.Kind,
 		Name:       in.Name,
+		Limit:      in.Limit,
+		Continue:   in.Continue,
 	}
 
 	labelSelector := in.GetLabelSelector()
@@ -59,6 +69,25 @@ func convertToKey(in *proto.KeyRequest) (store.Key, error) {
 		key.Selector = &matchLabels
 	}
 
+	fieldSelector := in.GetFieldSelector()
+	if fieldSelector != nil {
+		selector, err := fields.ParseSelector(string(fieldSelector.Value))
+		if err != nil {
+			return store.Key{}, errors.New("cannot parse field selector string")
+		}
+
+		// Keys only hold equality requirements, so other operators are rejected
+		// rather than matching the opposite objects.
+		matchFields := fields.Set{}
+		for _, requirement := range selector.Requirements() {
+			if requirement.Operator != selection.Equals && requirement.Operator != selection.DoubleEquals {
+				return store.Key{}, errors.Errorf("field selector %q: only = and == are supported", fieldSelector.Value)
+			}
+			matchFields[requirement.Field] = requirement.Value
+		}
+		key.FieldSelector = &matchFields
+	}
+
 	return key, nil
 }
 
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin/api/proto"
+)
+
+func Test_convertToKey_fieldSelector(t *testing.T) {
+	tests := []struct {
+		name          string
+		fieldSelector string
+		expected      *fields.Set
+		isErr         bool
+	}{
+		{
+			name:          "equals",
+			fieldSelector: "metadata.name=pod,status.phase==Running",
+			expected:      &fields.Set{"metadata.name": "pod", "status.phase": "Running"},
+		},
+		{
+			name:          "not equals",
+			fieldSelector: "status.phase!=Running",
+			isErr:         true,
+		},
+		{
+			name:          "invalid",
+			fieldSelector: "status.phase",
+			isErr:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := convertToKey(&proto.KeyRequest{
+				Namespace:     "default",
+				ApiVersion:    "v1",
+				Kind:          "Pod",
+				FieldSelector: &wrappers.BytesValue{Value: []byte(test.fieldSelector)},
+			})
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, key.FieldSelector)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	api "github.com/vmware-tanzu/octant/pkg/plugin/api"
 	store "github.com/vmware-tanzu/octant/pkg/store"
+	component "github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 // MockService is a mock of Service interface
@@ -64,6 +65,18 @@ func (mr *MockServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), arg0, arg1)
 }
 
+// EndContentPush mocks base method
+func (m *MockService) EndContentPush(arg0 context.Context, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EndContentPush", arg0, arg1)
+}
+
+// EndContentPush indicates an expected call of EndContentPush
+func (mr *MockServiceMockRecorder) EndContentPush(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndContentPush", reflect.TypeOf((*MockService)(nil).EndContentPush), arg0, arg1)
+}
+
 // ForceFrontendUpdate mocks base method
 func (m *MockService) ForceFrontendUpdate(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -138,6 +151,20 @@ func (mr *MockServiceMockRecorder) PortForward(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockService)(nil).PortForward), arg0, arg1)
 }
 
+// PushContent mocks base method
+func (m *MockService) PushContent(arg0 context.Context, arg1 string, arg2 component.ContentResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushContent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushContent indicates an expected call of PushContent
+func (mr *MockServiceMockRecorder) PushContent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushContent", reflect.TypeOf((*MockService)(nil).PushContent), arg0, arg1, arg2)
+}
+
 // Update mocks base method
 func (m *MockService) Update(arg0 context.Context, arg1 *unstructured.Unstructured) error {
 	m.ctrl.T.Helper()
@@ -177,6 +177,26 @@ func (mr *MockDashboardClientMockRecorder) PortForward(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockDashboardClient)(nil).PortForward), varargs...)
 }
 
+// PushContent mocks base method
+func (m *MockDashboardClient) PushContent(arg0 context.Context, arg1 ...grpc.CallOption) (proto.Dashboard_PushContentClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PushContent", varargs...)
+	ret0, _ := ret[0].(proto.Dashboard_PushContentClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PushContent indicates an expected call of PushContent
+func (mr *MockDashboardClientMockRecorder) PushContent(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushContent", reflect.TypeOf((*MockDashboardClient)(nil).PushContent), varargs...)
+}
+
 // Update mocks base method
 func (m *MockDashboardClient) Update(arg0 context.Context, arg1 *proto.UpdateRequest, arg2 ...grpc.CallOption) (*proto.UpdateResponse, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +63,9 @@ type KeyRequest struct {
 	Kind                 string               `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
 	Name                 string               `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	LabelSelector        *wrappers.BytesValue `protobuf:"bytes,5,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	FieldSelector        *wrappers.BytesValue `protobuf:"bytes,6,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	Limit                int64                `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Continue             string               `protobuf:"bytes,8,opt,name=continue,proto3" json:"continue,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -128,8 +131,30 @@ func (m *KeyRequest) GetLabelSelector() *wrappers.BytesValue {
 	return nil
 }
 
+func (m *KeyRequest) GetFieldSelector() *wrappers.BytesValue {
+	if m != nil {
+		return m.FieldSelector
+	}
+	return nil
+}
+
+func (m *KeyRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *KeyRequest) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
 type ListResponse struct {
 	Objects              [][]byte `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	Continue             string   `protobuf:"bytes,2,opt,name=continue,proto3" json:"continue,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -167,6 +192,13 @@ func (m *ListResponse) GetObjects() [][]byte {
 	return nil
 }
 
+func (m *ListResponse) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
 type GetResponse struct {
 	Object               []byte   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -534,6 +566,61 @@ func (m *NamespacesResponse) GetNamespaces() []string {
 	return nil
 }
 
+type PushContentRequest struct {
+	ContentPath          string   `protobuf:"bytes,1,opt,name=contentPath,proto3" json:"contentPath,omitempty"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	End                  bool     `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushContentRequest) Reset()         { *m = PushContentRequest{} }
+func (m *PushContentRequest) String() string { return proto.CompactTextString(m) }
+func (*PushContentRequest) ProtoMessage()    {}
+func (*PushContentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b9012dddebf2b7c, []int{12}
+}
+
+func (m *PushContentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushContentRequest.Unmarshal(m, b)
+}
+func (m *PushContentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushContentRequest.Marshal(b, m, deterministic)
+}
+func (m *PushContentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushContentRequest.Merge(m, src)
+}
+func (m *PushContentRequest) XXX_Size() int {
+	return xxx_messageInfo_PushContentRequest.Size(m)
+}
+func (m *PushContentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushContentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushContentRequest proto.InternalMessageInfo
+
+func (m *PushContentRequest) GetContentPath() string {
+	if m != nil {
+		return m.ContentPath
+	}
+	return ""
+}
+
+func (m *PushContentRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *PushContentRequest) GetEnd() bool {
+	if m != nil {
+		return m.End
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "proto.Empty")
 	proto.RegisterType((*KeyRequest)(nil), "proto.KeyRequest")
@@ -547,46 +634,53 @@ func init() {
 	proto.RegisterType((*PortForwardResponse)(nil), "proto.PortForwardResponse")
 	proto.RegisterType((*CancelPortForwardRequest)(nil), "proto.CancelPortForwardRequest")
 	proto.RegisterType((*NamespacesResponse)(nil), "proto.NamespacesResponse")
+	proto.RegisterType((*PushContentRequest)(nil), "proto.PushContentRequest")
 }
 
 func init() { proto.RegisterFile("dashboard_api.proto", fileDescriptor_3b9012dddebf2b7c) }
 
 var fileDescriptor_3b9012dddebf2b7c = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x56, 0xd6, 0x3f, 0xf5, 0xb4, 0x9d, 0x98, 0x0b, 0x28, 0x04, 0x54, 0xaa, 0x68, 0x88, 0x5e,
-	0xa0, 0x4c, 0x0c, 0xb8, 0xe0, 0x0e, 0xb6, 0xd2, 0x09, 0x81, 0x2a, 0x14, 0xc4, 0x6e, 0xb8, 0x40,
-	0x4e, 0x72, 0x18, 0x81, 0x34, 0x36, 0xb6, 0xab, 0xa9, 0xaf, 0xc1, 0xbb, 0xf0, 0x1c, 0xbc, 0x12,
-	0x4a, 0xec, 0x34, 0xf5, 0xda, 0x49, 0xbd, 0x6a, 0xce, 0x77, 0x7e, 0x7c, 0xfc, 0x7d, 0x9f, 0x0b,
-	0xc3, 0x84, 0xca, 0x1f, 0x11, 0xa3, 0x22, 0xf9, 0x46, 0x79, 0x1a, 0x70, 0xc1, 0x14, 0x23, 0xad,
-	0xf2, 0xc7, 0x1b, 0x5d, 0x31, 0x76, 0x95, 0xe1, 0x49, 0x19, 0x45, 0xcb, 0xef, 0x27, 0xd7, 0x82,
-	0x72, 0x8e, 0x42, 0xea, 0x32, 0xbf, 0x03, 0xad, 0x77, 0x0b, 0xae, 0x56, 0xfe, 0x5f, 0x07, 0xe0,
-	0x03, 0xae, 0x42, 0xfc, 0xbd, 0x44, 0xa9, 0xc8, 0x23, 0xe8, 0xe6, 0x74, 0x81, 0x92, 0xd3, 0x18,
-	0x5d, 0x67, 0xec, 0x4c, 0xba, 0x61, 0x0d, 0x90, 0x11, 0x00, 0xe5, 0xe9, 0x25, 0x0a, 0x99, 0xb2,
-	0xdc, 0x3d, 0x28, 0xd3, 0x1b, 0x08, 0x21, 0xd0, 0xfc, 0x95, 0xe6, 0x89, 0xdb, 0x28, 0x33, 0xe5,
-	0x77, 0x81, 0x15, 0x03, 0xdc, 0xa6, 0xc6, 0x8a, 0x6f, 0xf2, 0x16, 0x06, 0x19, 0x8d, 0x30, 0xfb,
-	0x8c, 0x19, 0xc6, 0x8a, 0x09, 0xb7, 0x35, 0x76, 0x26, 0xbd, 0xd3, 0x87, 0x81, 0xde, 0x3a, 0xa8,
-	0xb6, 0x0e, 0xce, 0x56, 0x0a, 0xe5, 0x25, 0xcd, 0x96, 0x18, 0xda, 0x1d, 0xfe, 0x04, 0xfa, 0x1f,
-	0x53, 0xa9, 0x42, 0x94, 0x9c, 0xe5, 0x12, 0x89, 0x0b, 0x1d, 0x16, 0xfd, 0xc4, 0x58, 0x49, 0xd7,
-	0x19, 0x37, 0x26, 0xfd, 0xb0, 0x0a, 0xfd, 0x27, 0xd0, 0xbb, 0xc0, 0xba, 0xf0, 0x3e, 0xb4, 0x75,
-	0xa6, 0xbc, 0x5e, 0x3f, 0x34, 0x91, 0xff, 0x14, 0x06, 0x5f, 0x78, 0x42, 0x15, 0x56, 0x54, 0xdc,
-	0x56, 0x78, 0x07, 0x0e, 0xab, 0x42, 0x3d, 0xb2, 0x68, 0x3d, 0x17, 0xb8, 0x5f, 0x6b, 0x55, 0x68,
-	0x5a, 0xff, 0x38, 0x40, 0x3e, 0x31, 0xa1, 0x66, 0x4c, 0x5c, 0x53, 0x91, 0xec, 0x27, 0x83, 0x0b,
-	0x1d, 0xce, 0x92, 0x79, 0xc1, 0xaa, 0xd6, 0xa0, 0x0a, 0xc9, 0x31, 0x0c, 0x62, 0x96, 0x2b, 0x9a,
-	0xe6, 0x28, 0xca, 0xbc, 0x56, 0xc2, 0x06, 0x0b, 0x19, 0x39, 0x13, 0x6a, 0xbe, 0x5c, 0x44, 0x28,
-	0x4a, 0x61, 0x06, 0xe1, 0x06, 0xe2, 0x7f, 0x85, 0xa1, 0xb5, 0x93, 0x61, 0xee, 0x18, 0x06, 0xbc,
-	0x86, 0xdf, 0x4f, 0xcd, 0x62, 0x36, 0x78, 0x63, 0xf8, 0xc1, 0xd6, 0xf0, 0x37, 0xe0, 0x9e, 0xd3,
-	0x3c, 0xc6, 0x6c, 0xc7, 0xb5, 0xf7, 0x3a, 0xc1, 0x7f, 0x09, 0x64, 0x5e, 0x71, 0x21, 0xd7, 0xdb,
-	0x8d, 0x00, 0xd6, 0x0c, 0x69, 0x0f, 0x74, 0xc3, 0x0d, 0xe4, 0xf4, 0x5f, 0x03, 0xba, 0xd3, 0xea,
-	0xc1, 0x90, 0x00, 0x9a, 0x85, 0x7d, 0xc8, 0x91, 0xf6, 0x5a, 0x50, 0x3f, 0x01, 0x6f, 0x68, 0x20,
-	0xcb, 0x5e, 0xcf, 0xa0, 0x71, 0x81, 0x3b, 0xcb, 0x89, 0x81, 0x36, 0x3d, 0xf6, 0x0a, 0xda, 0xda,
-	0x22, 0xe4, 0xae, 0xc9, 0x5a, 0xd6, 0xf2, 0xee, 0xdd, 0x40, 0xeb, 0x36, 0x6d, 0x8f, 0x75, 0x9b,
-	0x65, 0xab, 0x75, 0x9b, 0xed, 0x21, 0x32, 0x85, 0xde, 0x06, 0x97, 0xe4, 0x81, 0xa9, 0xda, 0xe6,
-	0xd7, 0xf3, 0x76, 0xa5, 0xcc, 0x94, 0x33, 0x38, 0xda, 0xd2, 0x85, 0x3c, 0xae, 0x4e, 0xbc, 0x45,
-	0x31, 0xaf, 0x6f, 0x0a, 0xca, 0x3f, 0x13, 0xf2, 0x1a, 0x0e, 0x0b, 0xd6, 0x6a, 0x75, 0x88, 0x95,
-	0xf7, 0xaa, 0xd5, 0x76, 0xc8, 0xf7, 0x1c, 0x86, 0x33, 0x26, 0x62, 0x9c, 0x09, 0x96, 0x2b, 0xcc,
-	0x13, 0xc3, 0x9f, 0xdd, 0x6f, 0x45, 0x51, 0xbb, 0x0c, 0x5e, 0xfc, 0x0f, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0xb5, 0x05, 0x66, 0x08, 0x05, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5f, 0x6f, 0xd3, 0x3e,
+	0x14, 0x55, 0xfa, 0x77, 0xbd, 0x6d, 0xa7, 0xcd, 0xdd, 0x7e, 0xca, 0x2f, 0xa0, 0x51, 0x45, 0x43,
+	0xf4, 0x01, 0x75, 0x62, 0x80, 0x04, 0x6f, 0xb0, 0x95, 0x4d, 0x08, 0x34, 0x4d, 0x41, 0xec, 0x85,
+	0x07, 0xe4, 0x24, 0x77, 0x5b, 0x20, 0x8d, 0x83, 0xe3, 0x68, 0xda, 0x47, 0xe0, 0x95, 0x8f, 0xc1,
+	0xa7, 0x44, 0x71, 0xec, 0x26, 0x6e, 0x3b, 0xa9, 0x4f, 0xf1, 0x3d, 0xf7, 0x9e, 0xe3, 0xeb, 0xeb,
+	0xe3, 0xc0, 0x28, 0xa4, 0xd9, 0xad, 0xcf, 0x28, 0x0f, 0xbf, 0xd3, 0x34, 0x9a, 0xa6, 0x9c, 0x09,
+	0x46, 0xda, 0xf2, 0xe3, 0x1c, 0xdc, 0x30, 0x76, 0x13, 0xe3, 0x91, 0x8c, 0xfc, 0xfc, 0xfa, 0xe8,
+	0x8e, 0xd3, 0x34, 0x45, 0x9e, 0x95, 0x65, 0x6e, 0x17, 0xda, 0x1f, 0xe6, 0xa9, 0xb8, 0x77, 0xff,
+	0x36, 0x00, 0x3e, 0xe1, 0xbd, 0x87, 0xbf, 0x72, 0xcc, 0x04, 0x79, 0x0c, 0xbd, 0x84, 0xce, 0x31,
+	0x4b, 0x69, 0x80, 0xb6, 0x35, 0xb6, 0x26, 0x3d, 0xaf, 0x02, 0xc8, 0x01, 0x00, 0x4d, 0xa3, 0x2b,
+	0xe4, 0x59, 0xc4, 0x12, 0xbb, 0x21, 0xd3, 0x35, 0x84, 0x10, 0x68, 0xfd, 0x8c, 0x92, 0xd0, 0x6e,
+	0xca, 0x8c, 0x5c, 0x17, 0x58, 0x21, 0x60, 0xb7, 0x4a, 0xac, 0x58, 0x93, 0xf7, 0x30, 0x8c, 0xa9,
+	0x8f, 0xf1, 0x17, 0x8c, 0x31, 0x10, 0x8c, 0xdb, 0xed, 0xb1, 0x35, 0xe9, 0x1f, 0x3f, 0x9a, 0x96,
+	0x5d, 0x4f, 0x75, 0xd7, 0xd3, 0x93, 0x7b, 0x81, 0xd9, 0x15, 0x8d, 0x73, 0xf4, 0x4c, 0x46, 0x21,
+	0x71, 0x1d, 0x61, 0x1c, 0x2e, 0x24, 0x3a, 0x1b, 0x48, 0x18, 0x0c, 0xb2, 0x07, 0xed, 0x38, 0x9a,
+	0x47, 0xc2, 0xee, 0x8e, 0xad, 0x49, 0xd3, 0x2b, 0x03, 0xe2, 0xc0, 0x56, 0xc0, 0x12, 0x11, 0x25,
+	0x39, 0xda, 0x5b, 0xb2, 0xe7, 0x45, 0xec, 0xce, 0x60, 0xf0, 0x39, 0xca, 0x84, 0x87, 0x59, 0xca,
+	0x92, 0x0c, 0x89, 0x0d, 0x5d, 0xe6, 0xff, 0xc0, 0x40, 0x64, 0xb6, 0x35, 0x6e, 0x4e, 0x06, 0x9e,
+	0x0e, 0x0d, 0x95, 0xc6, 0x92, 0xca, 0x53, 0xe8, 0x9f, 0x63, 0x25, 0xf2, 0x1f, 0x74, 0x4a, 0x96,
+	0x9c, 0xf7, 0xc0, 0x53, 0x91, 0xfb, 0x0c, 0x86, 0x5f, 0xd3, 0x90, 0x0a, 0xd4, 0x77, 0xf3, 0x50,
+	0xe1, 0x0e, 0x6c, 0xeb, 0xc2, 0x52, 0xb2, 0xa0, 0x9e, 0x72, 0xdc, 0x8c, 0xaa, 0x0b, 0x15, 0xf5,
+	0x8f, 0x05, 0xe4, 0x92, 0x71, 0x71, 0xc6, 0xf8, 0x1d, 0xe5, 0xe1, 0x66, 0xbe, 0xb0, 0xa1, 0x9b,
+	0xb2, 0xf0, 0x82, 0xce, 0xf5, 0x61, 0x75, 0x48, 0x0e, 0x61, 0x58, 0x9c, 0x9b, 0x46, 0x09, 0x72,
+	0x99, 0x2f, 0xad, 0x61, 0x82, 0x85, 0xaf, 0x52, 0xc6, 0xc5, 0x45, 0x3e, 0xf7, 0x91, 0x4b, 0xa7,
+	0x0c, 0xbd, 0x1a, 0xe2, 0x7e, 0x83, 0x91, 0xd1, 0x93, 0x9a, 0xdc, 0x21, 0x0c, 0xd3, 0x0a, 0xfe,
+	0x38, 0x53, 0x8d, 0x99, 0xe0, 0x92, 0x78, 0x63, 0x45, 0xfc, 0x1d, 0xd8, 0xa7, 0x34, 0x09, 0x30,
+	0x5e, 0x73, 0xec, 0x8d, 0x76, 0x70, 0x5f, 0x01, 0xb9, 0xd0, 0xb3, 0xc8, 0x16, 0xdd, 0x1d, 0x00,
+	0x2c, 0x26, 0x54, 0xfa, 0xa3, 0xe7, 0xd5, 0x10, 0xd7, 0x07, 0x72, 0x99, 0x67, 0xb7, 0xa7, 0x2c,
+	0x11, 0x98, 0x08, 0xbd, 0xe3, 0x18, 0xfa, 0x41, 0x89, 0x5c, 0x52, 0x71, 0xab, 0xf6, 0xab, 0x43,
+	0xc5, 0xb0, 0x55, 0x28, 0x0f, 0x33, 0xf0, 0x74, 0x48, 0x76, 0xa0, 0x89, 0xea, 0xf5, 0x6d, 0x79,
+	0xc5, 0xf2, 0xf8, 0x77, 0x0b, 0x7a, 0x33, 0xfd, 0x97, 0x20, 0x53, 0x68, 0x15, 0xf6, 0x25, 0xbb,
+	0xe5, 0xeb, 0x98, 0x56, 0xef, 0xde, 0x19, 0x29, 0xc8, 0xb0, 0xf7, 0x73, 0x68, 0x9e, 0xe3, 0xda,
+	0x72, 0xa2, 0xa0, 0xba, 0x8f, 0x5f, 0x43, 0xa7, 0xb4, 0x21, 0xd9, 0x53, 0x59, 0xc3, 0xbe, 0xce,
+	0xfe, 0x12, 0x5a, 0xd1, 0x4a, 0x0b, 0x2e, 0x68, 0x86, 0x75, 0x9d, 0xfd, 0x25, 0x54, 0xd1, 0x66,
+	0xd0, 0xaf, 0xdd, 0x17, 0xf9, 0x5f, 0x55, 0xad, 0xde, 0xa1, 0xe3, 0xac, 0x4b, 0x29, 0x95, 0x13,
+	0xd8, 0x5d, 0xb9, 0x7b, 0xf2, 0x44, 0xef, 0xf8, 0x80, 0x2b, 0x9c, 0x81, 0x2a, 0x90, 0x7f, 0x50,
+	0xf2, 0x16, 0xb6, 0x8b, 0xa9, 0x55, 0x0e, 0x20, 0x46, 0xde, 0xd1, 0xad, 0xad, 0xb1, 0xc8, 0x0b,
+	0x18, 0x9d, 0x31, 0x1e, 0xe0, 0x19, 0x97, 0x37, 0x18, 0xaa, 0xf9, 0x99, 0x7c, 0x73, 0xb7, 0x37,
+	0xd0, 0xaf, 0xb9, 0xa6, 0x3a, 0xf7, 0x8a, 0x93, 0x4c, 0xde, 0xc4, 0xf2, 0x3b, 0x32, 0x7c, 0xf9,
+	0x6f, 0x00, 0x8e, 0x98, 0x93, 0x8b, 0x37, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -609,6 +703,7 @@ type DashboardClient interface {
 	CancelPortForward(ctx context.Context, in *CancelPortForwardRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListNamespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error)
 	ForceFrontendUpdate(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	PushContent(ctx context.Context, opts ...grpc.CallOption) (Dashboard_PushContentClient, error)
 }
 
 type dashboardClient struct {
@@ -691,6 +786,40 @@ func (c *dashboardClient) ForceFrontendUpdate(ctx context.Context, in *Empty, op
 	return out, nil
 }
 
+func (c *dashboardClient) PushContent(ctx context.Context, opts ...grpc.CallOption) (Dashboard_PushContentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[0], "/proto.Dashboard/PushContent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dashboardPushContentClient{stream}
+	return x, nil
+}
+
+type Dashboard_PushContentClient interface {
+	Send(*PushContentRequest) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type dashboardPushContentClient struct {
+	grpc.ClientStream
+}
+
+func (x *dashboardPushContentClient) Send(m *PushContentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dashboardPushContentClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DashboardServer is the server API for Dashboard service.
 type DashboardServer interface {
 	List(context.Context, *KeyRequest) (*ListResponse, error)
@@ -701,6 +830,7 @@ type DashboardServer interface {
 	CancelPortForward(context.Context, *CancelPortForwardRequest) (*Empty, error)
 	ListNamespaces(context.Context, *Empty) (*NamespacesResponse, error)
 	ForceFrontendUpdate(context.Context, *Empty) (*Empty, error)
+	PushContent(Dashboard_PushContentServer) error
 }
 
 // UnimplementedDashboardServer can be embedded to have forward compatible implementations.
@@ -731,6 +861,9 @@ func (*UnimplementedDashboardServer) ListNamespaces(ctx context.Context, req *Em
 func (*UnimplementedDashboardServer) ForceFrontendUpdate(ctx context.Context, req *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceFrontendUpdate not implemented")
 }
+func (*UnimplementedDashboardServer) PushContent(srv Dashboard_PushContentServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushContent not implemented")
+}
 
 func RegisterDashboardServer(s *grpc.Server, srv DashboardServer) {
 	s.RegisterService(&_Dashboard_serviceDesc, srv)
@@ -880,6 +1013,32 @@ func _Dashboard_ForceFrontendUpdate_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dashboard_PushContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DashboardServer).PushContent(&dashboardPushContentServer{stream})
+}
+
+type Dashboard_PushContentServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*PushContentRequest, error)
+	grpc.ServerStream
+}
+
+type dashboardPushContentServer struct {
+	grpc.ServerStream
+}
+
+func (x *dashboardPushContentServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dashboardPushContentServer) Recv() (*PushContentRequest, error) {
+	m := new(PushContentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Dashboard_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Dashboard",
 	HandlerType: (*DashboardServer)(nil),
@@ -917,6 +1076,12 @@ var _Dashboard_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Dashboard_ForceFrontendUpdate_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PushContent",
+			Handler:       _Dashboard_PushContent_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "dashboard_api.proto",
 }
@@ -7,7 +7,9 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -17,6 +19,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api/proto"
 	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 // PortForwardRequest describes a port forward request.
@@ -48,6 +51,8 @@ type Service interface {
 	Update(ctx context.Context, object *unstructured.Unstructured) error
 	Create(ctx context.Context, object *unstructured.Unstructured) error
 	ForceFrontendUpdate(ctx context.Context) error
+	PushContent(ctx context.Context, contentPath string, response component.ContentResponse) error
+	EndContentPush(ctx context.Context, contentPath string)
 }
 
 // FrontendUpdateController can control the frontend. ie. the web gui
@@ -55,9 +60,18 @@ type FrontendUpdateController interface {
 	ForceUpdate() error
 }
 
+// ContentPusher forwards content pushed by plugins to the frontend.
+type ContentPusher interface {
+	// PushContent sends content to clients viewing a content path.
+	PushContent(contentPath string, response component.ContentResponse)
+	// EndContentPush stops using pushed content for a content path.
+	EndContentPush(contentPath string)
+}
+
 // FrontendProxy is a proxy for messaging the frontend.
 type FrontendProxy struct {
 	FrontendUpdateController FrontendUpdateController
+	ContentPusher            ContentPusher
 }
 
 // ForceFrontendUpdate forces the frontend to update
@@ -69,6 +83,25 @@ func (proxy *FrontendProxy) ForceFrontendUpdate() error {
 	return proxy.FrontendUpdateController.ForceUpdate()
 }
 
+// PushContent pushes content for a content path to the frontend.
+func (proxy *FrontendProxy) PushContent(contentPath string, response component.ContentResponse) error {
+	if proxy.ContentPusher == nil {
+		return fmt.Errorf("frontend does not accept pushed content")
+	}
+
+	proxy.ContentPusher.PushContent(contentPath, response)
+	return nil
+}
+
+// EndContentPush ends pushed content for a content path.
+func (proxy *FrontendProxy) EndContentPush(contentPath string) {
+	if proxy.ContentPusher == nil {
+		return
+	}
+
+	proxy.ContentPusher.EndContentPush(contentPath)
+}
+
 // GRPCService is an implementation of the dashboard service based on GRPC.
 type GRPCService struct {
 	ObjectStore        store.Store
@@ -144,14 +177,21 @@ func (s *GRPCService) ForceFrontendUpdate(ctx context.Context) error {
 	return s.FrontendProxy.ForceFrontendUpdate()
 }
 
-func NewGRPCServer(service Service) *grpcServer {
-	return &grpcServer{
-		service: service,
-	}
+// PushContent sends content for a content path to the clients viewing it.
+func (s *GRPCService) PushContent(ctx context.Context, contentPath string, response component.ContentResponse) error {
+	return s.FrontendProxy.PushContent(contentPath, response)
+}
+
+// EndContentPush ends pushed content for a content path. Clients viewing it go back to polling.
+func (s *GRPCService) EndContentPush(ctx context.Context, contentPath string) {
+	s.FrontendProxy.EndContentPush(contentPath)
 }
 
+// grpcServer serves the dashboard API. It is created by the API which issues
+// plugin tokens, so it shares the content roots the tokens may push content under.
 type grpcServer struct {
 	service Service
+	roots   *contentRoots
 }
 
 var _ proto.DashboardServer = (*grpcServer)(nil)
@@ -174,7 +214,8 @@ func (c *grpcServer) List(ctx context.Context, in *proto.KeyRequest) (*proto.Lis
 	}
 
 	out := &proto.ListResponse{
-		Objects: encodedObjects,
+		Objects:  encodedObjects,
+		Continue: objects.GetContinue(),
 	}
 
 	return out, nil
@@ -297,3 +338,53 @@ func (c *grpcServer) ForceFrontendUpdate(ctx context.Context, _ *proto.Empty) (*
 
 	return &proto.Empty{}, nil
 }
+
+// PushContent receives content from a plugin and forwards it to the frontend. Pushed
+// content for a path is used until the plugin ends it or the stream ends. A plugin can
+// only push content under the content root of the token it sends.
+func (c *grpcServer) PushContent(stream proto.Dashboard_PushContentServer) error {
+	ctx := stream.Context()
+	token := tokenFromContext(ctx)
+
+	contentPaths := make(map[string]bool)
+	defer func() {
+		for contentPath := range contentPaths {
+			c.service.EndContentPush(ctx, contentPath)
+		}
+	}()
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&proto.Empty{})
+		}
+		if err != nil {
+			return err
+		}
+
+		if in.ContentPath == "" {
+			return errors.New("content path is required")
+		}
+
+		if !c.roots.allows(token, in.ContentPath) {
+			return errors.Errorf("plugin can't push content for %s", in.ContentPath)
+		}
+
+		if in.End {
+			c.service.EndContentPush(ctx, in.ContentPath)
+			delete(contentPaths, in.ContentPath)
+			continue
+		}
+
+		var response component.ContentResponse
+		if err := json.Unmarshal(in.Content, &response); err != nil {
+			return fmt.Errorf("unmarshal content for %s: %w", in.ContentPath, err)
+		}
+
+		if err := c.service.PushContent(ctx, in.ContentPath, response); err != nil {
+			return err
+		}
+
+		contentPaths[in.ContentPath] = true
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// tokenMetadataKey is the gRPC metadata key a plugin sends its token in.
+const tokenMetadataKey = "octant-plugin-token"
+
+// contentRoots tracks the tokens issued to plugins and the content root each
+// token may push content under.
+type contentRoots struct {
+	mu    sync.RWMutex
+	roots map[string]string
+}
+
+func newContentRoots() *contentRoots {
+	return &contentRoots{
+		roots: make(map[string]string),
+	}
+}
+
+// issue creates a token. The token can't push content until it is given a
+// content root.
+func (c *contentRoots) issue() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	token := hex.EncodeToString(b)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.roots[token] = ""
+	return token, nil
+}
+
+// set lets token push content under contentRoot.
+func (c *contentRoots) set(token, contentRoot string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.roots[token] = contentRoot
+}
+
+// revoke removes token.
+func (c *contentRoots) revoke(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.roots, token)
+}
+
+// allows returns true if token may push content for contentPath.
+func (c *contentRoots) allows(token, contentPath string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	contentRoot := c.roots[token]
+	if contentRoot == "" {
+		return false
+	}
+
+	return contentPath == contentRoot || strings.HasPrefix(contentPath, contentRoot+"/")
+}
+
+// tokenFromContext returns the token a plugin sent with a request.
+func tokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(tokenMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
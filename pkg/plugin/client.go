@@ -7,12 +7,15 @@ package plugin
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -37,6 +40,32 @@ type Capabilities struct {
 	IsModule bool `json:",omitempty"`
 	// ActionNames is a list of action names this plugin handles
 	ActionNames []string `json:",omitempty"`
+	// SupportsListColumns are the GVKs the plugin will add list table columns for.
+	SupportsListColumns []schema.GroupVersionKind `json:",omitempty"`
+	// SupportsRelatedObjects are the GVKs the plugin will find related objects for.
+	SupportsRelatedObjects []schema.GroupVersionKind `json:",omitempty"`
+	// ObjectActions are actions the plugin adds to object detail pages and list rows.
+	ObjectActions []ObjectAction `json:",omitempty"`
+	// NavigationContributions are navigation entries a module plugin adds to sections
+	// generated by other modules. Their paths are relative to the plugin's content path.
+	NavigationContributions []navigation.Contribution `json:",omitempty"`
+}
+
+// ObjectAction is an action a plugin adds to the detail page and list table
+// rows of objects. When it is invoked, the dashboard dispatches it to the
+// plugin's HandleAction with the object's key in the payload.
+type ObjectAction struct {
+	// ActionName is the name of the action the plugin handles.
+	ActionName string `json:"actionName"`
+	// Label is the label shown to the user.
+	Label string `json:"label"`
+	// Confirmation is text shown to the user before the action is invoked. It is optional.
+	Confirmation string `json:"confirmation,omitempty"`
+	// Form is a form shown to the user before the action is invoked. Its values are
+	// added to the payload. It is optional.
+	Form *component.Form `json:"form,omitempty"`
+	// SupportedGVKs are the GVKs the action is added to.
+	SupportedGVKs []schema.GroupVersionKind `json:"supportedGVKs"`
 }
 
 // HasPrinterSupport returns true if this plugin supports the supplied GVK.
@@ -58,6 +87,116 @@ func (c Capabilities) HasObjectStatusSupport(gvk schema.GroupVersionKind) bool {
 	return includesGVK(gvk, c.SupportsObjectStatus)
 }
 
+// HasListColumnsSupport returns true if this plugin supports adding list table
+// columns for the supplied GVK.
+func (c Capabilities) HasListColumnsSupport(gvk schema.GroupVersionKind) bool {
+	return includesGVK(gvk, c.SupportsListColumns)
+}
+
+// HasRelatedObjectsSupport returns true if this plugin supports finding related
+// objects for the supplied GVK.
+func (c Capabilities) HasRelatedObjectsSupport(gvk schema.GroupVersionKind) bool {
+	return includesGVK(gvk, c.SupportsRelatedObjects)
+}
+
+// ObjectActionsFor returns the object actions for the supplied GVK.
+func (c Capabilities) ObjectActionsFor(gvk schema.GroupVersionKind) []ObjectAction {
+	var list []ObjectAction
+
+	for _, objectAction := range c.ObjectActions {
+		if includesGVK(gvk, objectAction.SupportedGVKs) {
+			list = append(list, objectAction)
+		}
+	}
+
+	return list
+}
+
+// HandledActionNames returns the names of all actions the plugin handles. This
+// includes the action names for object actions.
+func (c Capabilities) HandledActionNames() []string {
+	list := append([]string{}, c.ActionNames...)
+
+	seen := make(map[string]bool)
+	for _, actionName := range list {
+		seen[actionName] = true
+	}
+
+	for _, objectAction := range c.ObjectActions {
+		if seen[objectAction.ActionName] {
+			continue
+		}
+		seen[objectAction.ActionName] = true
+		list = append(list, objectAction.ActionName)
+	}
+
+	return list
+}
+
+// Summary returns a human readable summary of the capabilities. Each item describes
+// one capability.
+func (c Capabilities) Summary() []string {
+	var summaryItems []string
+	if c.IsModule {
+		summaryItems = append(summaryItems, "Module")
+	}
+
+	if actionNames := c.ActionNames; len(actionNames) > 0 {
+		summaryItems = append(summaryItems, fmt.Sprintf("Actions: %s",
+			strings.Join(actionNames, ", ")))
+	}
+
+	in := []struct {
+		name string
+		list []schema.GroupVersionKind
+	}{
+		{name: "Object Status", list: c.SupportsObjectStatus},
+		{name: "Printer Config", list: c.SupportsPrinterConfig},
+		{name: "Printer Items", list: c.SupportsPrinterItems},
+		{name: "Printer Status", list: c.SupportsPrinterStatus},
+		{name: "Tab", list: c.SupportsTab},
+		{name: "List Columns", list: c.SupportsListColumns},
+		{name: "Related Objects", list: c.SupportsRelatedObjects},
+	}
+
+	for _, item := range in {
+		support, ok := summarizeSupports(item.name, item.list)
+		if ok {
+			summaryItems = append(summaryItems, support)
+		}
+	}
+
+	for _, objectAction := range c.ObjectActions {
+		support, ok := summarizeSupports(fmt.Sprintf("Object Action %q", objectAction.Label), objectAction.SupportedGVKs)
+		if ok {
+			summaryItems = append(summaryItems, support)
+		}
+	}
+
+	for _, contribution := range c.NavigationContributions {
+		summaryItems = append(summaryItems, fmt.Sprintf("Navigation: %s > %s",
+			contribution.Section, contribution.Title))
+	}
+
+	return summaryItems
+}
+
+func summarizeSupports(name string, list []schema.GroupVersionKind) (string, bool) {
+	if len(list) < 1 {
+		return "", false
+	}
+
+	var items []string
+	for _, groupVersionKind := range list {
+		apiVersion, kind := groupVersionKind.ToAPIVersionAndKind()
+		items = append(items, fmt.Sprintf("%s %s", apiVersion, kind))
+	}
+
+	return fmt.Sprintf("%s: %s",
+		name, strings.Join(items, ", "),
+	), true
+}
+
 // PrintResponse is a printer response from the plugin. The dashboard
 // will use this to the add the plugin's output to a summary view.
 type PrintResponse struct {
@@ -76,6 +215,31 @@ type TabResponse struct {
 	Tab *component.Tab `json:"tab"`
 }
 
+// ListColumn is a column value a plugin adds to the row for an object in
+// a list table.
+type ListColumn struct {
+	// Name is the name of the column.
+	Name string `json:"name"`
+	// Value is the content of the column for the object.
+	Value component.Component `json:"value"`
+}
+
+// ListColumnsResponse is a list columns response from the plugin. The
+// dashboard will use this to add columns to an object's row in a list table.
+// Plugins are sent all the objects in a list at once and return a response
+// for each object, in the same order.
+type ListColumnsResponse struct {
+	// Columns are the additional columns for the object.
+	Columns []ListColumn `json:"columns"`
+}
+
+// RelatedObjectsResponse is a related objects response from the plugin. The
+// dashboard will use this to add edges to the resource viewer for an object.
+type RelatedObjectsResponse struct {
+	// Keys are the keys of objects related to the object.
+	Keys []store.Key `json:"keys"`
+}
+
 // ObjectStatusResponse is an object status response from plugin.
 type ObjectStatusResponse struct {
 	// ObjectStatus is status of an object.
@@ -95,10 +259,39 @@ type Service interface {
 	Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error)
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error)
+	PrintListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error)
+	RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
 	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
 }
 
+// Registration is what Octant sends a plugin when it registers the plugin.
+type Registration struct {
+	// DashboardAPIAddress is the address of the dashboard API.
+	DashboardAPIAddress string
+	// DashboardAPIToken identifies the plugin to the dashboard API.
+	DashboardAPIToken string
+	// Configuration is the plugin's configuration.
+	Configuration Configuration
+}
+
+// RegistrationService is implemented by plugins which accept a dashboard API token
+// and their configuration when they register. It is optional, so plugins which only
+// implement Service keep working. They are registered with the dashboard API address.
+type RegistrationService interface {
+	RegisterWith(ctx context.Context, registration Registration) (Metadata, error)
+}
+
+// register registers a plugin with RegisterWith if it implements RegistrationService,
+// and with Register otherwise.
+func register(ctx context.Context, service Service, registration Registration) (Metadata, error) {
+	if registrationService, ok := service.(RegistrationService); ok {
+		return registrationService.RegisterWith(ctx, registration)
+	}
+
+	return service.Register(ctx, registration.DashboardAPIAddress)
+}
+
 // ModuleService is the interface that is exposed as a plugin as a module. The plugin is required to implement this
 // interface.
 type ModuleService interface {
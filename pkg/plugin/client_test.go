@@ -77,3 +77,68 @@ func TestCapabilities_HasTabSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestCapabilities_HasListColumnsSupport(t *testing.T) {
+	cases := []struct {
+		name         string
+		in           schema.GroupVersionKind
+		capabilities Capabilities
+		hasSupport   bool
+	}{
+		{
+			name: "with list columns support",
+			in:   gvk.Pod,
+			capabilities: Capabilities{
+				SupportsListColumns: []schema.GroupVersionKind{gvk.Pod},
+			},
+			hasSupport: true,
+		},
+		{
+			name: "with out list columns support",
+			in:   gvk.Deployment,
+			capabilities: Capabilities{
+				SupportsListColumns: []schema.GroupVersionKind{gvk.Pod},
+			},
+			hasSupport: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.hasSupport, tc.capabilities.HasListColumnsSupport(tc.in))
+		})
+	}
+}
+
+func TestCapabilities_ObjectActionsFor(t *testing.T) {
+	restart := ObjectAction{
+		ActionName:    "restart",
+		Label:         "Restart",
+		SupportedGVKs: []schema.GroupVersionKind{gvk.Pod, gvk.Deployment},
+	}
+	scale := ObjectAction{
+		ActionName:    "scale",
+		Label:         "Scale",
+		SupportedGVKs: []schema.GroupVersionKind{gvk.Deployment},
+	}
+
+	capabilities := Capabilities{
+		ObjectActions: []ObjectAction{restart, scale},
+	}
+
+	assert.Equal(t, []ObjectAction{restart}, capabilities.ObjectActionsFor(gvk.Pod))
+	assert.Equal(t, []ObjectAction{restart, scale}, capabilities.ObjectActionsFor(gvk.Deployment))
+	assert.Empty(t, capabilities.ObjectActionsFor(gvk.Service))
+}
+
+func TestCapabilities_HandledActionNames(t *testing.T) {
+	capabilities := Capabilities{
+		ActionNames: []string{"action1", "restart"},
+		ObjectActions: []ObjectAction{
+			{ActionName: "restart"},
+			{ActionName: "scale"},
+		},
+	}
+
+	assert.Equal(t, []string{"action1", "restart", "scale"}, capabilities.HandledActionNames())
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+// Configuration is configuration for a plugin. It is a set of named settings.
+type Configuration map[string]string
+
+// Names returns the sorted names of the settings in the configuration.
+func (c Configuration) Names() []string {
+	var list []string
+	for name := range c {
+		list = append(list, name)
+	}
+
+	sort.Strings(list)
+
+	return list
+}
+
+// ToActionPayload converts the configuration to an action payload.
+func (c Configuration) ToActionPayload() action.Payload {
+	payload := action.Payload{}
+	for name, value := range c {
+		payload[name] = value
+	}
+
+	return payload
+}
+
+// ConfigurationKey returns the key used to look up the configuration for a plugin. Plugins
+// are keyed by the name of their file, including any extension, because the configuration
+// is passed to a plugin when it registers, before its metadata name is known.
+func ConfigurationKey(pluginName string) string {
+	return filepath.Base(pluginName)
+}
+
+// configFile is the plugin section of Octant's configuration file.
+type configFile struct {
+	Plugins map[string]map[string]interface{} `json:"plugins"`
+}
+
+// LoadConfigurations loads plugin configurations from Octant's configuration file. Configurations
+// are keyed by plugin file name. A missing configuration file is not an error.
+func LoadConfigurations(config Config) (map[string]Configuration, error) {
+	if config == nil {
+		return nil, fmt.Errorf("config is nil")
+	}
+
+	configurations := make(map[string]Configuration)
+
+	path := config.ConfigFile(config.Home())
+	if path == "" {
+		return configurations, nil
+	}
+
+	data, err := afero.ReadFile(config.Fs(), path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return configurations, nil
+		}
+		return nil, fmt.Errorf("read configuration file: %w", err)
+	}
+
+	var cf configFile
+	if err := yaml.Unmarshal(data, &cf); err != nil {
+		return nil, fmt.Errorf("parse configuration file %q: %w", path, err)
+	}
+
+	for name, settings := range cf.Plugins {
+		configuration := Configuration{}
+		for k, v := range settings {
+			configuration[k] = fmt.Sprint(v)
+		}
+		configurations[name] = configuration
+	}
+
+	return configurations, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+func TestLoadConfigurations(t *testing.T) {
+	homePath := filepath.Join("/home", "user")
+	configPath := filepath.Join(homePath, ".config", "octant", "config.yaml")
+
+	tests := []struct {
+		name     string
+		contents string
+		expected map[string]Configuration
+		wantErr  bool
+	}{
+		{
+			name:     "no configuration file",
+			expected: map[string]Configuration{},
+		},
+		{
+			name: "plugin configurations",
+			contents: `
+plugins:
+  plugin-a:
+    endpoint: https://example.com
+    interval: 30
+  plugin-b:
+    enabled: true
+`,
+			expected: map[string]Configuration{
+				"plugin-a": {"endpoint": "https://example.com", "interval": "30"},
+				"plugin-b": {"enabled": "true"},
+			},
+		},
+		{
+			name:     "invalid configuration file",
+			contents: "plugins: [",
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if test.contents != "" {
+				require.NoError(t, afero.WriteFile(fs, configPath, []byte(test.contents), 0600))
+			}
+
+			c := &defaultConfig{
+				fs: fs,
+				os: "unix",
+				homeFn: func() string {
+					return homePath
+				},
+			}
+
+			got, err := LoadConfigurations(c)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestConfiguration_Names(t *testing.T) {
+	c := Configuration{"b": "2", "a": "1"}
+	assert.Equal(t, []string{"a", "b"}, c.Names())
+}
+
+func TestConfiguration_ToActionPayload(t *testing.T) {
+	c := Configuration{"a": "1"}
+	assert.Equal(t, action.Payload{"a": "1"}, c.ToActionPayload())
+}
+
+func TestConfigurationKey(t *testing.T) {
+	assert.Equal(t, "plugin-a", ConfigurationKey(filepath.Join("/plugins", "plugin-a")))
+}
@@ -12,39 +12,139 @@ import (
 
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
-func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabilities {
+func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) (Capabilities, error) {
 	if in == nil {
-		return Capabilities{}
+		return Capabilities{}, nil
+	}
+
+	objectActions, err := convertToObjectActions(in.ObjectActions)
+	if err != nil {
+		return Capabilities{}, err
 	}
 
 	c := Capabilities{
-		SupportsPrinterStatus: convertToGroupVersionKindList(in.SupportsPrinterStatus),
-		SupportsPrinterConfig: convertToGroupVersionKindList(in.SupportsPrinterConfig),
-		SupportsPrinterItems:  convertToGroupVersionKindList(in.SupportsPrinterItems),
-		SupportsObjectStatus:  convertToGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsTab:           convertToGroupVersionKindList(in.SupportsTab),
-		IsModule:              in.IsModule,
-		ActionNames:           in.ActionNames,
+		SupportsPrinterStatus:   convertToGroupVersionKindList(in.SupportsPrinterStatus),
+		SupportsPrinterConfig:   convertToGroupVersionKindList(in.SupportsPrinterConfig),
+		SupportsPrinterItems:    convertToGroupVersionKindList(in.SupportsPrinterItems),
+		SupportsObjectStatus:    convertToGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsTab:             convertToGroupVersionKindList(in.SupportsTab),
+		IsModule:                in.IsModule,
+		ActionNames:             in.ActionNames,
+		SupportsListColumns:     convertToGroupVersionKindList(in.SupportsListColumns),
+		SupportsRelatedObjects:  convertToGroupVersionKindList(in.SupportsRelatedObjects),
+		ObjectActions:           objectActions,
+		NavigationContributions: convertToNavigationContributions(in.NavigationContributions),
 	}
 
-	return c
+	return c, nil
 }
 
-func convertFromCapabilities(in Capabilities) dashboard.RegisterResponse_Capabilities {
+func convertFromCapabilities(in Capabilities) (dashboard.RegisterResponse_Capabilities, error) {
+	objectActions, err := convertFromObjectActions(in.ObjectActions)
+	if err != nil {
+		return dashboard.RegisterResponse_Capabilities{}, err
+	}
+
 	c := dashboard.RegisterResponse_Capabilities{
-		SupportsPrinterStatus: convertFromGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsPrinterConfig: convertFromGroupVersionKindList(in.SupportsPrinterConfig),
-		SupportsPrinterItems:  convertFromGroupVersionKindList(in.SupportsPrinterItems),
-		SupportsObjectStatus:  convertFromGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsTab:           convertFromGroupVersionKindList(in.SupportsTab),
-		IsModule:              in.IsModule,
-		ActionNames:           in.ActionNames,
+		SupportsPrinterStatus:   convertFromGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsPrinterConfig:   convertFromGroupVersionKindList(in.SupportsPrinterConfig),
+		SupportsPrinterItems:    convertFromGroupVersionKindList(in.SupportsPrinterItems),
+		SupportsObjectStatus:    convertFromGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsTab:             convertFromGroupVersionKindList(in.SupportsTab),
+		IsModule:                in.IsModule,
+		ActionNames:             in.ActionNames,
+		SupportsListColumns:     convertFromGroupVersionKindList(in.SupportsListColumns),
+		SupportsRelatedObjects:  convertFromGroupVersionKindList(in.SupportsRelatedObjects),
+		ObjectActions:           objectActions,
+		NavigationContributions: convertFromNavigationContributions(in.NavigationContributions),
 	}
 
-	return c
+	return c, nil
+}
+
+func convertToNavigationContributions(in []*dashboard.RegisterResponse_NavigationContribution) []navigation.Contribution {
+	var list []navigation.Contribution
+
+	for _, contribution := range in {
+		list = append(list, navigation.Contribution{
+			Module:   contribution.Module,
+			Section:  contribution.Section,
+			Title:    contribution.Title,
+			Path:     contribution.Path,
+			IconName: contribution.IconName,
+		})
+	}
+
+	return list
+}
+
+func convertFromNavigationContributions(in []navigation.Contribution) []*dashboard.RegisterResponse_NavigationContribution {
+	var list []*dashboard.RegisterResponse_NavigationContribution
+
+	for _, contribution := range in {
+		list = append(list, &dashboard.RegisterResponse_NavigationContribution{
+			Module:   contribution.Module,
+			Section:  contribution.Section,
+			Title:    contribution.Title,
+			Path:     contribution.Path,
+			IconName: contribution.IconName,
+		})
+	}
+
+	return list
+}
+
+func convertToObjectActions(in []*dashboard.RegisterResponse_ObjectAction) ([]ObjectAction, error) {
+	var list []ObjectAction
+
+	for _, objectAction := range in {
+		var form *component.Form
+		if len(objectAction.Form) > 0 {
+			form = &component.Form{}
+			if err := json.Unmarshal(objectAction.Form, form); err != nil {
+				return nil, err
+			}
+		}
+
+		list = append(list, ObjectAction{
+			ActionName:    objectAction.ActionName,
+			Label:         objectAction.Label,
+			Confirmation:  objectAction.Confirmation,
+			Form:          form,
+			SupportedGVKs: convertToGroupVersionKindList(objectAction.SupportedGVKs),
+		})
+	}
+
+	return list, nil
+}
+
+func convertFromObjectActions(in []ObjectAction) ([]*dashboard.RegisterResponse_ObjectAction, error) {
+	var list []*dashboard.RegisterResponse_ObjectAction
+
+	for _, objectAction := range in {
+		var form []byte
+		if objectAction.Form != nil {
+			data, err := json.Marshal(objectAction.Form)
+			if err != nil {
+				return nil, err
+			}
+			form = data
+		}
+
+		list = append(list, &dashboard.RegisterResponse_ObjectAction{
+			ActionName:    objectAction.ActionName,
+			Label:         objectAction.Label,
+			Confirmation:  objectAction.Confirmation,
+			Form:          form,
+			SupportedGVKs: convertFromGroupVersionKindList(objectAction.SupportedGVKs),
+		})
+	}
+
+	return list, nil
 }
 
 func convertToGroupVersionKindList(in []*dashboard.RegisterResponse_GroupVersionKind) []schema.GroupVersionKind {
@@ -174,3 +274,74 @@ func convertFromSummarySection(in component.SummarySection) (*dashboard.PrintRes
 		Component: data,
 	}, nil
 }
+
+func convertToListColumns(in []*dashboard.PrintListColumnsResponse_Column) ([]ListColumn, error) {
+	var list []ListColumn
+
+	for _, column := range in {
+		var typedObject component.TypedObject
+		if err := json.Unmarshal(column.Component, &typedObject); err != nil {
+			return nil, err
+		}
+
+		view, err := typedObject.ToComponent()
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, ListColumn{
+			Name:  column.Name,
+			Value: view,
+		})
+	}
+
+	return list, nil
+}
+
+func convertFromListColumns(in []ListColumn) ([]*dashboard.PrintListColumnsResponse_Column, error) {
+	var list []*dashboard.PrintListColumnsResponse_Column
+
+	for _, column := range in {
+		data, err := json.Marshal(column.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, &dashboard.PrintListColumnsResponse_Column{
+			Name:      column.Name,
+			Component: data,
+		})
+	}
+
+	return list, nil
+}
+
+func convertToRelatedObjectKeys(in []*dashboard.RelatedObjectsResponse_Key) []store.Key {
+	var list []store.Key
+
+	for _, key := range in {
+		list = append(list, store.Key{
+			Namespace:  key.Namespace,
+			APIVersion: key.ApiVersion,
+			Kind:       key.Kind,
+			Name:       key.Name,
+		})
+	}
+
+	return list
+}
+
+func convertFromRelatedObjectKeys(in []store.Key) []*dashboard.RelatedObjectsResponse_Key {
+	var list []*dashboard.RelatedObjectsResponse_Key
+
+	for _, key := range in {
+		list = append(list, &dashboard.RelatedObjectsResponse_Key{
+			Namespace:  key.Namespace,
+			ApiVersion: key.APIVersion,
+			Kind:       key.Kind,
+			Name:       key.Name,
+		})
+	}
+
+	return list
+}
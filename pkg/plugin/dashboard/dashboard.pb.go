@@ -346,11 +346,20 @@ func (m *NavigationResponse_Navigation) GetIconName() string {
 	return ""
 }
 
+func (m *NavigationResponse_Navigation) GetIconSource() string {
+	if m != nil {
+		return m.IconSource
+	}
+	return ""
+}
+
 type RegisterRequest struct {
-	DashboardAPIAddress  string   `protobuf:"bytes,1,opt,name=dashboardAPIAddress,proto3" json:"dashboardAPIAddress,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	DashboardAPIAddress  string            `protobuf:"bytes,1,opt,name=dashboardAPIAddress,proto3" json:"dashboardAPIAddress,omitempty"`
+	Configuration        map[string]string `protobuf:"bytes,2,rep,name=configuration,proto3" json:"configuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DashboardAPIToken    string            `protobuf:"bytes,3,opt,name=dashboardAPIToken,proto3" json:"dashboardAPIToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
@@ -385,6 +394,20 @@ func (m *RegisterRequest) GetDashboardAPIAddress() string {
 	return ""
 }
 
+func (m *RegisterRequest) GetConfiguration() map[string]string {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+func (m *RegisterRequest) GetDashboardAPIToken() string {
+	if m != nil {
+		return m.DashboardAPIToken
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	PluginName           string                         `protobuf:"bytes,1,opt,name=pluginName,proto3" json:"pluginName,omitempty"`
 	Description          string                         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -495,24 +518,172 @@ func (m *RegisterResponse_GroupVersionKind) GetKind() string {
 	return ""
 }
 
+type RegisterResponse_ObjectAction struct {
+	ActionName           string                               `protobuf:"bytes,1,opt,name=actionName,proto3" json:"actionName,omitempty"`
+	Label                string                               `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Confirmation         string                               `protobuf:"bytes,3,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	Form                 []byte                               `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	SupportedGVKs        []*RegisterResponse_GroupVersionKind `protobuf:"bytes,5,rep,name=supportedGVKs,proto3" json:"supportedGVKs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *RegisterResponse_ObjectAction) Reset()         { *m = RegisterResponse_ObjectAction{} }
+func (m *RegisterResponse_ObjectAction) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse_ObjectAction) ProtoMessage()    {}
+func (*RegisterResponse_ObjectAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{8, 1}
+}
+
+func (m *RegisterResponse_ObjectAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResponse_ObjectAction.Unmarshal(m, b)
+}
+func (m *RegisterResponse_ObjectAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResponse_ObjectAction.Marshal(b, m, deterministic)
+}
+func (m *RegisterResponse_ObjectAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse_ObjectAction.Merge(m, src)
+}
+func (m *RegisterResponse_ObjectAction) XXX_Size() int {
+	return xxx_messageInfo_RegisterResponse_ObjectAction.Size(m)
+}
+func (m *RegisterResponse_ObjectAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse_ObjectAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResponse_ObjectAction proto.InternalMessageInfo
+
+func (m *RegisterResponse_ObjectAction) GetActionName() string {
+	if m != nil {
+		return m.ActionName
+	}
+	return ""
+}
+
+func (m *RegisterResponse_ObjectAction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *RegisterResponse_ObjectAction) GetConfirmation() string {
+	if m != nil {
+		return m.Confirmation
+	}
+	return ""
+}
+
+func (m *RegisterResponse_ObjectAction) GetForm() []byte {
+	if m != nil {
+		return m.Form
+	}
+	return nil
+}
+
+func (m *RegisterResponse_ObjectAction) GetSupportedGVKs() []*RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.SupportedGVKs
+	}
+	return nil
+}
+
+type RegisterResponse_NavigationContribution struct {
+	Module               string   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Section              string   `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Path                 string   `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	IconName             string   `protobuf:"bytes,5,opt,name=iconName,proto3" json:"iconName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResponse_NavigationContribution) Reset() {
+	*m = RegisterResponse_NavigationContribution{}
+}
+func (m *RegisterResponse_NavigationContribution) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse_NavigationContribution) ProtoMessage()    {}
+func (*RegisterResponse_NavigationContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{8, 2}
+}
+
+func (m *RegisterResponse_NavigationContribution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResponse_NavigationContribution.Unmarshal(m, b)
+}
+func (m *RegisterResponse_NavigationContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResponse_NavigationContribution.Marshal(b, m, deterministic)
+}
+func (m *RegisterResponse_NavigationContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse_NavigationContribution.Merge(m, src)
+}
+func (m *RegisterResponse_NavigationContribution) XXX_Size() int {
+	return xxx_messageInfo_RegisterResponse_NavigationContribution.Size(m)
+}
+func (m *RegisterResponse_NavigationContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse_NavigationContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResponse_NavigationContribution proto.InternalMessageInfo
+
+func (m *RegisterResponse_NavigationContribution) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *RegisterResponse_NavigationContribution) GetSection() string {
+	if m != nil {
+		return m.Section
+	}
+	return ""
+}
+
+func (m *RegisterResponse_NavigationContribution) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RegisterResponse_NavigationContribution) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RegisterResponse_NavigationContribution) GetIconName() string {
+	if m != nil {
+		return m.IconName
+	}
+	return ""
+}
+
 type RegisterResponse_Capabilities struct {
-	SupportsPrinterConfig []*RegisterResponse_GroupVersionKind `protobuf:"bytes,1,rep,name=supportsPrinterConfig,proto3" json:"supportsPrinterConfig,omitempty"`
-	SupportsPrinterStatus []*RegisterResponse_GroupVersionKind `protobuf:"bytes,2,rep,name=supportsPrinterStatus,proto3" json:"supportsPrinterStatus,omitempty"`
-	SupportsPrinterItems  []*RegisterResponse_GroupVersionKind `protobuf:"bytes,3,rep,name=supportsPrinterItems,proto3" json:"supportsPrinterItems,omitempty"`
-	SupportsObjectStatus  []*RegisterResponse_GroupVersionKind `protobuf:"bytes,4,rep,name=supportsObjectStatus,proto3" json:"supportsObjectStatus,omitempty"`
-	SupportsTab           []*RegisterResponse_GroupVersionKind `protobuf:"bytes,5,rep,name=supportsTab,proto3" json:"supportsTab,omitempty"`
-	IsModule              bool                                 `protobuf:"varint,6,opt,name=isModule,proto3" json:"isModule,omitempty"`
-	ActionNames           []string                             `protobuf:"bytes,7,rep,name=action_names,json=actionNames,proto3" json:"action_names,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
-	XXX_unrecognized      []byte                               `json:"-"`
-	XXX_sizecache         int32                                `json:"-"`
+	SupportsPrinterConfig   []*RegisterResponse_GroupVersionKind       `protobuf:"bytes,1,rep,name=supportsPrinterConfig,proto3" json:"supportsPrinterConfig,omitempty"`
+	SupportsPrinterStatus   []*RegisterResponse_GroupVersionKind       `protobuf:"bytes,2,rep,name=supportsPrinterStatus,proto3" json:"supportsPrinterStatus,omitempty"`
+	SupportsPrinterItems    []*RegisterResponse_GroupVersionKind       `protobuf:"bytes,3,rep,name=supportsPrinterItems,proto3" json:"supportsPrinterItems,omitempty"`
+	SupportsObjectStatus    []*RegisterResponse_GroupVersionKind       `protobuf:"bytes,4,rep,name=supportsObjectStatus,proto3" json:"supportsObjectStatus,omitempty"`
+	SupportsTab             []*RegisterResponse_GroupVersionKind       `protobuf:"bytes,5,rep,name=supportsTab,proto3" json:"supportsTab,omitempty"`
+	IsModule                bool                                       `protobuf:"varint,6,opt,name=isModule,proto3" json:"isModule,omitempty"`
+	ActionNames             []string                                   `protobuf:"bytes,7,rep,name=action_names,json=actionNames,proto3" json:"action_names,omitempty"`
+	SupportsListColumns     []*RegisterResponse_GroupVersionKind       `protobuf:"bytes,8,rep,name=supportsListColumns,proto3" json:"supportsListColumns,omitempty"`
+	SupportsRelatedObjects  []*RegisterResponse_GroupVersionKind       `protobuf:"bytes,9,rep,name=supportsRelatedObjects,proto3" json:"supportsRelatedObjects,omitempty"`
+	ObjectActions           []*RegisterResponse_ObjectAction           `protobuf:"bytes,10,rep,name=objectActions,proto3" json:"objectActions,omitempty"`
+	NavigationContributions []*RegisterResponse_NavigationContribution `protobuf:"bytes,11,rep,name=navigationContributions,proto3" json:"navigationContributions,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                                   `json:"-"`
+	XXX_unrecognized        []byte                                     `json:"-"`
+	XXX_sizecache           int32                                      `json:"-"`
 }
 
 func (m *RegisterResponse_Capabilities) Reset()         { *m = RegisterResponse_Capabilities{} }
 func (m *RegisterResponse_Capabilities) String() string { return proto.CompactTextString(m) }
 func (*RegisterResponse_Capabilities) ProtoMessage()    {}
 func (*RegisterResponse_Capabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{8, 1}
+	return fileDescriptor_9b97678da3a35dfb, []int{8, 3}
 }
 
 func (m *RegisterResponse_Capabilities) XXX_Unmarshal(b []byte) error {
@@ -582,6 +753,34 @@ func (m *RegisterResponse_Capabilities) GetActionNames() []string {
 	return nil
 }
 
+func (m *RegisterResponse_Capabilities) GetSupportsListColumns() []*RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.SupportsListColumns
+	}
+	return nil
+}
+
+func (m *RegisterResponse_Capabilities) GetSupportsRelatedObjects() []*RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.SupportsRelatedObjects
+	}
+	return nil
+}
+
+func (m *RegisterResponse_Capabilities) GetObjectActions() []*RegisterResponse_ObjectAction {
+	if m != nil {
+		return m.ObjectActions
+	}
+	return nil
+}
+
+func (m *RegisterResponse_Capabilities) GetNavigationContributions() []*RegisterResponse_NavigationContribution {
+	if m != nil {
+		return m.NavigationContributions
+	}
+	return nil
+}
+
 type ObjectRequest struct {
 	Object               []byte   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -770,6 +969,233 @@ func (m *PrintTabResponse) GetLayout() []byte {
 	return nil
 }
 
+type PrintListColumnsResponse struct {
+	Rows                 []*PrintListColumnsResponse_Row `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *PrintListColumnsResponse) Reset()         { *m = PrintListColumnsResponse{} }
+func (m *PrintListColumnsResponse) String() string { return proto.CompactTextString(m) }
+func (*PrintListColumnsResponse) ProtoMessage()    {}
+func (*PrintListColumnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{12}
+}
+
+func (m *PrintListColumnsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrintListColumnsResponse.Unmarshal(m, b)
+}
+func (m *PrintListColumnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrintListColumnsResponse.Marshal(b, m, deterministic)
+}
+func (m *PrintListColumnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrintListColumnsResponse.Merge(m, src)
+}
+func (m *PrintListColumnsResponse) XXX_Size() int {
+	return xxx_messageInfo_PrintListColumnsResponse.Size(m)
+}
+func (m *PrintListColumnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrintListColumnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrintListColumnsResponse proto.InternalMessageInfo
+
+func (m *PrintListColumnsResponse) GetRows() []*PrintListColumnsResponse_Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+type PrintListColumnsResponse_Column struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Component            []byte   `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrintListColumnsResponse_Column) Reset()         { *m = PrintListColumnsResponse_Column{} }
+func (m *PrintListColumnsResponse_Column) String() string { return proto.CompactTextString(m) }
+func (*PrintListColumnsResponse_Column) ProtoMessage()    {}
+func (*PrintListColumnsResponse_Column) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{12, 0}
+}
+
+func (m *PrintListColumnsResponse_Column) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrintListColumnsResponse_Column.Unmarshal(m, b)
+}
+func (m *PrintListColumnsResponse_Column) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrintListColumnsResponse_Column.Marshal(b, m, deterministic)
+}
+func (m *PrintListColumnsResponse_Column) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrintListColumnsResponse_Column.Merge(m, src)
+}
+func (m *PrintListColumnsResponse_Column) XXX_Size() int {
+	return xxx_messageInfo_PrintListColumnsResponse_Column.Size(m)
+}
+func (m *PrintListColumnsResponse_Column) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrintListColumnsResponse_Column.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrintListColumnsResponse_Column proto.InternalMessageInfo
+
+func (m *PrintListColumnsResponse_Column) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PrintListColumnsResponse_Column) GetComponent() []byte {
+	if m != nil {
+		return m.Component
+	}
+	return nil
+}
+
+type PrintListColumnsResponse_Row struct {
+	Columns              []*PrintListColumnsResponse_Column `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *PrintListColumnsResponse_Row) Reset()         { *m = PrintListColumnsResponse_Row{} }
+func (m *PrintListColumnsResponse_Row) String() string { return proto.CompactTextString(m) }
+func (*PrintListColumnsResponse_Row) ProtoMessage()    {}
+func (*PrintListColumnsResponse_Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{12, 1}
+}
+
+func (m *PrintListColumnsResponse_Row) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrintListColumnsResponse_Row.Unmarshal(m, b)
+}
+func (m *PrintListColumnsResponse_Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrintListColumnsResponse_Row.Marshal(b, m, deterministic)
+}
+func (m *PrintListColumnsResponse_Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrintListColumnsResponse_Row.Merge(m, src)
+}
+func (m *PrintListColumnsResponse_Row) XXX_Size() int {
+	return xxx_messageInfo_PrintListColumnsResponse_Row.Size(m)
+}
+func (m *PrintListColumnsResponse_Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrintListColumnsResponse_Row.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrintListColumnsResponse_Row proto.InternalMessageInfo
+
+func (m *PrintListColumnsResponse_Row) GetColumns() []*PrintListColumnsResponse_Column {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+type RelatedObjectsResponse struct {
+	Keys                 []*RelatedObjectsResponse_Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *RelatedObjectsResponse) Reset()         { *m = RelatedObjectsResponse{} }
+func (m *RelatedObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*RelatedObjectsResponse) ProtoMessage()    {}
+func (*RelatedObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{13}
+}
+
+func (m *RelatedObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelatedObjectsResponse.Unmarshal(m, b)
+}
+func (m *RelatedObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelatedObjectsResponse.Marshal(b, m, deterministic)
+}
+func (m *RelatedObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelatedObjectsResponse.Merge(m, src)
+}
+func (m *RelatedObjectsResponse) XXX_Size() int {
+	return xxx_messageInfo_RelatedObjectsResponse.Size(m)
+}
+func (m *RelatedObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelatedObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelatedObjectsResponse proto.InternalMessageInfo
+
+func (m *RelatedObjectsResponse) GetKeys() []*RelatedObjectsResponse_Key {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type RelatedObjectsResponse_Key struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApiVersion           string   `protobuf:"bytes,2,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind                 string   `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelatedObjectsResponse_Key) Reset()         { *m = RelatedObjectsResponse_Key{} }
+func (m *RelatedObjectsResponse_Key) String() string { return proto.CompactTextString(m) }
+func (*RelatedObjectsResponse_Key) ProtoMessage()    {}
+func (*RelatedObjectsResponse_Key) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{13, 0}
+}
+
+func (m *RelatedObjectsResponse_Key) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelatedObjectsResponse_Key.Unmarshal(m, b)
+}
+func (m *RelatedObjectsResponse_Key) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelatedObjectsResponse_Key.Marshal(b, m, deterministic)
+}
+func (m *RelatedObjectsResponse_Key) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelatedObjectsResponse_Key.Merge(m, src)
+}
+func (m *RelatedObjectsResponse_Key) XXX_Size() int {
+	return xxx_messageInfo_RelatedObjectsResponse_Key.Size(m)
+}
+func (m *RelatedObjectsResponse_Key) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelatedObjectsResponse_Key.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelatedObjectsResponse_Key proto.InternalMessageInfo
+
+func (m *RelatedObjectsResponse_Key) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RelatedObjectsResponse_Key) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *RelatedObjectsResponse_Key) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RelatedObjectsResponse_Key) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type ObjectStatusResponse struct {
 	ObjectStatus         []byte   `protobuf:"bytes,1,opt,name=objectStatus,proto3" json:"objectStatus,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -781,7 +1207,7 @@ func (m *ObjectStatusResponse) Reset()         { *m = ObjectStatusResponse{} }
 func (m *ObjectStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectStatusResponse) ProtoMessage()    {}
 func (*ObjectStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{12}
+	return fileDescriptor_9b97678da3a35dfb, []int{14}
 }
 
 func (m *ObjectStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -821,7 +1247,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{13}
+	return fileDescriptor_9b97678da3a35dfb, []int{15}
 }
 
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
@@ -856,6 +1282,45 @@ func (m *WatchRequest) GetObject() []byte {
 	return nil
 }
 
+type ObjectsRequest struct {
+	Objects              [][]byte `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectsRequest) Reset()         { *m = ObjectsRequest{} }
+func (m *ObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectsRequest) ProtoMessage()    {}
+func (*ObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{16}
+}
+
+func (m *ObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectsRequest.Unmarshal(m, b)
+}
+func (m *ObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectsRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectsRequest.Merge(m, src)
+}
+func (m *ObjectsRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectsRequest.Size(m)
+}
+func (m *ObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectsRequest proto.InternalMessageInfo
+
+func (m *ObjectsRequest) GetObjects() [][]byte {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "dashboard.Empty")
 	proto.RegisterType((*ContentRequest)(nil), "dashboard.ContentRequest")
@@ -866,85 +1331,121 @@ func init() {
 	proto.RegisterType((*NavigationResponse)(nil), "dashboard.NavigationResponse")
 	proto.RegisterType((*NavigationResponse_Navigation)(nil), "dashboard.NavigationResponse.Navigation")
 	proto.RegisterType((*RegisterRequest)(nil), "dashboard.RegisterRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dashboard.RegisterRequest.ConfigurationEntry")
 	proto.RegisterType((*RegisterResponse)(nil), "dashboard.RegisterResponse")
 	proto.RegisterType((*RegisterResponse_GroupVersionKind)(nil), "dashboard.RegisterResponse.GroupVersionKind")
+	proto.RegisterType((*RegisterResponse_ObjectAction)(nil), "dashboard.RegisterResponse.ObjectAction")
+	proto.RegisterType((*RegisterResponse_NavigationContribution)(nil), "dashboard.RegisterResponse.NavigationContribution")
 	proto.RegisterType((*RegisterResponse_Capabilities)(nil), "dashboard.RegisterResponse.Capabilities")
 	proto.RegisterType((*ObjectRequest)(nil), "dashboard.ObjectRequest")
 	proto.RegisterType((*PrintResponse)(nil), "dashboard.PrintResponse")
 	proto.RegisterType((*PrintResponse_SummaryItem)(nil), "dashboard.PrintResponse.SummaryItem")
 	proto.RegisterType((*PrintTabResponse)(nil), "dashboard.PrintTabResponse")
+	proto.RegisterType((*PrintListColumnsResponse)(nil), "dashboard.PrintListColumnsResponse")
+	proto.RegisterType((*PrintListColumnsResponse_Column)(nil), "dashboard.PrintListColumnsResponse.Column")
+	proto.RegisterType((*PrintListColumnsResponse_Row)(nil), "dashboard.PrintListColumnsResponse.Row")
+	proto.RegisterType((*RelatedObjectsResponse)(nil), "dashboard.RelatedObjectsResponse")
+	proto.RegisterType((*RelatedObjectsResponse_Key)(nil), "dashboard.RelatedObjectsResponse.Key")
 	proto.RegisterType((*ObjectStatusResponse)(nil), "dashboard.ObjectStatusResponse")
 	proto.RegisterType((*WatchRequest)(nil), "dashboard.WatchRequest")
+	proto.RegisterType((*ObjectsRequest)(nil), "dashboard.ObjectsRequest")
 }
 
 func init() { proto.RegisterFile("dashboard.proto", fileDescriptor_9b97678da3a35dfb) }
 
 var fileDescriptor_9b97678da3a35dfb = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdb, 0x6e, 0xf3, 0x44,
-	0x10, 0x56, 0xce, 0xc9, 0xc4, 0x3f, 0x09, 0xdb, 0xf0, 0x63, 0xdc, 0xd2, 0x04, 0xab, 0x12, 0x45,
-	0x42, 0x11, 0x2a, 0x42, 0x42, 0x50, 0xa1, 0x46, 0x29, 0xa2, 0x11, 0xd0, 0x46, 0x6e, 0x29, 0x97,
-	0x65, 0x63, 0x2f, 0xc9, 0x82, 0xe3, 0x35, 0xde, 0x75, 0x51, 0x9e, 0x85, 0x2b, 0x6e, 0x90, 0x78,
-	0x05, 0x1e, 0x88, 0xe7, 0x40, 0x5e, 0xaf, 0x9d, 0x75, 0x9a, 0x44, 0xb4, 0x70, 0xe7, 0xf9, 0x76,
-	0xe6, 0x9b, 0xd9, 0x39, 0xec, 0x18, 0x3a, 0x1e, 0xe6, 0x8b, 0x19, 0xc3, 0x91, 0x37, 0x0c, 0x23,
-	0x26, 0x18, 0x6a, 0xe5, 0x80, 0xdd, 0x80, 0xda, 0x97, 0xcb, 0x50, 0xac, 0xec, 0x13, 0x78, 0x63,
-	0xcc, 0x02, 0x41, 0x02, 0xe1, 0x90, 0x5f, 0x62, 0xc2, 0x05, 0x42, 0x50, 0x0d, 0xb1, 0x58, 0x98,
-	0xa5, 0x41, 0xe9, 0xb4, 0xe5, 0xc8, 0x6f, 0xfb, 0x1c, 0x3a, 0xb9, 0x16, 0x0f, 0x59, 0xc0, 0x09,
-	0xfa, 0x00, 0xba, 0x6e, 0x0a, 0x3d, 0x44, 0x0a, 0x93, 0x26, 0x86, 0xd3, 0x71, 0x8b, 0xaa, 0xf6,
-	0x14, 0x0e, 0xae, 0x70, 0xe0, 0xf9, 0x64, 0xe4, 0x0a, 0xca, 0x82, 0xcc, 0x51, 0x1f, 0xda, 0x58,
-	0x02, 0x0f, 0x01, 0x5e, 0x12, 0xe5, 0x0f, 0x52, 0xe8, 0x1a, 0x2f, 0x09, 0x32, 0xa1, 0x11, 0xe2,
-	0x95, 0xcf, 0xb0, 0x67, 0x96, 0x25, 0x73, 0x26, 0xda, 0xaf, 0xa1, 0x57, 0x64, 0x54, 0x9e, 0x0e,
-	0xe0, 0xcd, 0x6b, 0xfc, 0x48, 0xe7, 0x58, 0xf3, 0x63, 0xff, 0x56, 0x06, 0xa4, 0xa3, 0xea, 0x02,
-	0x57, 0x00, 0x41, 0x8e, 0x4a, 0xef, 0xed, 0xb3, 0xd3, 0xe1, 0x3a, 0x67, 0x4f, 0x4d, 0x74, 0x48,
-	0xb3, 0xb5, 0xfe, 0x2a, 0x01, 0xac, 0x8f, 0x50, 0x0f, 0x6a, 0x82, 0x0a, 0x3f, 0xbb, 0x51, 0x2a,
-	0xe4, 0x69, 0x2d, 0xaf, 0xd3, 0x8a, 0x2e, 0xa1, 0xe9, 0x2e, 0xa8, 0xef, 0x45, 0x24, 0x30, 0x2b,
-	0x83, 0xca, 0xb3, 0x02, 0xc8, 0x2d, 0xd1, 0x21, 0xb4, 0xa8, 0x9b, 0x65, 0xb1, 0x2a, 0xe9, 0x9b,
-	0x09, 0x20, 0x73, 0xd8, 0x87, 0xb6, 0x3c, 0xe4, 0x2c, 0x8e, 0x5c, 0x62, 0xd6, 0xd2, 0x24, 0x27,
-	0xd0, 0xad, 0x44, 0xec, 0x31, 0x74, 0x1c, 0x32, 0xa7, 0x5c, 0x90, 0x28, 0x2b, 0xcc, 0x47, 0x70,
-	0x90, 0x47, 0x31, 0x9a, 0x4e, 0x46, 0x9e, 0x17, 0x11, 0xce, 0xd5, 0x75, 0xb6, 0x1d, 0xd9, 0x7f,
-	0xd4, 0xa1, 0xbb, 0x66, 0x51, 0x09, 0x3e, 0x06, 0x08, 0xfd, 0x78, 0x4e, 0x65, 0x20, 0x59, 0x79,
-	0xd7, 0x08, 0x1a, 0x40, 0xdb, 0x23, 0xdc, 0x8d, 0x68, 0x28, 0x2b, 0x90, 0x26, 0x46, 0x87, 0xd0,
-	0x37, 0x60, 0xb8, 0x38, 0xc4, 0x33, 0xea, 0x53, 0x41, 0x09, 0x37, 0x2b, 0x4f, 0x8a, 0xb4, 0xe9,
-	0x74, 0x38, 0xd6, 0xf4, 0x9d, 0x82, 0xb5, 0x75, 0x0f, 0xdd, 0xaf, 0x22, 0x16, 0x87, 0xf7, 0x24,
-	0xe2, 0x94, 0x05, 0x5f, 0xd3, 0xc0, 0x4b, 0x6a, 0x35, 0x4f, 0xb0, 0xac, 0x56, 0x52, 0x48, 0x1a,
-	0xef, 0x31, 0x55, 0x52, 0x51, 0x65, 0x62, 0x52, 0xc5, 0x9f, 0x69, 0xe0, 0xc9, 0x48, 0x5a, 0x8e,
-	0xfc, 0xb6, 0xfe, 0xac, 0x82, 0xa1, 0xbb, 0x45, 0x33, 0x78, 0x8b, 0xc7, 0x61, 0xc8, 0x22, 0xc1,
-	0xa7, 0x11, 0x0d, 0x04, 0x89, 0xc6, 0x2c, 0xf8, 0x91, 0xce, 0xcd, 0x92, 0xac, 0xf1, 0x87, 0xfb,
-	0xe2, 0xdf, 0x8c, 0xd0, 0xd9, 0x4e, 0xb5, 0xc5, 0xc7, 0xad, 0xc0, 0x22, 0xe6, 0x66, 0xf9, 0x7f,
-	0xf0, 0x91, 0x52, 0xa1, 0x1f, 0xa0, 0xb7, 0x71, 0x30, 0x11, 0x64, 0xc9, 0x55, 0xab, 0x3e, 0xcf,
-	0xc5, 0x56, 0x26, 0xdd, 0xc3, 0xcd, 0xec, 0x27, 0xe2, 0x0a, 0x75, 0x89, 0xea, 0x7f, 0xf1, 0xa0,
-	0x33, 0xa1, 0x6b, 0x68, 0x67, 0xf8, 0x1d, 0x9e, 0x99, 0xb5, 0x17, 0x10, 0xeb, 0x04, 0xc8, 0x82,
-	0x26, 0xe5, 0xdf, 0x32, 0x2f, 0xf6, 0x89, 0x59, 0x1f, 0x94, 0x4e, 0x9b, 0x4e, 0x2e, 0xa3, 0xf7,
-	0xc0, 0xd0, 0x1e, 0x34, 0x6e, 0x36, 0x06, 0x95, 0xa4, 0xa3, 0xd7, 0x2f, 0x1a, 0xb7, 0xdf, 0x87,
-	0x57, 0x69, 0x78, 0xd9, 0xac, 0xbd, 0x86, 0x3a, 0x93, 0x80, 0x7a, 0x3c, 0x95, 0x64, 0xff, 0x5d,
-	0x82, 0x57, 0x32, 0x55, 0xf9, 0x38, 0x9d, 0x43, 0xdd, 0xd5, 0xdb, 0xe8, 0x44, 0xbb, 0x44, 0x41,
-	0x73, 0x78, 0x1b, 0x2f, 0x97, 0x38, 0x5a, 0x25, 0x29, 0x76, 0x94, 0x4d, 0x62, 0xcd, 0xf5, 0x06,
-	0xf9, 0x97, 0xd6, 0xa9, 0x4d, 0x32, 0x26, 0x54, 0x95, 0x3e, 0x09, 0x32, 0x15, 0xac, 0x31, 0xb4,
-	0x35, 0xe5, 0xe4, 0x2a, 0x0b, 0x82, 0x3d, 0x12, 0xa9, 0x61, 0x52, 0x12, 0x3a, 0x82, 0x96, 0xcb,
-	0x96, 0x21, 0x0b, 0x48, 0x20, 0xd4, 0x43, 0xbe, 0x06, 0xec, 0x2f, 0xa0, 0x2b, 0xfd, 0xdf, 0xe1,
-	0x59, 0x7e, 0x55, 0x04, 0x55, 0x6d, 0x25, 0xc8, 0xef, 0x84, 0xdd, 0xc7, 0x2b, 0x16, 0x67, 0x14,
-	0x4a, 0xb2, 0x3f, 0x83, 0x9e, 0x5e, 0xf0, 0x9c, 0xc3, 0x06, 0x83, 0xe9, 0x2d, 0x95, 0xa6, 0xb7,
-	0x80, 0xd9, 0x17, 0x60, 0x7c, 0x8f, 0x85, 0xbb, 0xc8, 0x8a, 0x61, 0x42, 0xe3, 0xd7, 0x44, 0x9e,
-	0x5c, 0x2a, 0xd7, 0x99, 0xa8, 0x95, 0xa9, 0xac, 0x97, 0xe9, 0xec, 0xf7, 0x1a, 0xd4, 0xa7, 0xf2,
-	0x49, 0x43, 0x17, 0xd0, 0x50, 0x3b, 0x12, 0xbd, 0xa3, 0x25, 0xb7, 0xb8, 0x5d, 0x2d, 0x6b, 0xdb,
-	0x91, 0x0a, 0xf9, 0x06, 0x0c, 0x7d, 0xab, 0xa1, 0x63, 0x4d, 0x77, 0xcb, 0x02, 0xb5, 0xfa, 0x3b,
-	0xcf, 0x15, 0xe1, 0xa4, 0xb0, 0x97, 0x8e, 0x76, 0xec, 0x96, 0x94, 0xec, 0xdd, 0xbd, 0x9b, 0x07,
-	0x8d, 0xa1, 0x99, 0x4d, 0x0a, 0xb2, 0xb6, 0x8e, 0x4f, 0x4a, 0x73, 0xb8, 0x67, 0xb4, 0xd0, 0xe7,
-	0x50, 0x93, 0xb5, 0x46, 0xa6, 0xa6, 0x55, 0x98, 0x07, 0xcb, 0xdc, 0xd5, 0x97, 0x68, 0x02, 0x46,
-	0x61, 0xb2, 0x77, 0x73, 0xf4, 0x9f, 0x9c, 0x6c, 0xf4, 0xc6, 0x08, 0x9a, 0x59, 0xcf, 0xed, 0xa1,
-	0x39, 0xdc, 0x0c, 0x45, 0x6f, 0xd1, 0x4f, 0xa0, 0x29, 0x5b, 0x67, 0xe4, 0x79, 0xe8, 0x6d, 0x4d,
-	0x51, 0xef, 0x27, 0xab, 0xab, 0x1d, 0xc8, 0xdf, 0x2d, 0xf4, 0x29, 0xb4, 0xa5, 0xc6, 0x77, 0xa1,
-	0x87, 0x05, 0x79, 0x89, 0xe5, 0x25, 0xf1, 0xc9, 0xb3, 0x2c, 0x67, 0x75, 0xf9, 0xf7, 0xf7, 0xf1,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x4a, 0x08, 0xcc, 0x10, 0x0a, 0x00, 0x00,
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x72, 0xd3, 0x46,
+	0x14, 0x1e, 0xff, 0xc4, 0x76, 0x8e, 0x15, 0x62, 0x36, 0xa9, 0x11, 0x82, 0x92, 0xa0, 0xd2, 0x81,
+	0x32, 0xd4, 0xd3, 0xa1, 0xd3, 0x19, 0x0a, 0x4c, 0x87, 0x8c, 0xc3, 0x40, 0x26, 0x10, 0x32, 0x0a,
+	0x4d, 0xef, 0x4a, 0xd7, 0xd2, 0x92, 0xa8, 0x91, 0xb5, 0xaa, 0x56, 0x22, 0xe3, 0x37, 0xe8, 0x25,
+	0xf7, 0x7d, 0x86, 0xbe, 0x40, 0xaf, 0x7a, 0xd1, 0x07, 0xe8, 0x23, 0xf4, 0xaa, 0xcf, 0xd1, 0xd1,
+	0xfe, 0x48, 0x2b, 0x5b, 0x76, 0x83, 0xdb, 0x3b, 0x9f, 0xb3, 0x7b, 0xbe, 0xf3, 0xb3, 0xe7, 0x3b,
+	0xbb, 0x32, 0xac, 0x7b, 0x98, 0x9d, 0x8e, 0x28, 0x8e, 0xbd, 0x41, 0x14, 0xd3, 0x84, 0xa2, 0xd5,
+	0x5c, 0x61, 0xb7, 0x61, 0xe5, 0xe9, 0x38, 0x4a, 0x26, 0xf6, 0x2d, 0xb8, 0x34, 0xa4, 0x61, 0x42,
+	0xc2, 0xc4, 0x21, 0x3f, 0xa5, 0x84, 0x25, 0x08, 0x41, 0x33, 0xc2, 0xc9, 0xa9, 0x59, 0xdb, 0xae,
+	0xdd, 0x59, 0x75, 0xf8, 0x6f, 0xfb, 0x31, 0xac, 0xe7, 0xbb, 0x58, 0x44, 0x43, 0x46, 0xd0, 0x67,
+	0xd0, 0x73, 0x85, 0xea, 0x4d, 0x2c, 0x75, 0xdc, 0xc4, 0x70, 0xd6, 0xdd, 0xf2, 0x56, 0xfb, 0x10,
+	0x36, 0x9e, 0xe3, 0xd0, 0x0b, 0xc8, 0x8e, 0x9b, 0xf8, 0x34, 0x54, 0x8e, 0xb6, 0xa0, 0x8b, 0xb9,
+	0xe2, 0x4d, 0x88, 0xc7, 0x44, 0xfa, 0x03, 0xa1, 0x3a, 0xc0, 0x63, 0x82, 0x4c, 0x68, 0x47, 0x78,
+	0x12, 0x50, 0xec, 0x99, 0x75, 0x8e, 0xac, 0x44, 0xbb, 0x0f, 0x9b, 0x65, 0x44, 0xe9, 0x69, 0x03,
+	0x2e, 0x1f, 0xe0, 0x77, 0xfe, 0x09, 0xd6, 0xfc, 0xd8, 0xbf, 0xd4, 0x01, 0xe9, 0x5a, 0x99, 0xc0,
+	0x73, 0x80, 0x30, 0xd7, 0x72, 0xef, 0xdd, 0xfb, 0x77, 0x06, 0x45, 0xcd, 0x66, 0x4d, 0x74, 0x95,
+	0x66, 0x6b, 0xfd, 0x56, 0x03, 0x28, 0x96, 0xd0, 0x26, 0xac, 0x24, 0x7e, 0x12, 0xa8, 0x8c, 0x84,
+	0x90, 0x97, 0xb5, 0x5e, 0x94, 0x15, 0xed, 0x42, 0xc7, 0x3d, 0xf5, 0x03, 0x2f, 0x26, 0xa1, 0xd9,
+	0xd8, 0x6e, 0x7c, 0x50, 0x00, 0xb9, 0x25, 0xba, 0x06, 0xab, 0xbe, 0xab, 0xaa, 0xd8, 0xe4, 0xf0,
+	0x1d, 0xdf, 0x95, 0x35, 0xdc, 0x82, 0x2e, 0x5f, 0x64, 0x34, 0x8d, 0x5d, 0x62, 0xae, 0x88, 0x22,
+	0x67, 0xaa, 0x23, 0xae, 0xb1, 0x7f, 0xae, 0xc3, 0xba, 0x43, 0x4e, 0x7c, 0x96, 0x90, 0x58, 0x9d,
+	0xcc, 0x17, 0xb0, 0x91, 0x87, 0xb1, 0x73, 0xb8, 0xb7, 0xe3, 0x79, 0x31, 0x61, 0x4c, 0xe6, 0x53,
+	0xb5, 0x84, 0x8e, 0x60, 0xcd, 0xa5, 0xe1, 0x5b, 0xff, 0x24, 0x8d, 0x45, 0x3d, 0xeb, 0x3c, 0x9d,
+	0xcf, 0xb5, 0x74, 0xa6, 0x9c, 0x0c, 0x86, 0xfa, 0xfe, 0xa7, 0x61, 0x12, 0x4f, 0x9c, 0x32, 0x06,
+	0xba, 0x07, 0x97, 0x75, 0x5f, 0xaf, 0xe9, 0x19, 0xaf, 0x53, 0x16, 0xc4, 0xec, 0x82, 0xf5, 0x04,
+	0xd0, 0x2c, 0x24, 0xea, 0x41, 0xe3, 0x8c, 0x4c, 0x64, 0xe8, 0xd9, 0xcf, 0xec, 0x78, 0xde, 0xe1,
+	0x20, 0x25, 0xf2, 0x24, 0x84, 0xf0, 0xb0, 0xfe, 0xa0, 0x66, 0xbf, 0x37, 0xa0, 0x57, 0x44, 0x29,
+	0xdb, 0xe4, 0x06, 0x40, 0x14, 0xa4, 0x27, 0x3e, 0x2f, 0xa7, 0x6a, 0xd2, 0x42, 0x83, 0xb6, 0xa1,
+	0xeb, 0x11, 0xe6, 0xc6, 0x7e, 0x24, 0xf3, 0xce, 0x36, 0xe8, 0x2a, 0xf4, 0x02, 0x0c, 0x17, 0x47,
+	0x78, 0xe4, 0x07, 0x7e, 0xe2, 0x13, 0xc6, 0x33, 0x28, 0x9f, 0xf4, 0xb4, 0xd3, 0xc1, 0x50, 0xdb,
+	0xef, 0x94, 0xac, 0xad, 0x63, 0xe8, 0x3d, 0x8b, 0x69, 0x1a, 0x1d, 0x93, 0x98, 0xf9, 0x34, 0xdc,
+	0xf7, 0x43, 0x2f, 0x4b, 0xe9, 0x24, 0xd3, 0xa9, 0x8e, 0xe3, 0x42, 0x46, 0x9f, 0x77, 0x62, 0x93,
+	0x8c, 0x4a, 0x89, 0x59, 0x2f, 0x9e, 0xf9, 0xa1, 0x27, 0x6b, 0xc9, 0x7f, 0x5b, 0x7f, 0xd6, 0xc0,
+	0x78, 0x35, 0xfa, 0x91, 0xb8, 0x89, 0xe0, 0x54, 0x96, 0x78, 0xc1, 0xc5, 0x0a, 0x76, 0x6e, 0xc2,
+	0x4a, 0x80, 0x47, 0x24, 0x50, 0x75, 0xe4, 0x02, 0xb2, 0xc1, 0xe0, 0x87, 0x18, 0x8f, 0x45, 0x1f,
+	0x08, 0x17, 0x25, 0x5d, 0xe6, 0xfe, 0x2d, 0x8d, 0xc7, 0xbc, 0x57, 0x0d, 0x87, 0xff, 0x46, 0x0e,
+	0xac, 0xb1, 0x34, 0x8a, 0x68, 0x9c, 0x10, 0xef, 0xd9, 0xf1, 0x3e, 0x33, 0x57, 0x78, 0x03, 0xdd,
+	0x5b, 0x54, 0xa5, 0xe9, 0x3a, 0x38, 0x65, 0x08, 0xeb, 0x7d, 0x0d, 0xfa, 0x05, 0x63, 0xb2, 0x01,
+	0x16, 0xfb, 0xa3, 0x94, 0x87, 0xd0, 0x87, 0xd6, 0x98, 0x7a, 0x69, 0x4e, 0x52, 0x29, 0x65, 0x35,
+	0x63, 0xc4, 0xd5, 0x4e, 0x52, 0x89, 0x05, 0xab, 0x1b, 0x55, 0xac, 0x6e, 0x6a, 0xac, 0xb6, 0x20,
+	0xa7, 0x9f, 0xe4, 0x5b, 0x2e, 0x5b, 0xbf, 0xb6, 0xc1, 0xd0, 0x0f, 0x17, 0x8d, 0xe0, 0x23, 0x19,
+	0x34, 0x3b, 0x8c, 0xfd, 0x30, 0x21, 0xb1, 0x68, 0x62, 0xb3, 0xb6, 0x44, 0xfe, 0xd5, 0x50, 0x15,
+	0x3e, 0x8e, 0x12, 0x9c, 0xa4, 0xcc, 0xac, 0xff, 0x0f, 0x3e, 0x04, 0x14, 0xfa, 0x01, 0x36, 0xa7,
+	0x16, 0xf6, 0x12, 0x32, 0x66, 0x66, 0x63, 0x09, 0x17, 0x95, 0x48, 0xba, 0x07, 0xd1, 0xa7, 0x32,
+	0x89, 0xe6, 0x7f, 0xf1, 0xa0, 0x23, 0xa1, 0x03, 0xe8, 0x2a, 0xfd, 0x6b, 0x3c, 0x5a, 0xaa, 0x03,
+	0x75, 0x00, 0xde, 0x08, 0xec, 0xa5, 0x68, 0xb3, 0xd6, 0x76, 0xed, 0x4e, 0xc7, 0xc9, 0x65, 0x74,
+	0x13, 0x0c, 0xed, 0xf2, 0x63, 0x66, 0x7b, 0xbb, 0x91, 0xcd, 0x8d, 0x82, 0x5f, 0x0c, 0x7d, 0x0f,
+	0x1b, 0x0a, 0xed, 0x85, 0xcf, 0x92, 0x21, 0x0d, 0xd2, 0x71, 0xc8, 0xcc, 0xce, 0x12, 0x61, 0x55,
+	0x01, 0x21, 0x0f, 0xfa, 0x4a, 0xed, 0x90, 0x00, 0x27, 0xc4, 0x13, 0xd5, 0x60, 0xe6, 0xea, 0x12,
+	0x2e, 0xe6, 0x60, 0xa1, 0x03, 0x58, 0xa3, 0xda, 0x58, 0x61, 0x26, 0xcc, 0x5c, 0x74, 0x33, 0xe0,
+	0xfa, 0x1c, 0x72, 0xca, 0xe6, 0x28, 0x80, 0x2b, 0x61, 0x25, 0xa7, 0x99, 0xd9, 0xe5, 0xc8, 0xf7,
+	0x17, 0x21, 0x57, 0x8f, 0x03, 0x67, 0x1e, 0xa4, 0x7d, 0x1b, 0xd6, 0x44, 0x30, 0xea, 0x6a, 0xec,
+	0x43, 0x4b, 0xc4, 0x23, 0x1f, 0x3b, 0x52, 0xb2, 0xff, 0xae, 0xc1, 0x1a, 0x6f, 0xd7, 0xfc, 0xe2,
+	0x78, 0x0c, 0x2d, 0x57, 0xa7, 0xf2, 0x2d, 0x2d, 0xae, 0xd2, 0xce, 0xc1, 0x51, 0x3a, 0x1e, 0xe3,
+	0x78, 0x92, 0xb5, 0xb9, 0x23, 0x6d, 0x32, 0x6b, 0xa6, 0x93, 0xf4, 0x82, 0xd6, 0xc2, 0x26, 0x1b,
+	0x56, 0xbe, 0xa4, 0x5f, 0x16, 0xa4, 0x10, 0xac, 0x21, 0x74, 0xb5, 0xcd, 0x59, 0x2a, 0xa7, 0x04,
+	0x7b, 0x24, 0x56, 0x33, 0x50, 0x48, 0xe8, 0x3a, 0xac, 0xba, 0x74, 0x1c, 0xd1, 0x90, 0x84, 0x89,
+	0x7c, 0x78, 0x15, 0x0a, 0xfb, 0x1b, 0xe8, 0x71, 0xff, 0xaf, 0xf1, 0x28, 0x4f, 0x15, 0x41, 0x53,
+	0x7b, 0xc2, 0xf1, 0xdf, 0x19, 0x7a, 0x80, 0x27, 0x34, 0x55, 0x10, 0x52, 0xb2, 0xff, 0xaa, 0x81,
+	0xc9, 0x01, 0xb4, 0x56, 0xcc, 0x81, 0x1e, 0x41, 0x33, 0xa6, 0xe7, 0x4c, 0x56, 0xec, 0xf6, 0x74,
+	0xce, 0x15, 0x26, 0x03, 0x87, 0x9e, 0x3b, 0xdc, 0xc8, 0x7a, 0x08, 0x2d, 0xb1, 0x58, 0x19, 0xcf,
+	0xc2, 0xac, 0xac, 0x7d, 0x68, 0x38, 0xf4, 0x1c, 0xed, 0x42, 0xdb, 0x95, 0x34, 0x13, 0x21, 0xdc,
+	0xbd, 0x48, 0x08, 0x42, 0x76, 0x94, 0xa9, 0xfd, 0x7b, 0x0d, 0xfa, 0x65, 0x16, 0xe4, 0x09, 0x7e,
+	0x0d, 0xcd, 0x33, 0x32, 0x51, 0xe8, 0x9f, 0x96, 0x5a, 0xb5, 0xca, 0x60, 0xb0, 0x4f, 0x26, 0x0e,
+	0x37, 0xb1, 0xce, 0xa0, 0xb1, 0x4f, 0x26, 0x59, 0x1e, 0x7c, 0x62, 0x44, 0xd8, 0x55, 0x09, 0x16,
+	0x0a, 0x7e, 0x69, 0x47, 0xfe, 0x71, 0xe9, 0xda, 0xd7, 0x34, 0x55, 0x37, 0x7f, 0x5e, 0xad, 0x66,
+	0x51, 0x2d, 0xfb, 0x21, 0x6c, 0xea, 0xa3, 0x31, 0x8f, 0xdf, 0x06, 0x83, 0x6a, 0x7a, 0x49, 0x82,
+	0x92, 0xce, 0x7e, 0x02, 0xc6, 0x77, 0x38, 0x71, 0x4f, 0x15, 0x65, 0x4c, 0x68, 0x9f, 0x67, 0xf2,
+	0xde, 0xae, 0x8c, 0x57, 0x89, 0x1a, 0x99, 0xea, 0x25, 0x32, 0xdd, 0x85, 0x4b, 0x79, 0x1d, 0x72,
+	0x0c, 0xb1, 0x26, 0x4a, 0x67, 0x38, 0x4a, 0xbc, 0xff, 0x47, 0x0b, 0x5a, 0x87, 0xfc, 0x39, 0x86,
+	0x9e, 0x40, 0x5b, 0x7e, 0xa5, 0xa0, 0xab, 0x5a, 0x65, 0xcb, 0xdf, 0x37, 0x96, 0x55, 0xb5, 0x24,
+	0xd3, 0x7b, 0x05, 0x86, 0xfe, 0x5d, 0x81, 0x6e, 0x68, 0x7b, 0x2b, 0x3e, 0x61, 0xac, 0xad, 0xb9,
+	0xeb, 0x12, 0x70, 0xaf, 0xf4, 0x65, 0x70, 0x7d, 0xce, 0xeb, 0x5e, 0x80, 0x7d, 0xbc, 0xf0, 0xed,
+	0x8f, 0x86, 0xd0, 0x51, 0xe3, 0x0c, 0x59, 0xf3, 0xdf, 0xd5, 0xd6, 0xb5, 0x05, 0xf3, 0x0f, 0x3d,
+	0x82, 0x15, 0xde, 0xc6, 0xc8, 0xd4, 0x76, 0x95, 0x26, 0x9c, 0x65, 0xce, 0x9b, 0x34, 0x68, 0x4f,
+	0xbd, 0x10, 0xe5, 0x7d, 0x39, 0x1f, 0x63, 0x6b, 0x66, 0x65, 0xaa, 0x8f, 0x76, 0xa0, 0xa3, 0xa6,
+	0xc8, 0x02, 0x98, 0x6b, 0xd3, 0xa1, 0xe8, 0x43, 0xc7, 0x81, 0xde, 0x34, 0x23, 0xd1, 0xd5, 0x19,
+	0x28, 0xd5, 0x41, 0xd6, 0x27, 0x17, 0x60, 0x32, 0x7a, 0x09, 0x97, 0xa6, 0xae, 0xaf, 0xf9, 0xc1,
+	0xdd, 0xfc, 0x57, 0xf2, 0xa2, 0xaf, 0xa0, 0xc3, 0x99, 0xb0, 0xe3, 0x79, 0xe8, 0x8a, 0xb6, 0x5d,
+	0xa7, 0x87, 0xd5, 0xd3, 0x16, 0xf8, 0x37, 0x39, 0x7a, 0x00, 0x5d, 0xbe, 0xe3, 0xdb, 0xc8, 0xc3,
+	0x09, 0x59, 0xc6, 0x72, 0x97, 0x04, 0xe4, 0x83, 0x2c, 0x47, 0x2d, 0xfe, 0x17, 0xc1, 0x97, 0xff,
+	0x0c, 0x00, 0x1f, 0x66, 0xff, 0x28, 0x35, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // PluginClient is the client API for Plugin service.
 //
@@ -957,16 +1458,18 @@ type PluginClient interface {
 	Print(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintResponse, error)
 	ObjectStatus(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*ObjectStatusResponse, error)
 	PrintTab(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintTabResponse, error)
+	PrintListColumns(ctx context.Context, in *ObjectsRequest, opts ...grpc.CallOption) (*PrintListColumnsResponse, error)
+	RelatedObjects(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*RelatedObjectsResponse, error)
 	WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchUpdate(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchDelete(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type pluginClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewPluginClient(cc *grpc.ClientConn) PluginClient {
+func NewPluginClient(cc grpc.ClientConnInterface) PluginClient {
 	return &pluginClient{cc}
 }
 
@@ -1033,6 +1536,24 @@ func (c *pluginClient) PrintTab(ctx context.Context, in *ObjectRequest, opts ...
 	return out, nil
 }
 
+func (c *pluginClient) PrintListColumns(ctx context.Context, in *ObjectsRequest, opts ...grpc.CallOption) (*PrintListColumnsResponse, error) {
+	out := new(PrintListColumnsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Plugin/PrintListColumns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) RelatedObjects(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*RelatedObjectsResponse, error) {
+	out := new(RelatedObjectsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Plugin/RelatedObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pluginClient) WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/dashboard.Plugin/WatchAdd", in, out, opts...)
@@ -1069,6 +1590,8 @@ type PluginServer interface {
 	Print(context.Context, *ObjectRequest) (*PrintResponse, error)
 	ObjectStatus(context.Context, *ObjectRequest) (*ObjectStatusResponse, error)
 	PrintTab(context.Context, *ObjectRequest) (*PrintTabResponse, error)
+	PrintListColumns(context.Context, *ObjectsRequest) (*PrintListColumnsResponse, error)
+	RelatedObjects(context.Context, *ObjectRequest) (*RelatedObjectsResponse, error)
 	WatchAdd(context.Context, *WatchRequest) (*Empty, error)
 	WatchUpdate(context.Context, *WatchRequest) (*Empty, error)
 	WatchDelete(context.Context, *WatchRequest) (*Empty, error)
@@ -1099,6 +1622,12 @@ func (*UnimplementedPluginServer) ObjectStatus(ctx context.Context, req *ObjectR
 func (*UnimplementedPluginServer) PrintTab(ctx context.Context, req *ObjectRequest) (*PrintTabResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrintTab not implemented")
 }
+func (*UnimplementedPluginServer) PrintListColumns(ctx context.Context, req *ObjectsRequest) (*PrintListColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrintListColumns not implemented")
+}
+func (*UnimplementedPluginServer) RelatedObjects(ctx context.Context, req *ObjectRequest) (*RelatedObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelatedObjects not implemented")
+}
 func (*UnimplementedPluginServer) WatchAdd(ctx context.Context, req *WatchRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WatchAdd not implemented")
 }
@@ -1239,6 +1768,42 @@ func _Plugin_PrintTab_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_PrintListColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).PrintListColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Plugin/PrintListColumns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).PrintListColumns(ctx, req.(*ObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_RelatedObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).RelatedObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Plugin/RelatedObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).RelatedObjects(ctx, req.(*ObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Plugin_WatchAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WatchRequest)
 	if err := dec(in); err != nil {
@@ -1325,6 +1890,14 @@ var _Plugin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PrintTab",
 			Handler:    _Plugin_PrintTab_Handler,
 		},
+		{
+			MethodName: "PrintListColumns",
+			Handler:    _Plugin_PrintListColumns_Handler,
+		},
+		{
+			MethodName: "RelatedObjects",
+			Handler:    _Plugin_RelatedObjects_Handler,
+		},
 		{
 			MethodName: "WatchAdd",
 			Handler:    _Plugin_WatchAdd_Handler,
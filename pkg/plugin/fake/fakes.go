@@ -41,6 +41,21 @@ func (m *MockRunners) EXPECT() *MockRunnersMockRecorder {
 	return m.recorder
 }
 
+// ListColumns mocks base method
+func (m *MockRunners) ListColumns(arg0 plugin.ManagerStore) (plugin.ListRunner, chan []plugin.ListColumnsResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListColumns", arg0)
+	ret0, _ := ret[0].(plugin.ListRunner)
+	ret1, _ := ret[1].(chan []plugin.ListColumnsResponse)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns
+func (mr *MockRunnersMockRecorder) ListColumns(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockRunners)(nil).ListColumns), arg0)
+}
+
 // ObjectStatus mocks base method
 func (m *MockRunners) ObjectStatus(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan plugin.ObjectStatusResponse) {
 	m.ctrl.T.Helper()
@@ -71,6 +86,21 @@ func (mr *MockRunnersMockRecorder) Print(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockRunners)(nil).Print), arg0)
 }
 
+// RelatedObjects mocks base method
+func (m *MockRunners) RelatedObjects(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan plugin.RelatedObjectsResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0)
+	ret0, _ := ret[0].(plugin.DefaultRunner)
+	ret1, _ := ret[1].(chan plugin.RelatedObjectsResponse)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockRunnersMockRecorder) RelatedObjects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockRunners)(nil).RelatedObjects), arg0)
+}
+
 // Tab mocks base method
 func (m *MockRunners) Tab(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan component.Tab) {
 	m.ctrl.T.Helper()
@@ -152,19 +182,19 @@ func (mr *MockManagerStoreMockRecorder) GetCommand(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommand", reflect.TypeOf((*MockManagerStore)(nil).GetCommand), arg0)
 }
 
-// GetJS mocks base method
-func (m *MockManagerStore) GetJS(arg0 string) (plugin.JSPlugin, bool) {
+// GetInProcess mocks base method
+func (m *MockManagerStore) GetInProcess(arg0 string) (plugin.InProcessPlugin, bool) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetJS", arg0)
-	ret0, _ := ret[0].(plugin.JSPlugin)
+	ret := m.ctrl.Call(m, "GetInProcess", arg0)
+	ret0, _ := ret[0].(plugin.InProcessPlugin)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
 
-// GetJS indicates an expected call of GetJS
-func (mr *MockManagerStoreMockRecorder) GetJS(arg0 interface{}) *gomock.Call {
+// GetInProcess indicates an expected call of GetInProcess
+func (mr *MockManagerStoreMockRecorder) GetInProcess(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJS", reflect.TypeOf((*MockManagerStore)(nil).GetJS), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInProcess", reflect.TypeOf((*MockManagerStore)(nil).GetInProcess), arg0)
 }
 
 // GetMetadata mocks base method
@@ -197,30 +227,30 @@ func (mr *MockManagerStoreMockRecorder) GetService(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockManagerStore)(nil).GetService), arg0)
 }
 
-// NamesJS mocks base method
-func (m *MockManagerStore) NamesJS() []string {
+// NamesInProcess mocks base method
+func (m *MockManagerStore) NamesInProcess() []string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NamesJS")
+	ret := m.ctrl.Call(m, "NamesInProcess")
 	ret0, _ := ret[0].([]string)
 	return ret0
 }
 
-// NamesJS indicates an expected call of NamesJS
-func (mr *MockManagerStoreMockRecorder) NamesJS() *gomock.Call {
+// NamesInProcess indicates an expected call of NamesInProcess
+func (mr *MockManagerStoreMockRecorder) NamesInProcess() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamesJS", reflect.TypeOf((*MockManagerStore)(nil).NamesJS))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamesInProcess", reflect.TypeOf((*MockManagerStore)(nil).NamesInProcess))
 }
 
-// RemoveJS mocks base method
-func (m *MockManagerStore) RemoveJS(arg0 string) {
+// RemoveInProcess mocks base method
+func (m *MockManagerStore) RemoveInProcess(arg0 string) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RemoveJS", arg0)
+	m.ctrl.Call(m, "RemoveInProcess", arg0)
 }
 
-// RemoveJS indicates an expected call of RemoveJS
-func (mr *MockManagerStoreMockRecorder) RemoveJS(arg0 interface{}) *gomock.Call {
+// RemoveInProcess indicates an expected call of RemoveInProcess
+func (mr *MockManagerStoreMockRecorder) RemoveInProcess(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveJS", reflect.TypeOf((*MockManagerStore)(nil).RemoveJS), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInProcess", reflect.TypeOf((*MockManagerStore)(nil).RemoveInProcess), arg0)
 }
 
 // Store mocks base method
@@ -237,18 +267,18 @@ func (mr *MockManagerStoreMockRecorder) Store(arg0, arg1, arg2, arg3 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockManagerStore)(nil).Store), arg0, arg1, arg2, arg3)
 }
 
-// StoreJS mocks base method
-func (m *MockManagerStore) StoreJS(arg0 string, arg1 plugin.JSPlugin) error {
+// StoreInProcess mocks base method
+func (m *MockManagerStore) StoreInProcess(arg0 string, arg1 plugin.InProcessPlugin) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreJS", arg0, arg1)
+	ret := m.ctrl.Call(m, "StoreInProcess", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StoreJS indicates an expected call of StoreJS
-func (mr *MockManagerStoreMockRecorder) StoreJS(arg0, arg1 interface{}) *gomock.Call {
+// StoreInProcess indicates an expected call of StoreInProcess
+func (mr *MockManagerStoreMockRecorder) StoreInProcess(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreJS", reflect.TypeOf((*MockManagerStore)(nil).StoreJS), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreInProcess", reflect.TypeOf((*MockManagerStore)(nil).StoreInProcess), arg0, arg1)
 }
 
 // MockClientFactory is a mock of ClientFactory interface
@@ -385,6 +415,21 @@ func (mr *MockModuleServiceMockRecorder) Print(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockModuleService)(nil).Print), arg0, arg1)
 }
 
+// PrintListColumns mocks base method
+func (m *MockModuleService) PrintListColumns(arg0 context.Context, arg1 []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintListColumns", arg0, arg1)
+	ret0, _ := ret[0].([]plugin.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrintListColumns indicates an expected call of PrintListColumns
+func (mr *MockModuleServiceMockRecorder) PrintListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintListColumns", reflect.TypeOf((*MockModuleService)(nil).PrintListColumns), arg0, arg1)
+}
+
 // PrintTab mocks base method
 func (m *MockModuleService) PrintTab(arg0 context.Context, arg1 runtime.Object) (plugin.TabResponse, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +460,21 @@ func (mr *MockModuleServiceMockRecorder) Register(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockModuleService)(nil).Register), arg0, arg1)
 }
 
+// RelatedObjects mocks base method
+func (m *MockModuleService) RelatedObjects(arg0 context.Context, arg1 runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(plugin.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockModuleServiceMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockModuleService)(nil).RelatedObjects), arg0, arg1)
+}
+
 // MockService is a mock of Service interface
 type MockService struct {
 	ctrl     *gomock.Controller
@@ -482,6 +542,21 @@ func (mr *MockServiceMockRecorder) Print(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockService)(nil).Print), arg0, arg1)
 }
 
+// PrintListColumns mocks base method
+func (m *MockService) PrintListColumns(arg0 context.Context, arg1 []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintListColumns", arg0, arg1)
+	ret0, _ := ret[0].([]plugin.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrintListColumns indicates an expected call of PrintListColumns
+func (mr *MockServiceMockRecorder) PrintListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintListColumns", reflect.TypeOf((*MockService)(nil).PrintListColumns), arg0, arg1)
+}
+
 // PrintTab mocks base method
 func (m *MockService) PrintTab(arg0 context.Context, arg1 runtime.Object) (plugin.TabResponse, error) {
 	m.ctrl.T.Helper()
@@ -512,6 +587,59 @@ func (mr *MockServiceMockRecorder) Register(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockService)(nil).Register), arg0, arg1)
 }
 
+// RelatedObjects mocks base method
+func (m *MockService) RelatedObjects(arg0 context.Context, arg1 runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(plugin.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockServiceMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockService)(nil).RelatedObjects), arg0, arg1)
+}
+
+// MockRegistrationService is a mock of RegistrationService interface
+type MockRegistrationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistrationServiceMockRecorder
+}
+
+// MockRegistrationServiceMockRecorder is the mock recorder for MockRegistrationService
+type MockRegistrationServiceMockRecorder struct {
+	mock *MockRegistrationService
+}
+
+// NewMockRegistrationService creates a new mock instance
+func NewMockRegistrationService(ctrl *gomock.Controller) *MockRegistrationService {
+	mock := &MockRegistrationService{ctrl: ctrl}
+	mock.recorder = &MockRegistrationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRegistrationService) EXPECT() *MockRegistrationServiceMockRecorder {
+	return m.recorder
+}
+
+// RegisterWith mocks base method
+func (m *MockRegistrationService) RegisterWith(arg0 context.Context, arg1 plugin.Registration) (plugin.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterWith", arg0, arg1)
+	ret0, _ := ret[0].(plugin.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterWith indicates an expected call of RegisterWith
+func (mr *MockRegistrationServiceMockRecorder) RegisterWith(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWith", reflect.TypeOf((*MockRegistrationService)(nil).RegisterWith), arg0, arg1)
+}
+
 // MockBroker is a mock of Broker interface
 type MockBroker struct {
 	ctrl     *gomock.Controller
@@ -39,6 +39,50 @@ func (m *MockManagerInterface) EXPECT() *MockManagerInterfaceMockRecorder {
 	return m.recorder
 }
 
+// Configuration mocks base method
+func (m *MockManagerInterface) Configuration(arg0 string) plugin.Configuration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Configuration", arg0)
+	ret0, _ := ret[0].(plugin.Configuration)
+	return ret0
+}
+
+// Configuration indicates an expected call of Configuration
+func (mr *MockManagerInterfaceMockRecorder) Configuration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Configuration", reflect.TypeOf((*MockManagerInterface)(nil).Configuration), arg0)
+}
+
+// ListColumns mocks base method
+func (m *MockManagerInterface) ListColumns(arg0 context.Context, arg1 []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListColumns", arg0, arg1)
+	ret0, _ := ret[0].([]plugin.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns
+func (mr *MockManagerInterfaceMockRecorder) ListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockManagerInterface)(nil).ListColumns), arg0, arg1)
+}
+
+// ObjectActions mocks base method
+func (m *MockManagerInterface) ObjectActions(arg0 runtime.Object) ([]plugin.ObjectAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectActions", arg0)
+	ret0, _ := ret[0].([]plugin.ObjectAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectActions indicates an expected call of ObjectActions
+func (mr *MockManagerInterfaceMockRecorder) ObjectActions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectActions", reflect.TypeOf((*MockManagerInterface)(nil).ObjectActions), arg0)
+}
+
 // ObjectStatus mocks base method
 func (m *MockManagerInterface) ObjectStatus(arg0 context.Context, arg1 runtime.Object) (*plugin.ObjectStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +113,21 @@ func (mr *MockManagerInterfaceMockRecorder) Print(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockManagerInterface)(nil).Print), arg0, arg1)
 }
 
+// RelatedObjects mocks base method
+func (m *MockManagerInterface) RelatedObjects(arg0 context.Context, arg1 runtime.Object) (*plugin.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(*plugin.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockManagerInterfaceMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockManagerInterface)(nil).RelatedObjects), arg0, arg1)
+}
+
 // Store mocks base method
 func (m *MockManagerInterface) Store() plugin.ManagerStore {
 	m.ctrl.T.Helper()
@@ -98,6 +157,20 @@ func (mr *MockManagerInterfaceMockRecorder) Tabs(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tabs", reflect.TypeOf((*MockManagerInterface)(nil).Tabs), arg0, arg1)
 }
 
+// UpdateConfiguration mocks base method
+func (m *MockManagerInterface) UpdateConfiguration(arg0 context.Context, arg1 string, arg2 plugin.Configuration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConfiguration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConfiguration indicates an expected call of UpdateConfiguration
+func (mr *MockManagerInterfaceMockRecorder) UpdateConfiguration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfiguration", reflect.TypeOf((*MockManagerInterface)(nil).UpdateConfiguration), arg0, arg1, arg2)
+}
+
 // UpdateObjectStore mocks base method
 func (m *MockManagerInterface) UpdateObjectStore(arg0 store.Store) {
 	m.ctrl.T.Helper()
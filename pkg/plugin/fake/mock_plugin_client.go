@@ -177,6 +177,46 @@ func (mr *MockPluginClientMockRecorder) PrintTab(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintTab", reflect.TypeOf((*MockPluginClient)(nil).PrintTab), varargs...)
 }
 
+// PrintListColumns mocks base method
+func (m *MockPluginClient) PrintListColumns(ctx context.Context, in *dashboard.ObjectsRequest, opts ...grpc.CallOption) (*dashboard.PrintListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PrintListColumns", varargs...)
+	ret0, _ := ret[0].(*dashboard.PrintListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrintListColumns indicates an expected call of PrintListColumns
+func (mr *MockPluginClientMockRecorder) PrintListColumns(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintListColumns", reflect.TypeOf((*MockPluginClient)(nil).PrintListColumns), varargs...)
+}
+
+// RelatedObjects mocks base method
+func (m *MockPluginClient) RelatedObjects(ctx context.Context, in *dashboard.ObjectRequest, opts ...grpc.CallOption) (*dashboard.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RelatedObjects", varargs...)
+	ret0, _ := ret[0].(*dashboard.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockPluginClientMockRecorder) RelatedObjects(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockPluginClient)(nil).RelatedObjects), varargs...)
+}
+
 // WatchAdd mocks base method
 func (m *MockPluginClient) WatchAdd(ctx context.Context, in *dashboard.WatchRequest, opts ...grpc.CallOption) (*dashboard.Empty, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +405,36 @@ func (mr *MockPluginServerMockRecorder) PrintTab(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintTab", reflect.TypeOf((*MockPluginServer)(nil).PrintTab), arg0, arg1)
 }
 
+// PrintListColumns mocks base method
+func (m *MockPluginServer) PrintListColumns(arg0 context.Context, arg1 *dashboard.ObjectsRequest) (*dashboard.PrintListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintListColumns", arg0, arg1)
+	ret0, _ := ret[0].(*dashboard.PrintListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrintListColumns indicates an expected call of PrintListColumns
+func (mr *MockPluginServerMockRecorder) PrintListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintListColumns", reflect.TypeOf((*MockPluginServer)(nil).PrintListColumns), arg0, arg1)
+}
+
+// RelatedObjects mocks base method
+func (m *MockPluginServer) RelatedObjects(arg0 context.Context, arg1 *dashboard.ObjectRequest) (*dashboard.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(*dashboard.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockPluginServerMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockPluginServer)(nil).RelatedObjects), arg0, arg1)
+}
+
 // WatchAdd mocks base method
 func (m *MockPluginServer) WatchAdd(arg0 context.Context, arg1 *dashboard.WatchRequest) (*dashboard.Empty, error) {
 	m.ctrl.T.Helper()
@@ -132,11 +132,19 @@ func (c *GRPCClient) Navigation(ctx context.Context) (navigation.Navigation, err
 
 // Register register a plugin.
 func (c *GRPCClient) Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error) {
+	return c.RegisterWith(ctx, Registration{DashboardAPIAddress: dashboardAPIAddress})
+}
+
+// RegisterWith registers a plugin with its dashboard API token and configuration.
+// Plugins which don't accept them only receive the dashboard API address.
+func (c *GRPCClient) RegisterWith(ctx context.Context, registration Registration) (Metadata, error) {
 	var m Metadata
 
 	err := c.run(func() error {
 		registerRequest := &dashboard.RegisterRequest{
-			DashboardAPIAddress: dashboardAPIAddress,
+			DashboardAPIAddress: registration.DashboardAPIAddress,
+			DashboardAPIToken:   registration.DashboardAPIToken,
+			Configuration:       registration.Configuration,
 		}
 
 		resp, err := c.client.Register(ctx, registerRequest, grpc.WaitForReady(true))
@@ -145,7 +153,10 @@ func (c *GRPCClient) Register(ctx context.Context, dashboardAPIAddress string) (
 			return errors.WithMessage(err, "unable to call register function")
 		}
 
-		capabilities := convertToCapabilities(resp.Capabilities)
+		capabilities, err := convertToCapabilities(resp.Capabilities)
+		if err != nil {
+			return errors.WithMessage(err, "unable to convert capabilities")
+		}
 
 		m = Metadata{
 			Name:         resp.PluginName,
@@ -258,6 +269,21 @@ func createObjectRequest(object runtime.Object) (*dashboard.ObjectRequest, error
 	return or, err
 }
 
+func createObjectsRequest(objects []runtime.Object) (*dashboard.ObjectsRequest, error) {
+	or := &dashboard.ObjectsRequest{}
+
+	for _, object := range objects {
+		data, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+
+		or.Objects = append(or.Objects, data)
+	}
+
+	return or, nil
+}
+
 // PrintTab creates a tab for an object.
 func (c *GRPCClient) PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error) {
 	var tab component.Tab
@@ -301,6 +327,75 @@ func (c *GRPCClient) PrintTab(ctx context.Context, object runtime.Object) (TabRe
 	return TabResponse{Tab: &tab}, nil
 }
 
+// PrintListColumns creates list table columns for objects with one call to the plugin.
+func (c *GRPCClient) PrintListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error) {
+	var responses []ListColumnsResponse
+
+	err := c.run(func() error {
+		in, err := createObjectsRequest(objects)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.PrintListColumns(ctx, in, grpc.WaitForReady(true))
+		if err != nil {
+			return errors.Wrap(err, "grpc client print list columns")
+		}
+
+		if len(resp.Rows) != len(objects) {
+			return errors.Errorf("plugin returned columns for %d objects; expected %d", len(resp.Rows), len(objects))
+		}
+
+		for _, row := range resp.Rows {
+			columns, err := convertToListColumns(row.Columns)
+			if err != nil {
+				return errors.Wrap(err, "convert list columns")
+			}
+
+			responses = append(responses, ListColumnsResponse{
+				Columns: columns,
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// RelatedObjects finds objects related to an object.
+func (c *GRPCClient) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	var ror RelatedObjectsResponse
+
+	err := c.run(func() error {
+		in, err := createObjectRequest(object)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.RelatedObjects(ctx, in, grpc.WaitForReady(true))
+		if err != nil {
+			return errors.Wrap(err, "grpc client related objects")
+		}
+
+		ror = RelatedObjectsResponse{
+			Keys: convertToRelatedObjectKeys(resp.Keys),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	return ror, nil
+}
+
 // GRPCServer is the grpc server the dashboard will use to communicate with the
 // the plugin.
 type GRPCServer struct {
@@ -368,12 +463,19 @@ func (s *GRPCServer) Navigation(ctx context.Context, req *dashboard.NavigationRe
 
 // Register register a plugin.
 func (s *GRPCServer) Register(ctx context.Context, registerRequest *dashboard.RegisterRequest) (*dashboard.RegisterResponse, error) {
-	m, err := s.Impl.Register(ctx, registerRequest.DashboardAPIAddress)
+	m, err := register(ctx, s.Impl, Registration{
+		DashboardAPIAddress: registerRequest.DashboardAPIAddress,
+		DashboardAPIToken:   registerRequest.DashboardAPIToken,
+		Configuration:       registerRequest.Configuration,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	capabilities := convertFromCapabilities(m.Capabilities)
+	capabilities, err := convertFromCapabilities(m.Capabilities)
+	if err != nil {
+		return nil, err
+	}
 
 	return &dashboard.RegisterResponse{
 		PluginName:   m.Name,
@@ -483,6 +585,62 @@ func (s *GRPCServer) PrintTab(ctx context.Context, objectRequest *dashboard.Obje
 	return out, nil
 }
 
+// PrintListColumns prints list table columns for objects.
+func (s *GRPCServer) PrintListColumns(ctx context.Context, objectsRequest *dashboard.ObjectsRequest) (*dashboard.PrintListColumnsResponse, error) {
+	var objects []runtime.Object
+	for _, data := range objectsRequest.Objects {
+		u, err := decodeObjectRequest(&dashboard.ObjectRequest{Object: data})
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, u)
+	}
+
+	responses, err := s.Impl.PrintListColumns(ctx, objects)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc server print list columns")
+	}
+
+	if len(responses) != len(objects) {
+		return nil, errors.Errorf("printed columns for %d objects; expected %d", len(responses), len(objects))
+	}
+
+	out := &dashboard.PrintListColumnsResponse{}
+
+	for _, lcr := range responses {
+		columns, err := convertFromListColumns(lcr.Columns)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Rows = append(out.Rows, &dashboard.PrintListColumnsResponse_Row{
+			Columns: columns,
+		})
+	}
+
+	return out, nil
+}
+
+// RelatedObjects finds objects related to an object.
+func (s *GRPCServer) RelatedObjects(ctx context.Context, objectRequest *dashboard.ObjectRequest) (*dashboard.RelatedObjectsResponse, error) {
+	u, err := decodeObjectRequest(objectRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	ror, err := s.Impl.RelatedObjects(ctx, u)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc server related objects")
+	}
+
+	out := &dashboard.RelatedObjectsResponse{
+		Keys: convertFromRelatedObjectKeys(ror.Keys),
+	}
+
+	return out, nil
+}
+
 // WatchAdd is called when a watched GVK has a new object added.
 func (s *GRPCServer) WatchAdd(context.Context, *dashboard.WatchRequest) (*dashboard.Empty, error) {
 	panic("not implemented")
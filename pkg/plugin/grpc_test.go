@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -24,6 +25,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 	"github.com/vmware-tanzu/octant/pkg/view/flexlayout"
 )
@@ -147,15 +149,39 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsPrinterItems:  inGVKs,
 				SupportsObjectStatus:  inGVKs,
 				SupportsTab:           inGVKs,
+				ObjectActions: []*dashboard.RegisterResponse_ObjectAction{
+					{
+						ActionName:    "my-plugin/restart",
+						Label:         "Restart",
+						Confirmation:  "Restart this pod?",
+						SupportedGVKs: inGVKs,
+					},
+				},
+				NavigationContributions: []*dashboard.RegisterResponse_NavigationContribution{
+					{Module: "overview", Section: "Workloads", Title: "Traces", Path: "traces", IconName: "trace"},
+				},
 			},
 		}
 
-		mocks.protoClient.EXPECT().Register(gomock.Any(), gomock.Any(), grpc.WaitForReady(true)).Return(resp, nil)
+		apiAddress := "localhost:54321"
+		apiToken := "token"
+		configuration := plugin.Configuration{"endpoint": "https://example.com"}
+
+		req := &dashboard.RegisterRequest{
+			DashboardAPIAddress: apiAddress,
+			DashboardAPIToken:   apiToken,
+			Configuration:       configuration,
+		}
+
+		mocks.protoClient.EXPECT().Register(gomock.Any(), gomock.Eq(req), grpc.WaitForReady(true)).Return(resp, nil)
 
 		client := mocks.genClient()
-		apiAddress := "localhost:54321"
 		ctx := context.Background()
-		got, err := client.Register(ctx, apiAddress)
+		got, err := client.RegisterWith(ctx, plugin.Registration{
+			DashboardAPIAddress: apiAddress,
+			DashboardAPIToken:   apiToken,
+			Configuration:       configuration,
+		})
 		require.NoError(t, err)
 
 		outGVKs := []schema.GroupVersionKind{{Version: "v1", Kind: "Pod"}}
@@ -169,6 +195,17 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsPrinterItems:  outGVKs,
 				SupportsObjectStatus:  outGVKs,
 				SupportsTab:           outGVKs,
+				ObjectActions: []plugin.ObjectAction{
+					{
+						ActionName:    "my-plugin/restart",
+						Label:         "Restart",
+						Confirmation:  "Restart this pod?",
+						SupportedGVKs: outGVKs,
+					},
+				},
+				NavigationContributions: []navigation.Contribution{
+					{Module: "overview", Section: "Workloads", Title: "Traces", Path: "traces", IconName: "trace"},
+				},
 			},
 		}
 		assert.Equal(t, expected, got)
@@ -314,6 +351,100 @@ func Test_GRPCClient_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCClient_PrintListColumns(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		pod1 := testutil.CreatePod("pod1")
+		pod2 := testutil.CreatePod("pod2")
+
+		objectsRequest := &dashboard.ObjectsRequest{}
+		for _, object := range []runtime.Object{pod1, pod2} {
+			objectData, err := json.Marshal(object)
+			require.NoError(t, err)
+			objectsRequest.Objects = append(objectsRequest.Objects, objectData)
+		}
+
+		listColumnsResponse := &dashboard.PrintListColumnsResponse{
+			Rows: []*dashboard.PrintListColumnsResponse_Row{
+				{
+					Columns: []*dashboard.PrintListColumnsResponse_Column{
+						{Name: "Team", Component: encodeComponent(t, component.NewText("platform"))},
+					},
+				},
+				{},
+			},
+		}
+
+		mocks.protoClient.EXPECT().
+			PrintListColumns(gomock.Any(), gomock.Eq(objectsRequest), grpc.WaitForReady(true)).
+			Return(listColumnsResponse, nil)
+
+		client := mocks.genClient()
+		ctx := context.Background()
+		got, err := client.PrintListColumns(ctx, []runtime.Object{pod1, pod2})
+		require.NoError(t, err)
+
+		expected := []plugin.ListColumnsResponse{
+			{
+				Columns: []plugin.ListColumn{
+					{Name: "Team", Value: component.NewText("platform")},
+				},
+			},
+			{},
+		}
+
+		testutil.AssertJSONEqual(t, expected, got)
+	})
+}
+
+func Test_GRPCClient_PrintListColumns_missing_rows(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		pod := testutil.CreatePod("pod")
+
+		mocks.protoClient.EXPECT().
+			PrintListColumns(gomock.Any(), gomock.Any(), grpc.WaitForReady(true)).
+			Return(&dashboard.PrintListColumnsResponse{}, nil)
+
+		client := mocks.genClient()
+		_, err := client.PrintListColumns(context.Background(), []runtime.Object{pod})
+		require.Error(t, err)
+	})
+}
+
+func Test_GRPCClient_RelatedObjects(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		object := testutil.CreatePod("pod")
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+		objectRequest := &dashboard.ObjectRequest{
+			Object: objectData,
+		}
+
+		relatedObjectsResponse := &dashboard.RelatedObjectsResponse{
+			Keys: []*dashboard.RelatedObjectsResponse_Key{
+				{Namespace: "default", ApiVersion: "v1", Kind: "Secret", Name: "secret"},
+			},
+		}
+
+		mocks.protoClient.EXPECT().
+			RelatedObjects(gomock.Any(), gomock.Eq(objectRequest), grpc.WaitForReady(true)).
+			Return(relatedObjectsResponse, nil)
+
+		client := mocks.genClient()
+		ctx := context.Background()
+		got, err := client.RelatedObjects(ctx, object)
+		require.NoError(t, err)
+
+		expected := plugin.RelatedObjectsResponse{
+			Keys: []store.Key{
+				{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"},
+			},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func Test_GRPCServer_Content(t *testing.T) {
 	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
 		server := mocks.genModuleServer()
@@ -373,18 +504,34 @@ func Test_GRPCServer_Register(t *testing.T) {
 				SupportsPrinterItems:  inGVKs,
 				SupportsObjectStatus:  inGVKs,
 				SupportsTab:           inGVKs,
+				ObjectActions: []plugin.ObjectAction{
+					{
+						ActionName:    "my-plugin/restart",
+						Label:         "Restart",
+						SupportedGVKs: inGVKs,
+					},
+				},
+				NavigationContributions: []navigation.Contribution{
+					{Module: "overview", Section: "Workloads", Title: "Traces", Path: "traces"},
+				},
 			},
 		}
 
 		apiAddress := "localhost:54321"
+		apiToken := "token"
+		configuration := map[string]string{"endpoint": "https://example.com"}
 
-		mocks.service.EXPECT().Register(gomock.Any(), gomock.Eq(apiAddress)).Return(metadata, nil)
+		mocks.service.EXPECT().
+			Register(gomock.Any(), gomock.Eq(apiAddress)).
+			Return(metadata, nil)
 
 		server := mocks.genServer()
 
 		ctx := context.Background()
 		got, err := server.Register(ctx, &dashboard.RegisterRequest{
 			DashboardAPIAddress: apiAddress,
+			DashboardAPIToken:   apiToken,
+			Configuration:       configuration,
 		})
 		require.NoError(t, err)
 
@@ -398,6 +545,16 @@ func Test_GRPCServer_Register(t *testing.T) {
 				SupportsPrinterItems:  outGVKs,
 				SupportsObjectStatus:  outGVKs,
 				SupportsTab:           outGVKs,
+				ObjectActions: []*dashboard.RegisterResponse_ObjectAction{
+					{
+						ActionName:    "my-plugin/restart",
+						Label:         "Restart",
+						SupportedGVKs: outGVKs,
+					},
+				},
+				NavigationContributions: []*dashboard.RegisterResponse_NavigationContribution{
+					{Module: "overview", Section: "Workloads", Title: "Traces", Path: "traces"},
+				},
 			},
 		}
 
@@ -405,6 +562,49 @@ func Test_GRPCServer_Register(t *testing.T) {
 	})
 }
 
+type registrationService struct {
+	*fake.MockService
+	*fake.MockRegistrationService
+}
+
+func Test_GRPCServer_Register_registration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := registrationService{
+		MockService:             fake.NewMockService(controller),
+		MockRegistrationService: fake.NewMockRegistrationService(controller),
+	}
+
+	registration := plugin.Registration{
+		DashboardAPIAddress: "localhost:54321",
+		DashboardAPIToken:   "token",
+		Configuration:       plugin.Configuration{"endpoint": "https://example.com"},
+	}
+
+	service.MockRegistrationService.EXPECT().
+		RegisterWith(gomock.Any(), gomock.Eq(registration)).
+		Return(plugin.Metadata{Name: "my-plugin"}, nil)
+
+	server := &plugin.GRPCServer{Impl: service}
+
+	// send the request through the wire format so fields missing from the
+	// generated descriptor are caught
+	data, err := proto.Marshal(&dashboard.RegisterRequest{
+		DashboardAPIAddress: registration.DashboardAPIAddress,
+		DashboardAPIToken:   registration.DashboardAPIToken,
+		Configuration:       registration.Configuration,
+	})
+	require.NoError(t, err)
+
+	req := &dashboard.RegisterRequest{}
+	require.NoError(t, proto.Unmarshal(data, req))
+
+	got, err := server.Register(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "my-plugin", got.PluginName)
+}
+
 func Test_GRPCServer_Print(t *testing.T) {
 	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
 		object := testutil.CreateDeployment("deployment")
@@ -503,6 +703,94 @@ func Test_GRPCServer_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCServer_PrintListColumns(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		pod1 := testutil.CreatePod("pod1")
+		pod2 := testutil.CreatePod("pod2")
+
+		value := component.NewText("platform")
+		responses := []plugin.ListColumnsResponse{
+			{
+				Columns: []plugin.ListColumn{
+					{Name: "Team", Value: value},
+				},
+			},
+			{},
+		}
+
+		objectsRequest := &dashboard.ObjectsRequest{}
+		var objects []runtime.Object
+		for _, object := range []runtime.Object{pod1, pod2} {
+			m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+			require.NoError(t, err)
+			objects = append(objects, &unstructured.Unstructured{Object: m})
+
+			objectData, err := json.Marshal(object)
+			require.NoError(t, err)
+			objectsRequest.Objects = append(objectsRequest.Objects, objectData)
+		}
+
+		mocks.service.EXPECT().PrintListColumns(gomock.Any(), gomock.Eq(objects)).Return(responses, nil)
+
+		ctx := context.Background()
+
+		server := mocks.genServer()
+		got, err := server.PrintListColumns(ctx, objectsRequest)
+		require.NoError(t, err)
+
+		expected := &dashboard.PrintListColumnsResponse{
+			Rows: []*dashboard.PrintListColumnsResponse_Row{
+				{
+					Columns: []*dashboard.PrintListColumnsResponse_Column{
+						{Name: "Team", Component: encodeComponent(t, value)},
+					},
+				},
+				{},
+			},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
+func Test_GRPCServer_RelatedObjects(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		object := testutil.CreatePod("pod")
+
+		ror := plugin.RelatedObjectsResponse{
+			Keys: []store.Key{
+				{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"},
+			},
+		}
+
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		require.NoError(t, err)
+		u := &unstructured.Unstructured{Object: m}
+
+		mocks.service.EXPECT().RelatedObjects(gomock.Any(), gomock.Eq(u)).Return(ror, nil)
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+		objectRequest := &dashboard.ObjectRequest{
+			Object: objectData,
+		}
+
+		ctx := context.Background()
+
+		server := mocks.genServer()
+		got, err := server.RelatedObjects(ctx, objectRequest)
+		require.NoError(t, err)
+
+		expected := &dashboard.RelatedObjectsResponse{
+			Keys: []*dashboard.RelatedObjectsResponse_Key{
+				{Namespace: "default", ApiVersion: "v1", Kind: "Secret", Name: "secret"},
+			},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func encodeComponent(t *testing.T, view component.Component) []byte {
 	data, err := json.Marshal(view)
 	require.NoError(t, err)
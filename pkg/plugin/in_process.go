@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// InProcessPlugin is a plugin which runs inside Octant rather than as a separate process.
+type InProcessPlugin interface {
+	Close()
+	PluginPath() string
+	Metadata() *Metadata
+
+	Navigation(ctx context.Context) (navigation.Navigation, error)
+	Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error)
+	RegisterWith(ctx context.Context, registration Registration) (Metadata, error)
+	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
+	PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error)
+	PrintListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error)
+	RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error)
+	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
+	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
+	Content(ctx context.Context, contentPath string) (component.ContentResponse, error)
+}
+
+// printListColumnsForEach prints list table columns for objects with a handler
+// which is called for one object at a time.
+func printListColumnsForEach(ctx context.Context, objects []runtime.Object, print func(ctx context.Context, object runtime.Object) (ListColumnsResponse, error)) ([]ListColumnsResponse, error) {
+	var responses []ListColumnsResponse
+	for _, object := range objects {
+		response, err := print(ctx, object)
+		if err != nil {
+			return nil, err
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+// IsInProcessPlugin returns true if the plugin runs inside Octant rather than as a
+// separate process. JavaScript and WebAssembly plugins run in process.
+func IsInProcessPlugin(pluginName string) bool {
+	return IsJavaScriptPlugin(pluginName) || IsWASMPlugin(pluginName)
+}
+
+// NewInProcessPlugin creates a JavaScript or WebAssembly plugin depending on the
+// plugin's file extension. JavaScript plugins only use the registration's configuration.
+func NewInProcessPlugin(ctx context.Context, objectStore store.Store, pluginPath string, registration Registration) (InProcessPlugin, error) {
+	if IsWASMPlugin(pluginPath) {
+		return NewWASMPlugin(ctx, objectStore, pluginPath, registration, CreateWASMModule)
+	}
+
+	return NewJSPlugin(ctx, objectStore, pluginPath, registration.Configuration, CreateRuntimeLoop, ExtractDefaultClass, ExtractMetadata)
+}
@@ -0,0 +1,415 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+// manifestsDir is the directory in a plugin directory that contains the manifests
+// of installed plugins.
+const manifestsDir = ".manifests"
+
+// InstalledPlugin is a plugin in a plugin directory.
+type InstalledPlugin struct {
+	// Path is the path to the plugin.
+	Path string
+	// Manifest is the manifest the plugin was installed with. It is nil for plugins
+	// that were copied into the plugin directory by hand.
+	Manifest *Manifest
+}
+
+// Name returns the name of the plugin.
+func (p InstalledPlugin) Name() string {
+	if p.Manifest != nil {
+		return p.Manifest.Name
+	}
+
+	return filepath.Base(p.Path)
+}
+
+// Installer installs, lists, and removes plugins in the plugin directory.
+type Installer struct {
+	config        Config
+	octantVersion string
+	clientFactory ClientFactory
+}
+
+// NewInstaller creates an instance of Installer. Plugins are checked for compatibility
+// with octantVersion before they are installed.
+func NewInstaller(config Config, octantVersion string) *Installer {
+	return &Installer{
+		config:        config,
+		octantVersion: octantVersion,
+		clientFactory: NewDefaultClientFactory(),
+	}
+}
+
+// Install installs a plugin from a package. The source is a plugin directory containing
+// a manifest, a manifest file, or a gzipped tarball containing a manifest. The plugin
+// is checked for compatibility and its checksum is verified before it is copied.
+func (i *Installer) Install(source string) (*InstalledPlugin, error) {
+	manifest, data, err := i.readPackage(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manifest.CheckCompatibility(i.octantVersion); err != nil {
+		return nil, err
+	}
+
+	if err := manifest.VerifyChecksum(data); err != nil {
+		return nil, err
+	}
+
+	dir, err := i.installDir()
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := i.Get(manifest.Name)
+	if err == nil && existing.Manifest != nil && existing.Manifest.File != manifest.File {
+		if _, err := i.Remove(manifest.Name); err != nil {
+			return nil, fmt.Errorf("remove previous version of plugin %q: %w", manifest.Name, err)
+		}
+	}
+
+	fs := i.config.Fs()
+
+	if err := fs.MkdirAll(filepath.Join(dir, manifestsDir), 0755); err != nil {
+		return nil, fmt.Errorf("create plugin directory: %w", err)
+	}
+
+	var mode os.FileMode = 0755
+	if IsInProcessPlugin(manifest.File) {
+		mode = 0644
+	}
+
+	pluginPath := filepath.Join(dir, manifest.File)
+	if err := afero.WriteFile(fs, pluginPath, data, mode); err != nil {
+		return nil, fmt.Errorf("write plugin: %w", err)
+	}
+	// WriteFile does not change the mode of an existing file.
+	if err := fs.Chmod(pluginPath, mode); err != nil {
+		return nil, fmt.Errorf("set plugin mode: %w", err)
+	}
+
+	manifestData, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+
+	if err := afero.WriteFile(fs, manifestPath(dir, manifest.Name), manifestData, 0644); err != nil {
+		return nil, fmt.Errorf("write manifest: %w", err)
+	}
+
+	return &InstalledPlugin{
+		Path:     pluginPath,
+		Manifest: manifest,
+	}, nil
+}
+
+// List lists the plugins in the plugin directories.
+func (i *Installer) List() ([]InstalledPlugin, error) {
+	pluginPaths, err := AvailablePlugins(i.config)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := make(map[string]*Manifest)
+
+	for _, pluginPath := range pluginPaths {
+		dir := filepath.Dir(pluginPath)
+		if _, ok := manifests[dir]; ok {
+			continue
+		}
+
+		dirManifests, err := i.readManifests(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range dirManifests {
+			manifests[k] = v
+		}
+	}
+
+	var list []InstalledPlugin
+	for _, pluginPath := range pluginPaths {
+		list = append(list, InstalledPlugin{
+			Path:     pluginPath,
+			Manifest: manifests[pluginPath],
+		})
+	}
+
+	sort.Slice(list, func(a, b int) bool {
+		return list[a].Name() < list[b].Name()
+	})
+
+	return list, nil
+}
+
+// Get returns the installed plugin with a name. Plugins without a manifest are
+// named after their file.
+func (i *Installer) Get(name string) (*InstalledPlugin, error) {
+	list, err := i.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, installedPlugin := range list {
+		if installedPlugin.Name() == name {
+			return &installedPlugin, nil
+		}
+	}
+
+	return nil, fmt.Errorf("plugin %q is not installed", name)
+}
+
+// Remove removes the installed plugin with a name and its manifest.
+func (i *Installer) Remove(name string) (*InstalledPlugin, error) {
+	installedPlugin, err := i.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := i.config.Fs()
+
+	if err := fs.Remove(installedPlugin.Path); err != nil {
+		return nil, fmt.Errorf("remove plugin: %w", err)
+	}
+
+	if installedPlugin.Manifest != nil {
+		p := manifestPath(filepath.Dir(installedPlugin.Path), installedPlugin.Manifest.Name)
+		if err := fs.Remove(p); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("remove manifest: %w", err)
+		}
+	}
+
+	return installedPlugin, nil
+}
+
+// Metadata starts an installed plugin and returns the metadata it registers with. This is
+// the same metadata the plugin manager records when it loads the plugin.
+func (i *Installer) Metadata(ctx context.Context, installedPlugin InstalledPlugin) (*Metadata, error) {
+	configurations, err := LoadConfigurations(i.config)
+	if err != nil {
+		return nil, err
+	}
+	configuration := configurations[ConfigurationKey(installedPlugin.Path)]
+
+	if IsInProcessPlugin(installedPlugin.Path) {
+		inProcessPlugin, err := NewInProcessPlugin(ctx, nil, installedPlugin.Path, Registration{Configuration: configuration})
+		if err != nil {
+			return nil, err
+		}
+		defer inProcessPlugin.Close()
+
+		return inProcessPlugin.Metadata(), nil
+	}
+
+	client := i.clientFactory.Init(ctx, installedPlugin.Path)
+	defer client.Kill()
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, fmt.Errorf("get rpc client for %q: %w", installedPlugin.Path, err)
+	}
+
+	raw, err := rpcClient.Dispense("plugin")
+	if err != nil {
+		return nil, fmt.Errorf("dispensing plugin for %q: %w", installedPlugin.Path, err)
+	}
+
+	service, ok := raw.(Service)
+	if !ok {
+		return nil, fmt.Errorf("unknown type for plugin %q: %T", installedPlugin.Path, raw)
+	}
+
+	metadata, err := register(ctx, service, Registration{Configuration: configuration})
+	if err != nil {
+		return nil, fmt.Errorf("register plugin %q: %w", installedPlugin.Path, err)
+	}
+
+	return &metadata, nil
+}
+
+// installDir returns the directory plugins are installed in. This is the plugin path
+// if it is set, and the default plugin directory otherwise.
+func (i *Installer) installDir() (string, error) {
+	dirs, err := i.config.PluginDirs(i.config.Home())
+	if err != nil {
+		return "", fmt.Errorf("get plugin directory: %w", err)
+	}
+
+	if len(dirs) == 0 {
+		return "", fmt.Errorf("unable to determine plugin directory")
+	}
+
+	return dirs[0], nil
+}
+
+// readManifests reads the manifests in a plugin directory. Manifests are keyed by the
+// path of their plugin.
+func (i *Installer) readManifests(dir string) (map[string]*Manifest, error) {
+	fs := i.config.Fs()
+
+	fis, err := afero.ReadDir(fs, filepath.Join(dir, manifestsDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]*Manifest{}, nil
+		}
+		return nil, fmt.Errorf("read manifests: %w", err)
+	}
+
+	manifests := make(map[string]*Manifest)
+	for _, fi := range fis {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != ".yaml" {
+			continue
+		}
+
+		data, err := afero.ReadFile(fs, filepath.Join(dir, manifestsDir, fi.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read manifest: %w", err)
+		}
+
+		manifest, err := ParseManifest(data)
+		if err != nil {
+			return nil, fmt.Errorf("manifest %q: %w", fi.Name(), err)
+		}
+
+		manifests[filepath.Join(dir, manifest.File)] = manifest
+	}
+
+	return manifests, nil
+}
+
+// readPackage reads the manifest and plugin file from a package.
+func (i *Installer) readPackage(source string) (*Manifest, []byte, error) {
+	fs := i.config.Fs()
+
+	fi, err := fs.Stat(source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read package: %w", err)
+	}
+
+	if isArchive(source) {
+		return i.readArchive(source)
+	}
+
+	manifestFile := source
+	if fi.IsDir() {
+		manifestFile = filepath.Join(source, ManifestFileName)
+	}
+
+	manifestData, err := afero.ReadFile(fs, manifestFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	manifest, err := ParseManifest(manifestData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := afero.ReadFile(fs, filepath.Join(filepath.Dir(manifestFile), manifest.File))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read plugin: %w", err)
+	}
+
+	return manifest, data, nil
+}
+
+// readArchive reads the manifest and plugin file from a gzipped tarball. The manifest
+// can be at the root of the tarball or in a top level directory.
+func (i *Installer) readArchive(source string) (*Manifest, []byte, error) {
+	f, err := i.config.Fs().Open(source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open package: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read package: %w", err)
+	}
+	defer func() {
+		_ = gzr.Close()
+	}()
+
+	files := make(map[string][]byte)
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read package: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read %q from package: %w", header.Name, err)
+		}
+
+		files[path.Clean(header.Name)] = data
+	}
+
+	var manifestFile string
+	for name := range files {
+		if path.Base(name) != ManifestFileName || strings.Count(name, "/") > 1 {
+			continue
+		}
+		if manifestFile == "" || len(name) < len(manifestFile) {
+			manifestFile = name
+		}
+	}
+
+	if manifestFile == "" {
+		return nil, nil, fmt.Errorf("package does not contain %s", ManifestFileName)
+	}
+
+	manifest, err := ParseManifest(files[manifestFile])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, ok := files[path.Join(path.Dir(manifestFile), manifest.File)]
+	if !ok {
+		return nil, nil, fmt.Errorf("package does not contain plugin file %q", manifest.File)
+	}
+
+	return manifest, data, nil
+}
+
+func manifestPath(dir, name string) string {
+	return filepath.Join(dir, manifestsDir, name+".yaml")
+}
+
+func isArchive(source string) bool {
+	return strings.HasSuffix(source, ".tar.gz") || strings.HasSuffix(source, ".tgz")
+}
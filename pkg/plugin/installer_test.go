@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstaller(t *testing.T) {
+	tests := []struct {
+		name          string
+		source        func(t *testing.T, fs afero.Fs, manifest string, data []byte) string
+		octantVersion string
+		checksum      string
+		wantErr       bool
+	}{
+		{
+			name: "directory",
+			source: func(t *testing.T, fs afero.Fs, manifest string, data []byte) string {
+				stagePackageFile(t, fs, filepath.Join("/src", ManifestFileName), []byte(manifest))
+				stagePackageFile(t, fs, filepath.Join("/src", "octant-sample"), data)
+				return "/src"
+			},
+			octantVersion: "v0.12.0",
+		},
+		{
+			name: "manifest file",
+			source: func(t *testing.T, fs afero.Fs, manifest string, data []byte) string {
+				stagePackageFile(t, fs, filepath.Join("/src", "sample.yaml"), []byte(manifest))
+				stagePackageFile(t, fs, filepath.Join("/src", "octant-sample"), data)
+				return filepath.Join("/src", "sample.yaml")
+			},
+			octantVersion: "v0.12.0",
+		},
+		{
+			name: "tarball",
+			source: func(t *testing.T, fs afero.Fs, manifest string, data []byte) string {
+				archive := createPackageArchive(t, map[string][]byte{
+					"sample/" + ManifestFileName: []byte(manifest),
+					"sample/octant-sample":       data,
+				})
+				stagePackageFile(t, fs, "/sample.tar.gz", archive)
+				return "/sample.tar.gz"
+			},
+			octantVersion: "v0.12.0",
+		},
+		{
+			name: "incompatible octant version",
+			source: func(t *testing.T, fs afero.Fs, manifest string, data []byte) string {
+				stagePackageFile(t, fs, filepath.Join("/src", ManifestFileName), []byte(manifest))
+				stagePackageFile(t, fs, filepath.Join("/src", "octant-sample"), data)
+				return "/src"
+			},
+			octantVersion: "v0.11.0",
+			wantErr:       true,
+		},
+		{
+			name: "checksum mismatch",
+			source: func(t *testing.T, fs afero.Fs, manifest string, data []byte) string {
+				stagePackageFile(t, fs, filepath.Join("/src", ManifestFileName), []byte(manifest))
+				stagePackageFile(t, fs, filepath.Join("/src", "octant-sample"), data)
+				return "/src"
+			},
+			octantVersion: "v0.12.0",
+			checksum:      Checksum([]byte("other")),
+			wantErr:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			c := &defaultConfig{
+				fs: fs,
+				os: "unix",
+				homeFn: func() string {
+					return filepath.Join("/home", "user")
+				},
+			}
+
+			dirs, err := c.PluginDirs(c.Home())
+			require.NoError(t, err)
+			pluginDir := dirs[0]
+
+			data := []byte("plugin")
+			checksum := Checksum(data)
+			if test.checksum != "" {
+				checksum = test.checksum
+			}
+
+			manifest := fmt.Sprintf(`
+name: sample
+version: 0.1.0
+file: octant-sample
+checksum: %s
+octantVersion: 0.12.0
+`, checksum)
+
+			installer := NewInstaller(c, test.octantVersion)
+
+			source := test.source(t, fs, manifest, data)
+			got, err := installer.Install(source)
+			if test.wantErr {
+				require.Error(t, err)
+
+				list, err := installer.List()
+				require.NoError(t, err)
+				require.Empty(t, list)
+				return
+			}
+			require.NoError(t, err)
+
+			pluginPath := filepath.Join(pluginDir, "octant-sample")
+
+			expected := &InstalledPlugin{
+				Path: pluginPath,
+				Manifest: &Manifest{
+					Name:          "sample",
+					Version:       "0.1.0",
+					File:          "octant-sample",
+					Checksum:      checksum,
+					OctantVersion: "0.12.0",
+				},
+			}
+			require.Equal(t, expected, got)
+
+			fi, err := fs.Stat(pluginPath)
+			require.NoError(t, err)
+			assert.Equal(t, "-rwxr-xr-x", fi.Mode().String())
+
+			stagePackageFile(t, fs, filepath.Join(pluginDir, "unmanaged"), []byte("plugin"))
+
+			list, err := installer.List()
+			require.NoError(t, err)
+			require.Equal(t, []InstalledPlugin{
+				*expected,
+				{Path: filepath.Join(pluginDir, "unmanaged")},
+			}, list)
+
+			removed, err := installer.Remove("sample")
+			require.NoError(t, err)
+			require.Equal(t, expected, removed)
+
+			_, err = installer.Get("sample")
+			require.Error(t, err)
+
+			_, err = fs.Stat(manifestPath(pluginDir, "sample"))
+			require.Error(t, err)
+		})
+	}
+}
+
+func stagePackageFile(t *testing.T, fs afero.Fs, name string, data []byte) {
+	require.NoError(t, fs.MkdirAll(filepath.Dir(name), 0755))
+	require.NoError(t, afero.WriteFile(fs, name, data, 0755))
+}
+
+func createPackageArchive(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+
+	for name, data := range files {
+		header := &tar.Header{
+			Name:     name,
+			Mode:     0755,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}
+		require.NoError(t, tw.WriteHeader(header))
+		_, err := tw.Write(data)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf.Bytes()
+}
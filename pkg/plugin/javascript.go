@@ -36,17 +36,7 @@ type pluginMetadataExtractor func(*goja.Runtime, goja.Value) (*Metadata, error)
 
 // JSPlugin interface represents a JavaScript plugin.
 type JSPlugin interface {
-	Close()
-	PluginPath() string
-	Metadata() *Metadata
-
-	Navigation(ctx context.Context) (navigation.Navigation, error)
-	Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error)
-	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
-	PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error)
-	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
-	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
-	Content(ctx context.Context, contentPath string) (component.ContentResponse, error)
+	InProcessPlugin
 }
 
 type jsPlugin struct {
@@ -68,8 +58,9 @@ type jsPlugin struct {
 
 var _ JSPlugin = (*jsPlugin)(nil)
 
-// NewJSPlugin creates a new instances of a JavaScript plugin.
-func NewJSPlugin(ctx context.Context, objectStore store.Store, pluginPath string, prf pluginRuntimeFactory, pce pluginClassExtractor, pme pluginMetadataExtractor) (*jsPlugin, error) {
+// NewJSPlugin creates a new instances of a JavaScript plugin. The plugin's configuration
+// is passed to the plugin class constructor.
+func NewJSPlugin(ctx context.Context, objectStore store.Store, pluginPath string, configuration Configuration, prf pluginRuntimeFactory, pce pluginClassExtractor, pme pluginMetadataExtractor) (*jsPlugin, error) {
 	loop, err := prf(ctx, pluginPath)
 	if err != nil {
 		return nil, fmt.Errorf("initializing runtime: %w", err)
@@ -101,9 +92,11 @@ func NewJSPlugin(ctx context.Context, objectStore store.Store, pluginPath string
 		gc := &dashboardClient{
 			objectStore: objectStore,
 			vm:          vm,
+			loop:        loop,
 			ctx:         ctx,
 		}
 		vm.Set("dashboardClient", createClientObject(gc))
+		vm.Set("pluginConfiguration", map[string]string(configuration))
 
 		pluginClass, err = pce(vm)
 		if err != nil {
@@ -147,12 +140,12 @@ func (t *jsPlugin) PluginPath() string {
 	return t.pluginPath
 }
 
-func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error) {
+func (t *jsPlugin) Navigation(ctx context.Context) (navigation.Navigation, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
 	nav := navigation.Navigation{}
-	errCh := make(chan error)
+	errCh := make(chan error, 1)
 
 	t.loop.RunOnLoop(func(vm *goja.Runtime) {
 		handler, err := vm.RunString("_concretePlugin.navigationHandler")
@@ -173,20 +166,21 @@ func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error)
 			return
 		}
 
-		jsonNav, err := json.Marshal(s.Export())
-		if err != nil {
-			errCh <- fmt.Errorf("unable to marshal navigation json: %w", err)
-			return
-		}
+		settleValue(vm, s, errCh, func(v goja.Value) error {
+			jsonNav, err := json.Marshal(v.Export())
+			if err != nil {
+				return fmt.Errorf("unable to marshal navigation json: %w", err)
+			}
 
-		if err := json.Unmarshal(jsonNav, &nav); err != nil {
-			errCh <- fmt.Errorf("unable to unmarshal navigation json: %w", err)
-			return
-		}
-		errCh <- nil
+			if err := json.Unmarshal(jsonNav, &nav); err != nil {
+				return fmt.Errorf("unable to unmarshal navigation json: %w", err)
+			}
+
+			return nil
+		})
 	})
 
-	err := <-errCh
+	err := waitForResult(ctx, errCh)
 	if err != nil {
 		return nav, err
 	}
@@ -194,12 +188,12 @@ func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error)
 	return nav, nil
 }
 
-func (t *jsPlugin) Content(_ context.Context, contentPath string) (component.ContentResponse, error) {
+func (t *jsPlugin) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
 	cr := component.ContentResponse{}
-	errCh := make(chan error)
+	errCh := make(chan error, 1)
 
 	t.loop.RunOnLoop(func(vm *goja.Runtime) {
 		handler, err := vm.RunString("_concretePlugin.contentHandler")
@@ -224,88 +218,80 @@ func (t *jsPlugin) Content(_ context.Context, contentPath string) (component.Con
 			return
 		}
 
-		pluginResp := s.ToObject(vm)
-		if pluginResp == nil {
-			errCh <- fmt.Errorf("empty contentResponse")
-			return
-		}
-
-		content := pluginResp.Get("content")
-		if content == goja.Undefined() {
-			errCh <- fmt.Errorf("unable to get content from contentResponse")
-			return
-		}
+		settleValue(vm, s, errCh, func(v goja.Value) error {
+			if !isSet(v) {
+				return fmt.Errorf("empty contentResponse")
+			}
 
-		contentObj, ok := content.Export().(map[string]interface{})
-		if !ok {
-			errCh <- fmt.Errorf("unable to get content as map from contentResponse")
-			return
-		}
+			pluginResp := v.ToObject(vm)
+			content := pluginResp.Get("content")
+			if content == goja.Undefined() {
+				return fmt.Errorf("unable to get content from contentResponse")
+			}
 
-		rawTitle, ok := contentObj["title"]
-		if ok {
-			titles, ok := rawTitle.([]interface{})
+			contentObj, ok := content.Export().(map[string]interface{})
 			if !ok {
-				errCh <- fmt.Errorf("unable to get title array from content")
-				return
+				return fmt.Errorf("unable to get content as map from contentResponse")
 			}
-			for i, c := range titles {
-				realTitle, err := extractComponent(fmt.Sprintf("title[%d]", i), c)
-				if err != nil {
-					errCh <- fmt.Errorf("unable to extract title: %w", err)
-					return
-				}
 
-				title, ok := realTitle.(component.TitleComponent)
+			rawTitle, ok := contentObj["title"]
+			if ok {
+				titles, ok := rawTitle.([]interface{})
 				if !ok {
-					errCh <- fmt.Errorf("unable to convert component to TitleComponent")
-					return
+					return fmt.Errorf("unable to get title array from content")
+				}
+				for i, c := range titles {
+					realTitle, err := extractComponent(fmt.Sprintf("title[%d]", i), c)
+					if err != nil {
+						return fmt.Errorf("unable to extract title: %w", err)
+					}
+
+					title, ok := realTitle.(component.TitleComponent)
+					if !ok {
+						return fmt.Errorf("unable to convert component to TitleComponent")
+					}
+					cr.Title = append(cr.Title, title)
 				}
-				cr.Title = append(cr.Title, title)
 			}
-		}
-
-		rawComponents, ok := contentObj["viewComponents"]
-		if !ok {
-			errCh <- fmt.Errorf("unable to get viewComponents from content")
-			return
-		}
 
-		components, ok := rawComponents.([]interface{})
-		if !ok {
-			errCh <- fmt.Errorf("unable to get viewComponents list")
-			return
-		}
+			rawComponents, ok := contentObj["viewComponents"]
+			if !ok {
+				return fmt.Errorf("unable to get viewComponents from content")
+			}
 
-		for i, c := range components {
-			realComponent, err := extractComponent(fmt.Sprintf("viewComponent[%d]", i), c)
-			if err != nil {
-				errCh <- fmt.Errorf("unable to extract component: %w", err)
-				return
+			components, ok := rawComponents.([]interface{})
+			if !ok {
+				return fmt.Errorf("unable to get viewComponents list")
 			}
-			cr.Add(realComponent)
-		}
 
-		rawButtonGroup, ok := contentObj["buttonGroup"]
-		if ok {
-			realButtonGroup, err := extractComponent("buttonGroup", rawButtonGroup)
-			if err != nil {
-				errCh <- fmt.Errorf("unable to extract buttonGroup: %w", err)
-				return
+			for i, c := range components {
+				realComponent, err := extractComponent(fmt.Sprintf("viewComponent[%d]", i), c)
+				if err != nil {
+					return fmt.Errorf("unable to extract component: %w", err)
+				}
+				cr.Add(realComponent)
 			}
 
-			buttonGroup, ok := realButtonGroup.(*component.ButtonGroup)
-			if !ok {
-				errCh <- fmt.Errorf("unable to convert extracted component to buttonGroup")
-				return
+			rawButtonGroup, ok := contentObj["buttonGroup"]
+			if ok {
+				realButtonGroup, err := extractComponent("buttonGroup", rawButtonGroup)
+				if err != nil {
+					return fmt.Errorf("unable to extract buttonGroup: %w", err)
+				}
+
+				buttonGroup, ok := realButtonGroup.(*component.ButtonGroup)
+				if !ok {
+					return fmt.Errorf("unable to convert extracted component to buttonGroup")
+				}
+
+				cr.ButtonGroup = buttonGroup
 			}
 
-			cr.ButtonGroup = buttonGroup
-		}
-		errCh <- nil
+			return nil
+		})
 	})
 
-	if err := <-errCh; err != nil {
+	if err := waitForResult(ctx, errCh); err != nil {
 		return cr, err
 	}
 	return cr, nil
@@ -319,11 +305,15 @@ func (t *jsPlugin) Register(_ context.Context, _ string) (Metadata, error) {
 	return Metadata{}, fmt.Errorf("not implemented")
 }
 
-func (t *jsPlugin) PrintTab(_ context.Context, object runtime.Object) (TabResponse, error) {
+func (t *jsPlugin) RegisterWith(_ context.Context, _ Registration) (Metadata, error) {
+	return Metadata{}, fmt.Errorf("not implemented")
+}
+
+func (t *jsPlugin) PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	tabResponse, err := t.objectRequestCall("tabHandler", object)
+	tabResponse, err := t.objectRequestCall(ctx, "tabHandler", object)
 	if err != nil {
 		return TabResponse{}, err
 	}
@@ -357,11 +347,87 @@ func (t *jsPlugin) PrintTab(_ context.Context, object runtime.Object) (TabRespon
 	}, nil
 }
 
-func (t *jsPlugin) ObjectStatus(_ context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+func (t *jsPlugin) PrintListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error) {
+	return printListColumnsForEach(ctx, objects, t.printListColumns)
+}
+
+func (t *jsPlugin) printListColumns(ctx context.Context, object runtime.Object) (ListColumnsResponse, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	osResponse, err := t.objectRequestCall("objectStatusHandler", object)
+	listColumnsResponse, err := t.objectRequestCall(ctx, "listColumnsHandler", object)
+	if err != nil {
+		return ListColumnsResponse{}, err
+	}
+
+	rawColumns := listColumnsResponse.Get("columns")
+	if rawColumns == nil || rawColumns == goja.Undefined() {
+		return ListColumnsResponse{}, fmt.Errorf("columns property not found")
+	}
+
+	columns, ok := rawColumns.Export().([]interface{})
+	if !ok {
+		return ListColumnsResponse{}, fmt.Errorf("unable to get columns list")
+	}
+
+	var response ListColumnsResponse
+	for i, c := range columns {
+		column, ok := c.(map[string]interface{})
+		if !ok {
+			return ListColumnsResponse{}, fmt.Errorf("unable to parse column in position %d", i)
+		}
+
+		name, ok := column["name"].(string)
+		if !ok || name == "" {
+			return ListColumnsResponse{}, fmt.Errorf("column in position %d requires a name", i)
+		}
+
+		value, err := extractComponent(fmt.Sprintf("columns[%d]", i), column["value"])
+		if err != nil {
+			return ListColumnsResponse{}, fmt.Errorf("unable to extract component: %w", err)
+		}
+
+		response.Columns = append(response.Columns, ListColumn{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	return response, nil
+}
+
+func (t *jsPlugin) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	relatedObjectsResponse, err := t.objectRequestCall(ctx, "relatedObjectsHandler", object)
+	if err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	rawKeys := relatedObjectsResponse.Get("keys")
+	if rawKeys == nil || rawKeys == goja.Undefined() {
+		return RelatedObjectsResponse{}, fmt.Errorf("keys property not found")
+	}
+
+	jsonKeys, err := json.Marshal(rawKeys.Export())
+	if err != nil {
+		return RelatedObjectsResponse{}, fmt.Errorf("unable to marshal keys: %w", err)
+	}
+
+	var response RelatedObjectsResponse
+	if err := json.Unmarshal(jsonKeys, &response.Keys); err != nil {
+		return RelatedObjectsResponse{}, fmt.Errorf("unable to unmarshal keys: %w", err)
+	}
+
+	return response, nil
+}
+
+func (t *jsPlugin) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	osResponse, err := t.objectRequestCall(ctx, "objectStatusHandler", object)
 	if err != nil {
 		return ObjectStatusResponse{}, err
 	}
@@ -391,11 +457,11 @@ func (t *jsPlugin) ObjectStatus(_ context.Context, object runtime.Object) (Objec
 	}, nil
 }
 
-func (t *jsPlugin) HandleAction(_ context.Context, actionPath string, payload action.Payload) error {
+func (t *jsPlugin) HandleAction(ctx context.Context, actionPath string, payload action.Payload) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	errCh := make(chan error)
+	errCh := make(chan error, 1)
 
 	t.loop.RunOnLoop(func(vm *goja.Runtime) {
 		handler, err := vm.RunString("_concretePlugin.actionHandler")
@@ -429,30 +495,32 @@ func (t *jsPlugin) HandleAction(_ context.Context, actionPath string, payload ac
 			return
 		}
 
-		if s != goja.Undefined() {
-			if jsErr := s.ToObject(vm); jsErr != nil {
-				errStr := jsErr.Get("error")
-				if errStr != goja.Undefined() {
-					errCh <- fmt.Errorf("%s actionHandler: %q", t.pluginPath, jsErr.Get("error"))
-					return
+		settleValue(vm, s, errCh, func(v goja.Value) error {
+			if isSet(v) {
+				if jsErr := v.ToObject(vm); jsErr != nil {
+					errStr := jsErr.Get("error")
+					if errStr != nil && errStr != goja.Undefined() {
+						return fmt.Errorf("%s actionHandler: %q", t.pluginPath, errStr)
+					}
 				}
 			}
-		}
-		errCh <- nil
+
+			return nil
+		})
 	})
 
-	if err := <-errCh; err != nil {
+	if err := waitForResult(ctx, errCh); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (t *jsPlugin) Print(_ context.Context, object runtime.Object) (PrintResponse, error) {
+func (t *jsPlugin) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	printResponse, err := t.objectRequestCall("printHandler", object)
+	printResponse, err := t.objectRequestCall(ctx, "printHandler", object)
 	if err != nil {
 		return PrintResponse{}, err
 	}
@@ -499,8 +567,10 @@ func (t *jsPlugin) Print(_ context.Context, object runtime.Object) (PrintRespons
 	return response, nil
 }
 
-func (t *jsPlugin) objectRequestCall(handlerName string, object runtime.Object) (*goja.Object, error) {
-	errCh := make(chan error)
+// objectRequestCall calls a handler with an object. Handlers can return their response
+// or a Promise that resolves to it.
+func (t *jsPlugin) objectRequestCall(ctx context.Context, handlerName string, object runtime.Object) (*goja.Object, error) {
+	errCh := make(chan error, 1)
 	var response *goja.Object
 
 	t.loop.RunOnLoop(func(vm *goja.Runtime) {
@@ -527,15 +597,17 @@ func (t *jsPlugin) objectRequestCall(handlerName string, object runtime.Object)
 			return
 		}
 
-		response = s.ToObject(vm)
-		if response == nil {
-			errCh <- fmt.Errorf("no status found")
-			return
-		}
-		errCh <- nil
+		settleValue(vm, s, errCh, func(v goja.Value) error {
+			if !isSet(v) {
+				return fmt.Errorf("no status found")
+			}
+
+			response = v.ToObject(vm)
+			return nil
+		})
 	})
 
-	if err := <-errCh; err != nil {
+	if err := waitForResult(ctx, errCh); err != nil {
 		return nil, err
 	}
 
@@ -642,10 +714,10 @@ func extractSections(name string, i interface{}) ([]component.SummarySection, er
 func ExtractDefaultClass(vm *goja.Runtime) (*goja.Object, error) {
 	// This is the location of a export default class that implements the Octant
 	// TypeScript module definition.
-	instantiateClass := "var _concretePlugin = new module.exports.default; _concretePlugin"
+	instantiateClass := "var _concretePlugin = new module.exports.default(dashboardClient, httpClient, pluginConfiguration); _concretePlugin"
 	// This is the library name the Octant webpack configuration uses.
 	if vm.Get("_octantPlugin") != nil {
-		instantiateClass = "var _concretePlugin = new _octantPlugin(dashboardClient, httpClient); _concretePlugin"
+		instantiateClass = "var _concretePlugin = new _octantPlugin(dashboardClient, httpClient, pluginConfiguration); _concretePlugin"
 	}
 
 	v, err := vm.RunString(instantiateClass)
@@ -668,6 +740,34 @@ func extractActions(i interface{}) ([]string, error) {
 	return actionNames, nil
 }
 
+func extractObjectActions(i interface{}) ([]ObjectAction, error) {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal object actions: %w", err)
+	}
+
+	var objectActions []ObjectAction
+	if err := json.Unmarshal(data, &objectActions); err != nil {
+		return nil, fmt.Errorf("unable to parse object actions: %w", err)
+	}
+
+	return objectActions, nil
+}
+
+func extractNavigationContributions(i interface{}) ([]navigation.Contribution, error) {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal navigation contributions: %w", err)
+	}
+
+	var contributions []navigation.Contribution
+	if err := json.Unmarshal(data, &contributions); err != nil {
+		return nil, fmt.Errorf("unable to parse navigation contributions: %w", err)
+	}
+
+	return contributions, nil
+}
+
 func extractGvk(name string, i interface{}) ([]schema.GroupVersionKind, error) {
 	GVKs, ok := i.([]interface{})
 	if !ok {
@@ -748,12 +848,36 @@ func ExtractMetadata(vm *goja.Runtime, pluginValue goja.Value) (*Metadata, error
 					return nil, fmt.Errorf("extractGvks: %w", err)
 				}
 				metadata.Capabilities.SupportsTab = append(metadata.Capabilities.SupportsTab, GVKs...)
+			case "supportListColumns":
+				GVKs, err := extractGvk(k, v)
+				if err != nil {
+					return nil, fmt.Errorf("extractGvks: %w", err)
+				}
+				metadata.Capabilities.SupportsListColumns = append(metadata.Capabilities.SupportsListColumns, GVKs...)
+			case "supportRelatedObjects":
+				GVKs, err := extractGvk(k, v)
+				if err != nil {
+					return nil, fmt.Errorf("extractGvks: %w", err)
+				}
+				metadata.Capabilities.SupportsRelatedObjects = append(metadata.Capabilities.SupportsRelatedObjects, GVKs...)
 			case "actionNames":
 				actions, err := extractActions(v)
 				if err != nil {
 					return nil, fmt.Errorf("extractActions: %w", err)
 				}
 				metadata.Capabilities.ActionNames = append(metadata.Capabilities.ActionNames, actions...)
+			case "objectActions":
+				objectActions, err := extractObjectActions(v)
+				if err != nil {
+					return nil, fmt.Errorf("extractObjectActions: %w", err)
+				}
+				metadata.Capabilities.ObjectActions = append(metadata.Capabilities.ObjectActions, objectActions...)
+			case "navigationContributions":
+				contributions, err := extractNavigationContributions(v)
+				if err != nil {
+					return nil, fmt.Errorf("extractNavigationContributions: %w", err)
+				}
+				metadata.Capabilities.NavigationContributions = append(metadata.Capabilities.NavigationContributions, contributions...)
 			default:
 				fmt.Printf("unknown capabilitiy: %s\n", k)
 			}
@@ -784,6 +908,13 @@ var exports = module.exports;
 			return
 		}
 
+		if _, err := vm.RunString(promisePolyfill); err != nil {
+			errCh <- fmt.Errorf("runtime promise support: %w", err)
+			return
+		}
+
+		vm.Set("fetch", createFetch(ctx, loop, vm))
+
 		registry := new(require.Registry)
 		registry.Enable(vm)
 
@@ -824,6 +955,7 @@ func (l logPrinter) Error(msg string) {
 type dashboardClient struct {
 	objectStore store.Store
 	vm          *goja.Runtime
+	loop        *eventloop.EventLoop
 	ctx         context.Context
 }
 
@@ -895,6 +1027,100 @@ func (d *dashboardClient) Create(c goja.FunctionCall) goja.Value {
 	return d.vm.ToValue(results)
 }
 
+// DeleteAsync deletes an object and returns a Promise that resolves when the object is deleted.
+func (d *dashboardClient) DeleteAsync(c goja.FunctionCall) goja.Value {
+	var key store.Key
+	obj := c.Argument(0).ToObject(d.vm)
+	if err := d.vm.ExportTo(obj, &key); err != nil {
+		return rejectedPromise(d.vm, fmt.Errorf("dashboardClient.DeleteAsync: %w", err))
+	}
+
+	return runAsync(d.loop, d.vm, func() (asyncResult, error) {
+		if err := d.objectStore.Delete(d.ctx, key); err != nil {
+			return nil, err
+		}
+
+		return func(vm *goja.Runtime) (goja.Value, error) {
+			return goja.Undefined(), nil
+		}, nil
+	})
+}
+
+// GetAsync gets an object and returns a Promise that resolves to the object. The Promise
+// resolves to null if the object does not exist.
+func (d *dashboardClient) GetAsync(c goja.FunctionCall) goja.Value {
+	var key store.Key
+	obj := c.Argument(0).ToObject(d.vm)
+	if err := d.vm.ExportTo(obj, &key); err != nil {
+		return rejectedPromise(d.vm, fmt.Errorf("dashboardClient.GetAsync: %w", err))
+	}
+
+	return runAsync(d.loop, d.vm, func() (asyncResult, error) {
+		u, err := d.objectStore.Get(d.ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(vm *goja.Runtime) (goja.Value, error) {
+			if u == nil {
+				return goja.Null(), nil
+			}
+			return vm.ToValue(u.Object), nil
+		}, nil
+	})
+}
+
+// ListAsync lists objects and returns a Promise that resolves to the list of objects.
+func (d *dashboardClient) ListAsync(c goja.FunctionCall) goja.Value {
+	var key store.Key
+	obj := c.Argument(0).ToObject(d.vm)
+	if err := d.vm.ExportTo(obj, &key); err != nil {
+		return rejectedPromise(d.vm, fmt.Errorf("dashboardClient.ListAsync: %w", err))
+	}
+
+	return runAsync(d.loop, d.vm, func() (asyncResult, error) {
+		u, _, err := d.objectStore.List(d.ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, len(u.Items))
+		for i := 0; i < len(u.Items); i++ {
+			items[i] = u.Items[i].Object
+		}
+
+		return func(vm *goja.Runtime) (goja.Value, error) {
+			return vm.ToValue(items), nil
+		}, nil
+	})
+}
+
+// CreateAsync creates or updates objects from YAML and returns a Promise that resolves to
+// the results.
+func (d *dashboardClient) CreateAsync(c goja.FunctionCall) goja.Value {
+	namespace := c.Argument(0).String()
+	update := c.Argument(1).String()
+
+	if namespace == "" {
+		return rejectedPromise(d.vm, fmt.Errorf("create/update: invalid namespace"))
+	}
+
+	if update == "" {
+		return rejectedPromise(d.vm, fmt.Errorf("create/update: empty yaml"))
+	}
+
+	return runAsync(d.loop, d.vm, func() (asyncResult, error) {
+		results, err := d.objectStore.CreateOrUpdateFromYAML(d.ctx, namespace, update)
+		if err != nil {
+			return nil, fmt.Errorf("create/update: %w", err)
+		}
+
+		return func(vm *goja.Runtime) (goja.Value, error) {
+			return vm.ToValue(results), nil
+		}, nil
+	})
+}
+
 func createClientObject(d *dashboardClient) goja.Value {
 	obj := d.vm.NewObject()
 	if err := obj.Set("Get", d.Get); err != nil {
@@ -912,6 +1138,21 @@ func createClientObject(d *dashboardClient) goja.Value {
 	if err := obj.Set("Delete", d.Delete); err != nil {
 		return d.vm.NewGoError(err)
 	}
+	if err := obj.Set("GetAsync", d.GetAsync); err != nil {
+		return d.vm.NewGoError(err)
+	}
+	if err := obj.Set("ListAsync", d.ListAsync); err != nil {
+		return d.vm.NewGoError(err)
+	}
+	if err := obj.Set("CreateAsync", d.CreateAsync); err != nil {
+		return d.vm.NewGoError(err)
+	}
+	if err := obj.Set("UpdateAsync", d.CreateAsync); err != nil {
+		return d.vm.NewGoError(err)
+	}
+	if err := obj.Set("DeleteAsync", d.DeleteAsync); err != nil {
+		return d.vm.NewGoError(err)
+	}
 	return obj
 }
 
@@ -0,0 +1,629 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+)
+
+// promiseFactoryName is the name of the global function that creates Promises.
+const promiseFactoryName = "__octantNewPromise"
+
+// defaultFetchTimeout is the timeout for fetch requests that do not specify one.
+const defaultFetchTimeout = 10 * time.Second
+
+// promisePolyfill installs a Promise implementation in runtimes that do not have one.
+// Callbacks are run as jobs on the event loop. It also defines a hidden factory Go uses to
+// create Promises.
+const promisePolyfill = `
+(function(global) {
+  Object.defineProperty(global, '` + promiseFactoryName + `', {
+    value: function(executor) {
+      return new global.Promise(executor);
+    }
+  });
+
+  if (typeof global.Promise === 'function') {
+    return;
+  }
+
+  var PENDING = 0, FULFILLED = 1, REJECTED = 2;
+
+  var jobs = [];
+  var flushScheduled = false;
+
+  function flush() {
+    var current = jobs;
+    jobs = [];
+    flushScheduled = false;
+    for (var i = 0; i < current.length; i++) {
+      current[i]();
+    }
+  }
+
+  function schedule(job) {
+    jobs.push(job);
+    if (!flushScheduled) {
+      flushScheduled = true;
+      setTimeout(flush, 0);
+    }
+  }
+
+  function settle(promise, state, value) {
+    if (promise._state !== PENDING) {
+      return;
+    }
+    promise._state = state;
+    promise._value = value;
+    var handlers = promise._handlers;
+    promise._handlers = null;
+    for (var i = 0; i < handlers.length; i++) {
+      runHandler(promise, handlers[i]);
+    }
+  }
+
+  function resolvePromise(promise, x) {
+    if (x === promise) {
+      settle(promise, REJECTED, new TypeError('a promise cannot be resolved with itself'));
+      return;
+    }
+
+    if (x !== null && (typeof x === 'object' || typeof x === 'function')) {
+      var then;
+      try {
+        then = x.then;
+      } catch (e) {
+        settle(promise, REJECTED, e);
+        return;
+      }
+
+      if (typeof then === 'function') {
+        var called = false;
+        schedule(function() {
+          try {
+            then.call(x, function(y) {
+              if (called) return;
+              called = true;
+              resolvePromise(promise, y);
+            }, function(r) {
+              if (called) return;
+              called = true;
+              settle(promise, REJECTED, r);
+            });
+          } catch (e) {
+            if (!called) {
+              called = true;
+              settle(promise, REJECTED, e);
+            }
+          }
+        });
+        return;
+      }
+    }
+
+    settle(promise, FULFILLED, x);
+  }
+
+  function runHandler(promise, handler) {
+    schedule(function() {
+      var callback = promise._state === FULFILLED ? handler.onFulfilled : handler.onRejected;
+      if (typeof callback !== 'function') {
+        if (promise._state === FULFILLED) {
+          handler.resolve(promise._value);
+        } else {
+          handler.reject(promise._value);
+        }
+        return;
+      }
+
+      var result;
+      try {
+        result = callback(promise._value);
+      } catch (e) {
+        handler.reject(e);
+        return;
+      }
+      handler.resolve(result);
+    });
+  }
+
+  function Promise(executor) {
+    if (!(this instanceof Promise)) {
+      throw new TypeError('Promise must be called with new');
+    }
+    if (typeof executor !== 'function') {
+      throw new TypeError('Promise executor is not a function');
+    }
+
+    this._state = PENDING;
+    this._value = undefined;
+    this._handlers = [];
+
+    var self = this;
+    var called = false;
+    try {
+      executor(function(value) {
+        if (called) return;
+        called = true;
+        resolvePromise(self, value);
+      }, function(reason) {
+        if (called) return;
+        called = true;
+        settle(self, REJECTED, reason);
+      });
+    } catch (e) {
+      if (!called) {
+        called = true;
+        settle(self, REJECTED, e);
+      }
+    }
+  }
+
+  Promise.prototype.then = function(onFulfilled, onRejected) {
+    var self = this;
+    return new Promise(function(resolve, reject) {
+      var handler = {
+        onFulfilled: onFulfilled,
+        onRejected: onRejected,
+        resolve: resolve,
+        reject: reject
+      };
+      if (self._state === PENDING) {
+        self._handlers.push(handler);
+      } else {
+        runHandler(self, handler);
+      }
+    });
+  };
+
+  Promise.prototype['catch'] = function(onRejected) {
+    return this.then(undefined, onRejected);
+  };
+
+  Promise.prototype['finally'] = function(onFinally) {
+    return this.then(function(value) {
+      return Promise.resolve(onFinally()).then(function() { return value; });
+    }, function(reason) {
+      return Promise.resolve(onFinally()).then(function() { throw reason; });
+    });
+  };
+
+  Promise.resolve = function(value) {
+    if (value instanceof Promise) {
+      return value;
+    }
+    return new Promise(function(resolve) { resolve(value); });
+  };
+
+  Promise.reject = function(reason) {
+    return new Promise(function(resolve, reject) { reject(reason); });
+  };
+
+  Promise.all = function(values) {
+    return new Promise(function(resolve, reject) {
+      var results = [];
+      var remaining = values.length;
+      if (remaining === 0) {
+        resolve(results);
+        return;
+      }
+      values.forEach(function(value, i) {
+        Promise.resolve(value).then(function(result) {
+          results[i] = result;
+          remaining--;
+          if (remaining === 0) {
+            resolve(results);
+          }
+        }, reject);
+      });
+    });
+  };
+
+  Promise.allSettled = function(values) {
+    return Promise.all(values.map(function(value) {
+      return Promise.resolve(value).then(function(result) {
+        return { status: 'fulfilled', value: result };
+      }, function(reason) {
+        return { status: 'rejected', reason: reason };
+      });
+    }));
+  };
+
+  Promise.race = function(values) {
+    return new Promise(function(resolve, reject) {
+      values.forEach(function(value) {
+        Promise.resolve(value).then(resolve, reject);
+      });
+    });
+  };
+
+  global.Promise = Promise;
+})(this);
+`
+
+// asyncResult converts the result of an asynchronous call to a JavaScript value. It
+// is called on the event loop.
+type asyncResult func(vm *goja.Runtime) (goja.Value, error)
+
+// newPromise creates a pending Promise with functions that resolve and reject it. The
+// functions must be called on the event loop.
+func newPromise(vm *goja.Runtime) (*goja.Object, func(goja.Value), func(goja.Value), error) {
+	var resolve, reject goja.Callable
+
+	executor := func(c goja.FunctionCall) goja.Value {
+		resolve, _ = goja.AssertFunction(c.Argument(0))
+		reject, _ = goja.AssertFunction(c.Argument(1))
+		return goja.Undefined()
+	}
+
+	factory, ok := goja.AssertFunction(vm.Get(promiseFactoryName))
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("create promise: runtime does not support promises")
+	}
+
+	value, err := factory(goja.Undefined(), vm.ToValue(executor))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("create promise: %w", err)
+	}
+	promise := value.ToObject(vm)
+
+	if resolve == nil || reject == nil {
+		return nil, nil, nil, fmt.Errorf("create promise: executor was not called")
+	}
+
+	resolveFn := func(v goja.Value) {
+		_, _ = resolve(goja.Undefined(), v)
+	}
+	rejectFn := func(v goja.Value) {
+		_, _ = reject(goja.Undefined(), v)
+	}
+
+	return promise, resolveFn, rejectFn, nil
+}
+
+// runAsync runs fn in a goroutine and returns a Promise. The Promise is settled with
+// fn's result on the event loop, and is rejected with an Error if fn fails. runAsync
+// must be called on the event loop.
+func runAsync(loop *eventloop.EventLoop, vm *goja.Runtime, fn func() (asyncResult, error)) goja.Value {
+	promise, resolve, reject, err := newPromise(vm)
+	if err != nil {
+		panic(vm.NewGoError(err))
+	}
+
+	go func() {
+		result, err := fn()
+		loop.RunOnLoop(func(vm *goja.Runtime) {
+			if err != nil {
+				reject(vm.NewGoError(err))
+				return
+			}
+
+			value, err := result(vm)
+			if err != nil {
+				reject(vm.NewGoError(err))
+				return
+			}
+
+			resolve(value)
+		})
+	}()
+
+	return promise
+}
+
+// resolvedPromise returns a Promise that is settled with the result. It must be called on
+// the event loop.
+func resolvedPromise(vm *goja.Runtime, result asyncResult) goja.Value {
+	promise, resolve, reject, err := newPromise(vm)
+	if err != nil {
+		panic(vm.NewGoError(err))
+	}
+
+	value, err := result(vm)
+	if err != nil {
+		reject(vm.NewGoError(err))
+	} else {
+		resolve(value)
+	}
+
+	return promise
+}
+
+// rejectedPromise returns a Promise that is rejected with an Error for err. Functions
+// which return a Promise use it for invalid arguments, so callers handle every failure
+// with catch. It must be called on the event loop.
+func rejectedPromise(vm *goja.Runtime, err error) goja.Value {
+	return resolvedPromise(vm, func(*goja.Runtime) (goja.Value, error) {
+		return nil, err
+	})
+}
+
+// settleValue calls fn with value. If value is a Promise, or any other thenable, fn is called
+// with the value it resolves to instead. The error from fn, or the reason a Promise was
+// rejected, is sent to errCh. settleValue must be called on the event loop, and errCh
+// must be buffered so the loop is not blocked if the caller has gone away.
+func settleValue(vm *goja.Runtime, value goja.Value, errCh chan<- error, fn func(goja.Value) error) {
+	then, ok := thenFunc(vm, value)
+	if !ok {
+		errCh <- fn(value)
+		return
+	}
+
+	onFulfilled := func(c goja.FunctionCall) goja.Value {
+		errCh <- fn(c.Argument(0))
+		return goja.Undefined()
+	}
+	onRejected := func(c goja.FunctionCall) goja.Value {
+		errCh <- fmt.Errorf("promise rejected: %s", rejectionReason(vm, c.Argument(0)))
+		return goja.Undefined()
+	}
+
+	if _, err := then(value, vm.ToValue(onFulfilled), vm.ToValue(onRejected)); err != nil {
+		errCh <- fmt.Errorf("wait for promise: %w", err)
+	}
+}
+
+// waitForResult waits for a result sent by settleValue.
+func waitForResult(ctx context.Context, errCh <-chan error) error {
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func thenFunc(vm *goja.Runtime, value goja.Value) (goja.Callable, bool) {
+	if value == nil || goja.IsUndefined(value) || goja.IsNull(value) {
+		return nil, false
+	}
+
+	obj := value.ToObject(vm)
+	if obj == nil {
+		return nil, false
+	}
+
+	then := obj.Get("then")
+	if then == nil {
+		return nil, false
+	}
+
+	return goja.AssertFunction(then)
+}
+
+func rejectionReason(vm *goja.Runtime, reason goja.Value) string {
+	if reason == nil || goja.IsUndefined(reason) || goja.IsNull(reason) {
+		return "no reason"
+	}
+
+	if obj := reason.ToObject(vm); obj != nil {
+		if message := obj.Get("message"); message != nil && !goja.IsUndefined(message) {
+			return message.String()
+		}
+	}
+
+	return reason.String()
+}
+
+// fetchRequest is a request created from the arguments to fetch.
+type fetchRequest struct {
+	url     string
+	method  string
+	headers map[string]string
+	body    *string
+	timeout time.Duration
+}
+
+func parseFetchRequest(vm *goja.Runtime, c goja.FunctionCall) (*fetchRequest, error) {
+	url := c.Argument(0)
+	if goja.IsUndefined(url) || goja.IsNull(url) || url.String() == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+
+	request := &fetchRequest{
+		url:     url.String(),
+		method:  http.MethodGet,
+		headers: map[string]string{},
+		timeout: defaultFetchTimeout,
+	}
+
+	init := c.Argument(1)
+	if goja.IsUndefined(init) || goja.IsNull(init) {
+		return request, nil
+	}
+
+	options := init.ToObject(vm)
+
+	if method := options.Get("method"); isSet(method) {
+		request.method = strings.ToUpper(method.String())
+	}
+
+	if headers := options.Get("headers"); isSet(headers) {
+		headersObj := headers.ToObject(vm)
+		for _, key := range headersObj.Keys() {
+			request.headers[key] = headersObj.Get(key).String()
+		}
+	}
+
+	if body := options.Get("body"); isSet(body) {
+		s := body.String()
+		request.body = &s
+	}
+
+	if timeout := options.Get("timeout"); isSet(timeout) {
+		ms := timeout.ToInteger()
+		if ms <= 0 {
+			return nil, fmt.Errorf("timeout must be greater than zero")
+		}
+		request.timeout = time.Duration(ms) * time.Millisecond
+	}
+
+	return request, nil
+}
+
+func isSet(value goja.Value) bool {
+	return value != nil && !goja.IsUndefined(value) && !goja.IsNull(value)
+}
+
+// fetchResponse is the response to a fetch request.
+type fetchResponse struct {
+	url        string
+	status     int
+	statusText string
+	headers    http.Header
+	body       []byte
+}
+
+func doFetch(ctx context.Context, request *fetchRequest) (*fetchResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, request.timeout)
+	defer cancel()
+
+	var body io.Reader
+	if request.body != nil {
+		body = strings.NewReader(*request.body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, request.method, request.url, body)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	for k, v := range request.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	return &fetchResponse{
+		url:        request.url,
+		status:     resp.StatusCode,
+		statusText: http.StatusText(resp.StatusCode),
+		headers:    resp.Header,
+		body:       data,
+	}, nil
+}
+
+// toValue converts the response to an object shaped like a standard fetch Response.
+func (r *fetchResponse) toValue(vm *goja.Runtime) (goja.Value, error) {
+	headers := vm.NewObject()
+	setters := []struct {
+		name string
+		fn   func(goja.FunctionCall) goja.Value
+	}{
+		{
+			name: "get",
+			fn: func(c goja.FunctionCall) goja.Value {
+				if values := r.headers[http.CanonicalHeaderKey(c.Argument(0).String())]; len(values) > 0 {
+					return vm.ToValue(strings.Join(values, ", "))
+				}
+				return goja.Null()
+			},
+		},
+		{
+			name: "has",
+			fn: func(c goja.FunctionCall) goja.Value {
+				return vm.ToValue(len(r.headers[http.CanonicalHeaderKey(c.Argument(0).String())]) > 0)
+			},
+		},
+		{
+			name: "forEach",
+			fn: func(c goja.FunctionCall) goja.Value {
+				callback, ok := goja.AssertFunction(c.Argument(0))
+				if !ok {
+					panic(vm.NewTypeError("forEach callback is not a function"))
+				}
+				for name, values := range r.headers {
+					if _, err := callback(goja.Undefined(), vm.ToValue(strings.Join(values, ", ")), vm.ToValue(strings.ToLower(name))); err != nil {
+						panic(err)
+					}
+				}
+				return goja.Undefined()
+			},
+		},
+	}
+
+	for _, setter := range setters {
+		if err := headers.Set(setter.name, setter.fn); err != nil {
+			return nil, fmt.Errorf("set headers.%s: %w", setter.name, err)
+		}
+	}
+
+	text := func(goja.FunctionCall) goja.Value {
+		return resolvedPromise(vm, func(vm *goja.Runtime) (goja.Value, error) {
+			return vm.ToValue(string(r.body)), nil
+		})
+	}
+
+	jsonFn := func(goja.FunctionCall) goja.Value {
+		return resolvedPromise(vm, func(vm *goja.Runtime) (goja.Value, error) {
+			var target interface{}
+			if err := json.Unmarshal(r.body, &target); err != nil {
+				return nil, fmt.Errorf("decode json: %w", err)
+			}
+			return vm.ToValue(target), nil
+		})
+	}
+
+	response := vm.NewObject()
+	values := map[string]interface{}{
+		"url":        r.url,
+		"status":     r.status,
+		"statusText": r.statusText,
+		"ok":         r.status >= 200 && r.status < 300,
+		"headers":    headers,
+		"text":       text,
+		"json":       jsonFn,
+	}
+
+	for k, v := range values {
+		if err := response.Set(k, v); err != nil {
+			return nil, fmt.Errorf("set response.%s: %w", k, err)
+		}
+	}
+
+	return response, nil
+}
+
+// createFetch creates a fetch function. It supports the url and the method, headers,
+// and body options of the standard fetch, as well as a timeout in milliseconds.
+func createFetch(ctx context.Context, loop *eventloop.EventLoop, vm *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(c goja.FunctionCall) goja.Value {
+		request, err := parseFetchRequest(vm, c)
+		if err != nil {
+			return rejectedPromise(vm, fmt.Errorf("fetch: %w", err))
+		}
+
+		return runAsync(loop, vm, func() (asyncResult, error) {
+			response, err := doFetch(ctx, request)
+			if err != nil {
+				return nil, fmt.Errorf("fetch: %w", err)
+			}
+
+			return response.toValue, nil
+		})
+	}
+}
@@ -0,0 +1,335 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestPromisePolyfill(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		expected interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "then chain",
+			script:   `Promise.resolve(1).then(function(v) { return v + 1; }).then(function(v) { return v * 2; })`,
+			expected: int64(4),
+		},
+		{
+			name:     "resolve with a promise",
+			script:   `new Promise(function(resolve) { resolve(Promise.resolve("nested")); })`,
+			expected: "nested",
+		},
+		{
+			name:     "catch",
+			script:   `Promise.reject(new Error("boom")).catch(function(e) { return e.message; })`,
+			expected: "boom",
+		},
+		{
+			name:    "thrown error",
+			script:  `Promise.resolve().then(function() { throw new Error("boom"); })`,
+			wantErr: true,
+		},
+		{
+			name:     "all",
+			script:   `Promise.all([1, Promise.resolve(2), new Promise(function(resolve) { setTimeout(function() { resolve(3); }, 10); })])`,
+			expected: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			name:     "race",
+			script:   `Promise.race([new Promise(function() {}), Promise.resolve("first")])`,
+			expected: "first",
+		},
+		{
+			name:     "finally",
+			script:   `var called = false; Promise.resolve("value").finally(function() { called = true; }).then(function(v) { return [v, called]; })`,
+			expected: []interface{}{"value", true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			loop := createTestRuntimeLoop(t)
+			defer loop.Stop()
+
+			got, err := evaluateAsync(t, loop, test.script)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Method", r.Method)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"token":"` + r.Header.Get("Authorization") + `","body":` + string(body) + `}`))
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		script   string
+		expected interface{}
+		wantErr  bool
+	}{
+		{
+			name: "post with headers and body",
+			script: `fetch("` + server.URL + `/echo", {
+  method: "POST",
+  headers: { "Authorization": "secret" },
+  body: JSON.stringify({ name: "octant" })
+}).then(function(response) {
+  return response.json().then(function(data) {
+    return [response.status, response.ok, response.headers.get("x-method"), data.token, data.body.name];
+  });
+})`,
+			expected: []interface{}{int64(201), true, "POST", "secret", "octant"},
+		},
+		{
+			name: "not found",
+			script: `fetch("` + server.URL + `/missing").then(function(response) {
+  return [response.status, response.ok, response.statusText];
+})`,
+			expected: []interface{}{int64(404), false, "Not Found"},
+		},
+		{
+			name:    "timeout",
+			script:  `fetch("` + server.URL + `/slow", { timeout: 10 })`,
+			wantErr: true,
+		},
+		{
+			name:    "missing url",
+			script:  `fetch()`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			loop := createTestRuntimeLoop(t)
+			defer loop.Stop()
+
+			got, err := evaluateAsync(t, loop, test.script)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestDashboardClient_GetAsync(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(pod, nil)
+
+	missing := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "missing"}
+	objectStore.EXPECT().Get(gomock.Any(), missing).Return((*unstructured.Unstructured)(nil), nil)
+
+	loop := createTestRuntimeLoop(t)
+	defer loop.Stop()
+
+	loop.RunOnLoop(func(vm *goja.Runtime) {
+		d := &dashboardClient{
+			objectStore: objectStore,
+			vm:          vm,
+			loop:        loop,
+			ctx:         context.Background(),
+		}
+		vm.Set("dashboardClient", createClientObject(d))
+	})
+
+	got, err := evaluateAsync(t, loop, `Promise.all([
+  dashboardClient.GetAsync({ namespace: "default", apiVersion: "v1", kind: "Pod", name: "pod" }),
+  dashboardClient.GetAsync({ namespace: "default", apiVersion: "v1", kind: "Pod", name: "missing" })
+]).then(function(results) {
+  return [results[0].metadata.name, results[1]];
+})`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []interface{}{"pod", nil}, got)
+}
+
+func TestDashboardClient_asyncErrors(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(nil, fmt.Errorf("get failed"))
+
+	loop := createTestRuntimeLoop(t)
+	defer loop.Stop()
+
+	loop.RunOnLoop(func(vm *goja.Runtime) {
+		d := &dashboardClient{
+			objectStore: objectStore,
+			vm:          vm,
+			loop:        loop,
+			ctx:         context.Background(),
+		}
+		vm.Set("dashboardClient", createClientObject(d))
+	})
+
+	got, err := evaluateAsync(t, loop, `function reason(promise) {
+  return promise.then(function() { return "resolved"; }, function(e) { return [e instanceof Error, e.message]; });
+}
+Promise.all([
+  reason(dashboardClient.GetAsync({ namespace: "default", apiVersion: "v1", kind: "Pod", name: "pod" })),
+  reason(dashboardClient.CreateAsync("", "kind: Pod"))
+])`)
+	require.NoError(t, err)
+
+	expected := []interface{}{
+		[]interface{}{true, "get failed"},
+		[]interface{}{true, "create/update: invalid namespace"},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestJSPlugin_promiseHandlers(t *testing.T) {
+	script := `
+var _octantPlugin = function(dashboardClient, httpClient, configuration) {
+  this.name = "async";
+  this.description = "async plugin";
+  this.isModule = true;
+  this.capabilities = {};
+};
+
+_octantPlugin.prototype.contentHandler = function(request) {
+  return new Promise(function(resolve) {
+    setTimeout(function() {
+      resolve({
+        content: {
+          viewComponents: [
+            { metadata: { type: "text" }, config: { value: "content for " + request.contentPath } }
+          ]
+        }
+      });
+    }, 10);
+  });
+};
+
+_octantPlugin.prototype.tabHandler = function(request) {
+  return Promise.resolve({
+    tab: {
+      name: "Async",
+      contents: { metadata: { type: "flexlayout" }, config: { sections: [] } }
+    }
+  });
+};
+
+_octantPlugin.prototype.printHandler = function(request) {
+  return Promise.reject(new Error("print failed"));
+};
+`
+	dir, err := ioutil.TempDir("", "octant-js-plugin")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	pluginPath := filepath.Join(dir, "async.js")
+	require.NoError(t, ioutil.WriteFile(pluginPath, []byte(script), 0644))
+
+	ctx := context.Background()
+
+	jsPlugin, err := NewJSPlugin(ctx, nil, pluginPath, nil, CreateRuntimeLoop, ExtractDefaultClass, ExtractMetadata)
+	require.NoError(t, err)
+	defer jsPlugin.Close()
+
+	content, err := jsPlugin.Content(ctx, "/path")
+	require.NoError(t, err)
+	assert.Equal(t, []component.Component{component.NewText("content for /path")}, content.Components)
+
+	tab, err := jsPlugin.PrintTab(ctx, testutil.CreatePod("pod"))
+	require.NoError(t, err)
+	assert.Equal(t, "Async", tab.Tab.Contents.Title[0].String())
+
+	_, err = jsPlugin.Print(ctx, testutil.CreatePod("pod"))
+	require.EqualError(t, err, "promise rejected: print failed")
+}
+
+func createTestRuntimeLoop(t *testing.T) *eventloop.EventLoop {
+	loop, err := CreateRuntimeLoop(context.Background(), "test")
+	require.NoError(t, err)
+	return loop
+}
+
+// evaluateAsync evaluates a script that returns a Promise and returns the value
+// the Promise resolves to.
+func evaluateAsync(t *testing.T, loop *eventloop.EventLoop, script string) (interface{}, error) {
+	errCh := make(chan error, 1)
+	var result interface{}
+
+	loop.RunOnLoop(func(vm *goja.Runtime) {
+		v, err := vm.RunString(script)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		settleValue(vm, v, errCh, func(v goja.Value) error {
+			result = v.Export()
+			return nil
+		})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := waitForResult(ctx, errCh)
+	return result, err
+}
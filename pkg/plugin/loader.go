@@ -23,6 +23,8 @@ const configDir = "octant"
 type Config interface {
 	// PluginDirs returns the location of the plugin directories.
 	PluginDirs(string) ([]string, error)
+	// ConfigFile returns the location of Octant's configuration file.
+	ConfigFile(string) string
 	// Home returns the user's home directory.
 	Home() string
 	// Fs is the afero filesystem
@@ -57,11 +59,7 @@ func (c *defaultConfig) PluginDirs(home string) ([]string, error) {
 		return []string{}, nil
 	}
 
-	defaultDir := filepath.Join(home, ".config", configDir, "plugins")
-
-	if c.os == "windows" || viper.GetString("xdg-config-home") != "" {
-		defaultDir = filepath.Join(home, configDir, "plugins")
-	}
+	defaultDir := filepath.Join(c.configDir(home), "plugins")
 
 	if path := viper.GetString("plugin-path"); path != "" {
 		path = strings.Trim(path, string(filepath.ListSeparator))
@@ -71,6 +69,28 @@ func (c *defaultConfig) PluginDirs(home string) ([]string, error) {
 	return []string{defaultDir}, nil
 }
 
+// ConfigFile returns the location of Octant's configuration file. The location
+// can be overridden with the config-file setting.
+func (c *defaultConfig) ConfigFile(home string) string {
+	if path := viper.GetString("config-file"); path != "" {
+		return path
+	}
+
+	if home == "" {
+		return ""
+	}
+
+	return filepath.Join(c.configDir(home), "config.yaml")
+}
+
+func (c *defaultConfig) configDir(home string) string {
+	if c.os == "windows" || viper.GetString("xdg-config-home") != "" {
+		return filepath.Join(home, configDir)
+	}
+
+	return filepath.Join(home, ".config", configDir)
+}
+
 func (c *defaultConfig) Home() string {
 	if c.homeFn == nil {
 		c.homeFn = func() string {
@@ -129,9 +149,13 @@ func AvailablePlugins(config Config) ([]string, error) {
 		}
 
 		for _, fi := range fis {
+			if fi.IsDir() {
+				continue
+			}
+
 			mode := fi.Mode()
 			// Windows does not have unix style executable bits.
-			if IsJavaScriptPlugin(fi.Name()) {
+			if IsInProcessPlugin(fi.Name()) {
 				pluginPath := filepath.Join(dir, fi.Name())
 				list = append(list, pluginPath)
 			} else if mode|64 == mode || config.OS() == "windows" {
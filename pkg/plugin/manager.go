@@ -78,10 +78,10 @@ type Client interface {
 // ManagerStore is the data store for Manager.
 type ManagerStore interface {
 	Store(name string, client Client, metadata *Metadata, cmd string) error
-	StoreJS(name string, jspc JSPlugin) error
-	GetJS(name string) (JSPlugin, bool)
-	RemoveJS(name string)
-	NamesJS() []string
+	StoreInProcess(name string, plugin InProcessPlugin) error
+	GetInProcess(name string) (InProcessPlugin, bool)
+	RemoveInProcess(name string)
+	NamesInProcess() []string
 	GetMetadata(name string) (*Metadata, error)
 	GetService(name string) (Service, error)
 	GetCommand(name string) (string, error)
@@ -95,7 +95,7 @@ type DefaultStore struct {
 	metadata map[string]Metadata
 	commands map[string]string
 
-	jsPlugins sync.Map
+	inProcessPlugins sync.Map
 }
 
 var _ ManagerStore = (*DefaultStore)(nil)
@@ -109,9 +109,9 @@ func NewDefaultStore() *DefaultStore {
 	}
 }
 
-func (s *DefaultStore) NamesJS() []string {
+func (s *DefaultStore) NamesInProcess() []string {
 	var names []string
-	s.jsPlugins.Range(func(key interface{}, value interface{}) bool {
+	s.inProcessPlugins.Range(func(key interface{}, value interface{}) bool {
 		name, ok := key.(string)
 		if !ok {
 			return false
@@ -122,22 +122,22 @@ func (s *DefaultStore) NamesJS() []string {
 	return names
 }
 
-func (s *DefaultStore) StoreJS(name string, plugin JSPlugin) error {
-	s.jsPlugins.Store(name, plugin)
+func (s *DefaultStore) StoreInProcess(name string, plugin InProcessPlugin) error {
+	s.inProcessPlugins.Store(name, plugin)
 	return nil
 }
 
-func (s *DefaultStore) GetJS(name string) (JSPlugin, bool) {
-	voidStar, ok := s.jsPlugins.Load(name)
+func (s *DefaultStore) GetInProcess(name string) (InProcessPlugin, bool) {
+	voidStar, ok := s.inProcessPlugins.Load(name)
 	if !ok {
 		return nil, false
 	}
-	jspc, ok := voidStar.(JSPlugin)
-	return jspc, ok
+	p, ok := voidStar.(InProcessPlugin)
+	return p, ok
 }
 
-func (s *DefaultStore) RemoveJS(name string) {
-	s.jsPlugins.Delete(name)
+func (s *DefaultStore) RemoveInProcess(name string) {
+	s.inProcessPlugins.Delete(name)
 }
 
 // Store stores information for a plugin.
@@ -209,8 +209,8 @@ func (s *DefaultStore) ClientNames() []string {
 	for name := range s.Clients() {
 		list = append(list, name)
 	}
-	tsNames := s.NamesJS()
-	list = append(list, tsNames...)
+	inProcessNames := s.NamesInProcess()
+	list = append(list, inProcessNames...)
 	return list
 }
 
@@ -239,6 +239,22 @@ type ManagerInterface interface {
 	// ObjectStatus returns the object status
 	ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error)
 
+	// ListColumns retrieves additional list table columns for the objects in a list.
+	// There is a response for each object, in the same order.
+	ListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error)
+
+	// RelatedObjects retrieves keys for objects plugins consider related to an object.
+	RelatedObjects(ctx context.Context, object runtime.Object) (*RelatedObjectsResponse, error)
+
+	// ObjectActions retrieves the actions plugins add for an object.
+	ObjectActions(object runtime.Object) ([]ObjectAction, error)
+
+	// Configuration returns the configuration for a plugin.
+	Configuration(name string) Configuration
+
+	// UpdateConfiguration updates the configuration for a plugin and notifies the plugin.
+	UpdateConfiguration(ctx context.Context, name string, configuration Configuration) error
+
 	// UpdateClusterClient sets the current cluster client.
 	UpdateObjectStore(objectStore store.Store)
 }
@@ -262,6 +278,15 @@ type ActionRegistrar interface {
 // ManagerOption is an option for configuring Manager.
 type ManagerOption func(*Manager)
 
+// WithConfigurations configures the manager with plugin configurations keyed by plugin file name.
+func WithConfigurations(configurations map[string]Configuration) ManagerOption {
+	return func(m *Manager) {
+		for name, configuration := range configurations {
+			m.configurations[name] = configuration
+		}
+	}
+}
+
 // Manager manages plugins
 type Manager struct {
 	PortForwarder   portforward.PortForwarder
@@ -275,6 +300,12 @@ type Manager struct {
 	objectStore store.Store
 	configs     []config
 	store       ManagerStore
+	// tokens are the dashboard API tokens issued to plugins by name.
+	tokens    map[string]string
+	tokenLock sync.Mutex
+
+	configurations    map[string]Configuration
+	configurationLock sync.RWMutex
 
 	lock sync.Mutex
 }
@@ -290,6 +321,8 @@ func NewManager(apiService api.API, moduleRegistrar ModuleRegistrar, actionRegis
 		API:             apiService,
 		ModuleRegistrar: moduleRegistrar,
 		ActionRegistrar: actionRegistrar,
+		configurations:  make(map[string]Configuration),
+		tokens:          make(map[string]string),
 	}
 
 	for _, option := range options {
@@ -338,18 +371,18 @@ func (m *Manager) Load(cmd string) error {
 	return nil
 }
 
-func (m *Manager) watchJS(ctx context.Context) {
+func (m *Manager) watchInProcess(ctx context.Context) {
 	logger := log.From(ctx)
 
 	dirs, err := DefaultConfig.PluginDirs(DefaultConfig.Home())
 	if err != nil {
-		logger.Errorf("unable to get plugin dirs for JavaScript plugins: %w", err)
+		logger.Errorf("unable to get plugin dirs for in-process plugins: %w", err)
 		return
 	}
 
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		logger.Errorf("initializing JavaScript plugin watcher: %w", err)
+		logger.Errorf("initializing in-process plugin watcher: %w", err)
 		return
 	}
 	defer func() {
@@ -360,49 +393,49 @@ func (m *Manager) watchJS(ctx context.Context) {
 
 	for _, dir := range dirs {
 		if err := watcher.Add(dir); err != nil {
-			logger.Warnf("unable to add %s to JavaScript plugin watcher", dir)
+			logger.Warnf("unable to add %s to in-process plugin watcher", dir)
 		}
 	}
 
-	logger.Infof("watching for new JavaScript plugins in %q", dirs)
+	logger.Infof("watching for new in-process plugins in %q", dirs)
 
 	writeEvents := make(map[string]bool)
 	updatePlugin := func(name string) {
-		jsPlugin, ok := m.store.GetJS(name)
+		inProcessPlugin, ok := m.store.GetInProcess(name)
 		if ok {
-			if err := m.unregisterJSPlugin(ctx, jsPlugin); err != nil {
+			if err := m.unregisterInProcessPlugin(ctx, inProcessPlugin); err != nil {
 				logger.Errorf("unregistering: %w", err)
 			}
-			m.store.RemoveJS(name)
+			m.store.RemoveInProcess(name)
 		}
-		logger.Infof("reloading: JavaScript plugin: %s", name)
-		if err := m.registerJSPlugin(ctx, name, m.API.Addr()); err != nil {
-			logger.Errorf("reloading: JavaScript plugin watcher: %w", err)
+		logger.Infof("reloading: in-process plugin: %s", name)
+		if err := m.registerInProcessPlugin(ctx, name, m.API.Addr()); err != nil {
+			logger.Errorf("reloading: in-process plugin watcher: %w", err)
 		}
 	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			logger.Infof("context cancelled shutting down JavaScript plugin watcher.")
+			logger.Infof("context cancelled shutting down in-process plugin watcher.")
 			return
 		case event, ok := <-watcher.Events:
 			if !ok {
-				logger.Errorf("bad event returned from JavaScript plugin watcher")
+				logger.Errorf("bad event returned from in-process plugin watcher")
 				return
 			}
 			if event.Op&(fsnotify.Chmod|fsnotify.Write|fsnotify.Create) == fsnotify.Chmod {
 				continue
 			}
-			if IsJavaScriptPlugin(event.Name) {
+			if IsInProcessPlugin(event.Name) {
 				if event.Op&fsnotify.Remove == fsnotify.Remove {
-					jsPlugin, ok := m.store.GetJS(event.Name)
+					inProcessPlugin, ok := m.store.GetInProcess(event.Name)
 					if ok {
-						if err := m.unregisterJSPlugin(ctx, jsPlugin); err != nil {
+						if err := m.unregisterInProcessPlugin(ctx, inProcessPlugin); err != nil {
 							logger.Errorf("unregistering: %w", err)
 						}
-						m.store.RemoveJS(event.Name)
-						logger.Infof("removing: JavaScript plugin: %s", event.Name)
+						m.store.RemoveInProcess(event.Name)
+						logger.Infof("removing: in-process plugin: %s", event.Name)
 					}
 				} else if event.Op&fsnotify.Write == fsnotify.Write {
 					writeEvents[event.Name] = true
@@ -424,7 +457,7 @@ func (m *Manager) watchJS(ctx context.Context) {
 	}
 }
 
-func (m *Manager) unregisterJSPlugin(_ context.Context, p JSPlugin) error {
+func (m *Manager) unregisterInProcessPlugin(_ context.Context, p InProcessPlugin) error {
 	p.Close()
 
 	metadata := p.Metadata()
@@ -436,23 +469,37 @@ func (m *Manager) unregisterJSPlugin(_ context.Context, p JSPlugin) error {
 		m.ModuleRegistrar.Unregister(mp)
 	}
 
-	for _, actionName := range metadata.Capabilities.ActionNames {
+	for _, actionName := range metadata.Capabilities.HandledActionNames() {
 		actionPath := actionName
 		m.ActionRegistrar.Unregister(actionPath, p.PluginPath())
 	}
 	return nil
 }
 
-func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string, apiAddr string) error {
-	jsPlugin, err := NewJSPlugin(ctx, m.objectStore, pluginPath, CreateRuntimeLoop, ExtractDefaultClass, ExtractMetadata)
+func (m *Manager) registerInProcessPlugin(ctx context.Context, pluginPath string, apiAddr string) error {
+	registration := Registration{
+		DashboardAPIAddress: apiAddr,
+		Configuration:       m.Configuration(pluginPath),
+	}
+
+	// WebAssembly plugins are given a dashboard API token so they can push content.
+	if IsWASMPlugin(pluginPath) {
+		token, err := m.issueToken(pluginPath)
+		if err != nil {
+			return fmt.Errorf("issue dashboard api token for %q: %w", pluginPath, err)
+		}
+		registration.DashboardAPIToken = token
+	}
+
+	inProcessPlugin, err := NewInProcessPlugin(ctx, m.objectStore, pluginPath, registration)
 	if err != nil {
 		return err
 	}
-	if err := m.store.StoreJS(pluginPath, jsPlugin); err != nil {
+	if err := m.store.StoreInProcess(pluginPath, inProcessPlugin); err != nil {
 		return err
 	}
 
-	metadata := jsPlugin.Metadata()
+	metadata := inProcessPlugin.Metadata()
 
 	pluginLogger := log.From(ctx).With("plugin-name", pluginPath)
 	pluginLogger.With(
@@ -460,11 +507,11 @@ func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string, apiAd
 		"metadata", metadata,
 	).Infof("registered plugin %q", metadata.Name)
 
-	for _, actionName := range metadata.Capabilities.ActionNames {
+	for _, actionName := range metadata.Capabilities.HandledActionNames() {
 		actionPath := actionName
 		pluginLogger.With("action-path", actionPath).Infof("registering plugin action")
 		err := m.ActionRegistrar.Register(actionPath, pluginPath, func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
-			return jsPlugin.HandleAction(ctx, actionPath, payload)
+			return inProcessPlugin.HandleAction(ctx, actionPath, payload)
 		})
 
 		if err != nil {
@@ -475,11 +522,15 @@ func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string, apiAd
 	if metadata.Capabilities.IsModule {
 		pluginLogger.Infof("plugin supports navigation")
 
-		mp, err := NewModuleProxy(metadata.Name, metadata, jsPlugin)
+		mp, err := NewModuleProxy(metadata.Name, metadata, inProcessPlugin)
 		if err != nil {
 			return fmt.Errorf("creating module proxy: %w", err)
 		}
 
+		if registration.DashboardAPIToken != "" {
+			m.API.SetContentRoot(registration.DashboardAPIToken, mp.ContentPath())
+		}
+
 		if err := m.ModuleRegistrar.Register(mp); err != nil {
 			return fmt.Errorf("register module %s: %w", metadata.Name, err)
 		}
@@ -488,19 +539,25 @@ func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string, apiAd
 	return nil
 }
 
-func (m *Manager) startJS(ctx context.Context, apiAddr string) error {
+func (m *Manager) startInProcess(ctx context.Context, apiAddr string) error {
 	pluginList, err := AvailablePlugins(DefaultConfig)
 	if err != nil {
 		return err
 	}
 
 	for _, pluginPath := range pluginList {
-		if IsJavaScriptPlugin(pluginPath) {
+		if IsWASMPlugin(pluginPath) && !wasmPluginsEnabled {
+			log.From(ctx).With("plugin-name", pluginPath).
+				Warnf("skipping WebAssembly plugin: Octant was built without the wasm_plugins tag")
+			continue
+		}
+
+		if IsInProcessPlugin(pluginPath) {
 			logger := log.From(ctx)
-			logger.With("addr", apiAddr).Debugf("creating ts plugin client")
+			logger.With("addr", apiAddr).Debugf("creating in-process plugin")
 
-			if err := m.registerJSPlugin(ctx, pluginPath, apiAddr); err != nil {
-				return fmt.Errorf("javascript plugin: %w", err)
+			if err := m.registerInProcessPlugin(ctx, pluginPath, apiAddr); err != nil {
+				return fmt.Errorf("in-process plugin: %w", err)
 			}
 		}
 	}
@@ -528,11 +585,11 @@ func (m *Manager) Start(ctx context.Context) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	if err := m.startJS(ctx, m.API.Addr()); err != nil {
+	if err := m.startInProcess(ctx, m.API.Addr()); err != nil {
 		return err
 	}
 
-	go m.watchJS(ctx)
+	go m.watchInProcess(ctx)
 
 	for i := range m.configs {
 		c := m.configs[i]
@@ -613,7 +670,16 @@ func (m *Manager) start(ctx context.Context, c config) error {
 		return errors.Errorf("unknown type for plugin %q: %T", c.name, raw)
 	}
 
-	metadata, err := service.Register(ctx, m.API.Addr())
+	token, err := m.issueToken(c.name)
+	if err != nil {
+		return errors.Wrapf(err, "issue dashboard api token for %q", c.name)
+	}
+
+	metadata, err := register(ctx, service, Registration{
+		DashboardAPIAddress: m.API.Addr(),
+		DashboardAPIToken:   token,
+		Configuration:       m.Configuration(c.name),
+	})
 	if err != nil {
 		return errors.Wrapf(err, "register plugin %q", c.name)
 	}
@@ -622,7 +688,7 @@ func (m *Manager) start(ctx context.Context, c config) error {
 		return errors.Wrapf(err, "storing plugin")
 	}
 
-	for _, actionName := range metadata.Capabilities.ActionNames {
+	for _, actionName := range metadata.Capabilities.HandledActionNames() {
 		actionPath := actionName
 		pluginLogger.With("action-path", actionPath).Infof("registering plugin action")
 		err := m.ActionRegistrar.Register(actionPath, c.name, func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
@@ -652,6 +718,8 @@ func (m *Manager) start(ctx context.Context, c config) error {
 			return errors.Wrap(err, "creating module proxy")
 		}
 
+		m.API.SetContentRoot(token, mp.ContentPath())
+
 		if err := m.ModuleRegistrar.Register(mp); err != nil {
 			return errors.Wrapf(err, "register module %s", metadata.Name)
 		}
@@ -660,6 +728,27 @@ func (m *Manager) start(ctx context.Context, c config) error {
 	return nil
 }
 
+// issueToken issues a dashboard API token to a plugin. The token previously
+// issued to the plugin, if any, is revoked. Plugins are started and restarted
+// from different goroutines, so tokens are guarded by tokenLock.
+func (m *Manager) issueToken(name string) (string, error) {
+	m.tokenLock.Lock()
+	defer m.tokenLock.Unlock()
+
+	if token, ok := m.tokens[name]; ok {
+		m.API.RevokeToken(token)
+		delete(m.tokens, name)
+	}
+
+	token, err := m.API.IssueToken()
+	if err != nil {
+		return "", err
+	}
+
+	m.tokens[name] = token
+	return token, nil
+}
+
 // Stop stops all plugins.
 func (m *Manager) Stop(ctx context.Context) {
 	logger := log.From(ctx)
@@ -777,3 +866,161 @@ func (m *Manager) ObjectStatus(ctx context.Context, object runtime.Object) (*Obj
 	<-done
 	return &osr, nil
 }
+
+// ListColumns queries plugins for additional list table columns for the objects
+// in a list. Each plugin is queried once for the whole list.
+func (m *Manager) ListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error) {
+	if m.Runners == nil {
+		return nil, errors.New("runners is nil")
+	}
+
+	runner, ch := m.Runners.ListColumns(m.store)
+	done := make(chan bool)
+
+	responses := make([]ListColumnsResponse, len(objects))
+
+	go func() {
+		for resp := range ch {
+			for i := range resp {
+				if i < len(responses) {
+					responses[i].Columns = append(responses[i].Columns, resp[i].Columns...)
+				}
+			}
+		}
+
+		done <- true
+	}()
+
+	if err := runner.Run(ctx, objects, m.store.ClientNames()); err != nil {
+		return nil, fmt.Errorf("list columns runner failed: %w", err)
+	}
+	close(ch)
+
+	<-done
+
+	for i := range responses {
+		columns := responses[i].Columns
+		sort.SliceStable(columns, func(i, j int) bool {
+			return columns[i].Name < columns[j].Name
+		})
+	}
+
+	return responses, nil
+}
+
+// RelatedObjects queries plugins for objects related to an object.
+func (m *Manager) RelatedObjects(ctx context.Context, object runtime.Object) (*RelatedObjectsResponse, error) {
+	if m.Runners == nil {
+		return nil, errors.New("runners is nil")
+	}
+
+	runner, ch := m.Runners.RelatedObjects(m.store)
+	done := make(chan bool)
+
+	var ror RelatedObjectsResponse
+
+	go func() {
+		for resp := range ch {
+			ror.Keys = append(ror.Keys, resp.Keys...)
+		}
+
+		done <- true
+	}()
+
+	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
+		return nil, fmt.Errorf("related objects runner failed: %w", err)
+	}
+	close(ch)
+
+	<-done
+
+	return &ror, nil
+}
+
+// ObjectActions returns the actions plugins add for an object's GVK.
+func (m *Manager) ObjectActions(object runtime.Object) ([]ObjectAction, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	gvk := object.GetObjectKind().GroupVersionKind()
+
+	var list []ObjectAction
+
+	for _, name := range m.store.ClientNames() {
+		if IsInProcessPlugin(name) {
+			inProcessPlugin, ok := m.store.GetInProcess(name)
+			if !ok {
+				return nil, fmt.Errorf("plugin %s not found", name)
+			}
+
+			list = append(list, inProcessPlugin.Metadata().Capabilities.ObjectActionsFor(gvk)...)
+			continue
+		}
+
+		metadata, err := m.store.GetMetadata(name)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, metadata.Capabilities.ObjectActionsFor(gvk)...)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Label < list[j].Label
+	})
+
+	return list, nil
+}
+
+// Configuration returns the configuration for a plugin. If there is no configuration
+// for the plugin, an empty configuration is returned.
+func (m *Manager) Configuration(name string) Configuration {
+	m.configurationLock.RLock()
+	defer m.configurationLock.RUnlock()
+
+	configuration := Configuration{}
+	for k, v := range m.configurations[ConfigurationKey(name)] {
+		configuration[k] = v
+	}
+
+	return configuration
+}
+
+// UpdateConfiguration updates the configuration for a plugin and notifies the plugin
+// with a RequestSetPluginConfiguration action.
+func (m *Manager) UpdateConfiguration(ctx context.Context, name string, configuration Configuration) error {
+	m.configurationLock.Lock()
+	updated := Configuration{}
+	for k, v := range configuration {
+		updated[k] = v
+	}
+	m.configurations[ConfigurationKey(name)] = updated
+	m.configurationLock.Unlock()
+
+	payload := updated.ToActionPayload()
+
+	if IsInProcessPlugin(name) {
+		inProcessPlugin, ok := m.store.GetInProcess(name)
+		if !ok {
+			return fmt.Errorf("plugin %s not found", name)
+		}
+
+		if err := inProcessPlugin.HandleAction(ctx, action.RequestSetPluginConfiguration, payload); err != nil {
+			return fmt.Errorf("notify plugin %q of configuration update: %w", name, err)
+		}
+
+		return nil
+	}
+
+	service, err := m.store.GetService(name)
+	if err != nil {
+		return err
+	}
+
+	if err := service.HandleAction(ctx, action.RequestSetPluginConfiguration, payload); err != nil {
+		return fmt.Errorf("notify plugin %q of configuration update: %w", name, err)
+	}
+
+	return nil
+}
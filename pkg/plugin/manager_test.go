@@ -7,6 +7,7 @@ package plugin_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -16,7 +17,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	dashPlugin "github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
@@ -87,6 +90,9 @@ func TestManager(t *testing.T) {
 	err = manager.Start(ctx)
 	require.NoError(t, err)
 
+	// plugin1 isn't a module, so it can't push content.
+	assert.Empty(t, apiService.contentRoots)
+
 	manager.Stop(ctx)
 }
 
@@ -197,20 +203,175 @@ func TestManager_Tabs(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestManager_ListColumns(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod1 := testutil.CreatePod("pod1")
+	pod2 := testutil.CreatePod("pod2")
+	objects := []runtime.Object{pod1, pod2}
+
+	var options []dashPlugin.ManagerOption
+
+	store := fake.NewMockManagerStore(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2"})
+
+	ch := make(chan []dashPlugin.ListColumnsResponse)
+	listColumnsRunner := dashPlugin.ListRunner{
+		RunFunc: func(ctx context.Context, name string, got []runtime.Object) error {
+			require.Equal(t, objects, got)
+
+			responses := make([]dashPlugin.ListColumnsResponse, len(got))
+			responses[0].Columns = []dashPlugin.ListColumn{
+				{Name: name, Value: component.NewText(name)},
+			}
+
+			ch <- responses
+			return nil
+		},
+	}
+
+	runners := fake.NewMockRunners(controller)
+	runners.EXPECT().
+		ListColumns(gomock.Eq(store)).Return(listColumnsRunner, ch)
+
+	options = append(options, func(m *dashPlugin.Manager) {
+		m.Runners = runners
+	})
+
+	apiService := &stubAPIService{}
+	manager := dashPlugin.NewManager(apiService, moduleRegistrar, actionRegistrar, options...)
+	manager.SetStore(store)
+
+	ctx := context.Background()
+	got, err := manager.ListColumns(ctx, objects)
+	require.NoError(t, err)
+
+	expected := []dashPlugin.ListColumnsResponse{
+		{
+			Columns: []dashPlugin.ListColumn{
+				{Name: "plugin1", Value: component.NewText("plugin1")},
+				{Name: "plugin2", Value: component.NewText("plugin2")},
+			},
+		},
+		{},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestManager_ObjectActions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	store := fake.NewMockManagerStore(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2"})
+
+	restart := dashPlugin.ObjectAction{
+		ActionName:    "plugin1/restart",
+		Label:         "Restart",
+		SupportedGVKs: []schema.GroupVersionKind{gvk.Pod},
+	}
+	annotate := dashPlugin.ObjectAction{
+		ActionName:    "plugin2/annotate",
+		Label:         "Annotate",
+		SupportedGVKs: []schema.GroupVersionKind{gvk.Pod},
+	}
+	scale := dashPlugin.ObjectAction{
+		ActionName:    "plugin2/scale",
+		Label:         "Scale",
+		SupportedGVKs: []schema.GroupVersionKind{gvk.Deployment},
+	}
+
+	store.EXPECT().
+		GetMetadata("plugin1").
+		Return(&dashPlugin.Metadata{
+			Capabilities: dashPlugin.Capabilities{
+				ObjectActions: []dashPlugin.ObjectAction{restart},
+			},
+		}, nil)
+	store.EXPECT().
+		GetMetadata("plugin2").
+		Return(&dashPlugin.Metadata{
+			Capabilities: dashPlugin.Capabilities{
+				ObjectActions: []dashPlugin.ObjectAction{annotate, scale},
+			},
+		}, nil)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar)
+	manager.SetStore(store)
+
+	got, err := manager.ObjectActions(pod)
+	require.NoError(t, err)
+
+	expected := []dashPlugin.ObjectAction{annotate, restart}
+	assert.Equal(t, expected, got)
+}
+
+func TestManager_UpdateConfiguration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	pluginPath := filepath.Join("/plugins", "plugin1")
+
+	configurations := map[string]dashPlugin.Configuration{
+		"plugin1": {"endpoint": "https://example.com"},
+	}
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar,
+		dashPlugin.WithConfigurations(configurations))
+	manager.SetStore(store)
+
+	require.Equal(t, configurations["plugin1"], manager.Configuration(pluginPath))
+	require.Empty(t, manager.Configuration("plugin2"))
+
+	updated := dashPlugin.Configuration{"endpoint": "https://example.org"}
+
+	service := fake.NewMockService(controller)
+	service.EXPECT().
+		HandleAction(gomock.Any(), action.RequestSetPluginConfiguration, action.Payload{"endpoint": "https://example.org"}).
+		Return(nil)
+	store.EXPECT().GetService(pluginPath).Return(service, nil)
+
+	ctx := context.Background()
+	require.NoError(t, manager.UpdateConfiguration(ctx, pluginPath, updated))
+
+	assert.Equal(t, updated, manager.Configuration(pluginPath))
+}
+
 type fakePluginClient struct {
 	clientProtocol *fake.MockClientProtocol
-	service        *fake.MockService
+	service        registrationService
 	name           string
 }
 
 var _ dashPlugin.Client = (*fakePluginClient)(nil)
 
 func newFakePluginClient(name string, controller *gomock.Controller) *fakePluginClient {
-	service := fake.NewMockService(controller)
+	service := registrationService{
+		MockService:             fake.NewMockService(controller),
+		MockRegistrationService: fake.NewMockRegistrationService(controller),
+	}
 	metadata := dashPlugin.Metadata{
 		Name: name,
 	}
-	service.EXPECT().Register(gomock.Any(), gomock.Eq("localhost:54321")).Return(metadata, nil).AnyTimes()
+	registration := dashPlugin.Registration{
+		DashboardAPIAddress: "localhost:54321",
+		DashboardAPIToken:   "token",
+		Configuration:       dashPlugin.Configuration{},
+	}
+	service.MockRegistrationService.EXPECT().RegisterWith(gomock.Any(), gomock.Eq(registration)).Return(metadata, nil).AnyTimes()
 
 	clientProtocol := fake.NewMockClientProtocol(controller)
 	clientProtocol.EXPECT().Dispense("plugin").Return(service, nil).AnyTimes()
@@ -228,7 +389,9 @@ func (c *fakePluginClient) Client() (plugin.ClientProtocol, error) {
 
 func (c *fakePluginClient) Kill() {}
 
-type stubAPIService struct{}
+type stubAPIService struct {
+	contentRoots map[string]string
+}
 
 var _ api.API = (*stubAPIService)(nil)
 
@@ -239,3 +402,16 @@ func (f *stubAPIService) Addr() string {
 func (f *stubAPIService) Start(context.Context) error {
 	return nil
 }
+
+func (f *stubAPIService) IssueToken() (string, error) {
+	return "token", nil
+}
+
+func (f *stubAPIService) SetContentRoot(token, contentRoot string) {
+	if f.contentRoots == nil {
+		f.contentRoots = make(map[string]string)
+	}
+	f.contentRoots[token] = contentRoot
+}
+
+func (f *stubAPIService) RevokeToken(token string) {}
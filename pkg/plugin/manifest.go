@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ManifestFileName is the name of the manifest file in a plugin package.
+	ManifestFileName = "plugin.yaml"
+
+	checksumPrefix = "sha256:"
+)
+
+// Manifest describes a plugin package.
+type Manifest struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+	// Version is the version of the plugin.
+	Version string `json:"version"`
+	// Description is a description of the plugin.
+	Description string `json:"description,omitempty"`
+	// File is the name of the plugin binary or JavaScript file in the package.
+	File string `json:"file"`
+	// Checksum is the SHA256 checksum of the plugin file in the form `sha256:<hex>`.
+	Checksum string `json:"checksum"`
+	// OctantVersion is the minimum version of Octant the plugin is compatible with.
+	OctantVersion string `json:"octantVersion"`
+}
+
+// ParseManifest parses a manifest.
+func ParseManifest(data []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parse manifest: %w", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &manifest, nil
+}
+
+// Validate validates the manifest.
+func (m *Manifest) Validate() error {
+	required := []struct {
+		name  string
+		value string
+	}{
+		{name: "name", value: m.Name},
+		{name: "version", value: m.Version},
+		{name: "file", value: m.File},
+		{name: "checksum", value: m.Checksum},
+		{name: "octantVersion", value: m.OctantVersion},
+	}
+
+	for _, field := range required {
+		if field.value == "" {
+			return fmt.Errorf("manifest %s is required", field.name)
+		}
+	}
+
+	if m.Name != filepath.Base(m.Name) || m.Name == "." {
+		return fmt.Errorf("manifest name %q is invalid", m.Name)
+	}
+
+	if m.File != filepath.Base(m.File) || m.File == "." {
+		return fmt.Errorf("manifest file %q must be a file name", m.File)
+	}
+
+	if !strings.HasPrefix(m.Checksum, checksumPrefix) {
+		return fmt.Errorf("manifest checksum %q must start with %q", m.Checksum, checksumPrefix)
+	}
+
+	if _, err := version.ParseGeneric(m.OctantVersion); err != nil {
+		return fmt.Errorf("manifest octantVersion %q is invalid: %w", m.OctantVersion, err)
+	}
+
+	return nil
+}
+
+// CheckCompatibility returns an error if the plugin is not compatible with the
+// supplied Octant version. Development builds without a parsable version are
+// compatible with all plugins.
+func (m *Manifest) CheckCompatibility(octantVersion string) error {
+	current, err := version.ParseGeneric(octantVersion)
+	if err != nil {
+		return nil
+	}
+
+	minimum, err := version.ParseGeneric(m.OctantVersion)
+	if err != nil {
+		return fmt.Errorf("parse octantVersion %q: %w", m.OctantVersion, err)
+	}
+
+	if !current.AtLeast(minimum) {
+		return fmt.Errorf("plugin %q requires Octant %s or later (running %s)",
+			m.Name, m.OctantVersion, octantVersion)
+	}
+
+	return nil
+}
+
+// VerifyChecksum returns an error if the checksum of data does not match the
+// manifest's checksum.
+func (m *Manifest) VerifyChecksum(data []byte) error {
+	if got := Checksum(data); got != m.Checksum {
+		return fmt.Errorf("checksum mismatch for %q: expected %s, got %s", m.File, m.Checksum, got)
+	}
+
+	return nil
+}
+
+// Checksum returns the checksum of data in the format used by manifests.
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return checksumPrefix + hex.EncodeToString(sum[:])
+}
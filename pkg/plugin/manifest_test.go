@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected *Manifest
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			data: `
+name: sample
+version: 0.1.0
+description: a sample plugin
+file: octant-sample
+checksum: sha256:abc
+octantVersion: 0.12.0
+`,
+			expected: &Manifest{
+				Name:          "sample",
+				Version:       "0.1.0",
+				Description:   "a sample plugin",
+				File:          "octant-sample",
+				Checksum:      "sha256:abc",
+				OctantVersion: "0.12.0",
+			},
+		},
+		{
+			name: "missing version",
+			data: `
+name: sample
+file: octant-sample
+checksum: sha256:abc
+octantVersion: 0.12.0
+`,
+			wantErr: true,
+		},
+		{
+			name: "file is a path",
+			data: `
+name: sample
+version: 0.1.0
+file: ../octant-sample
+checksum: sha256:abc
+octantVersion: 0.12.0
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown checksum type",
+			data: `
+name: sample
+version: 0.1.0
+file: octant-sample
+checksum: md5:abc
+octantVersion: 0.12.0
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid octant version",
+			data: `
+name: sample
+version: 0.1.0
+file: octant-sample
+checksum: sha256:abc
+octantVersion: latest
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseManifest([]byte(test.data))
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestManifest_CheckCompatibility(t *testing.T) {
+	manifest := Manifest{Name: "sample", OctantVersion: "0.12.0"}
+
+	tests := []struct {
+		name          string
+		octantVersion string
+		wantErr       bool
+	}{
+		{name: "same version", octantVersion: "v0.12.0"},
+		{name: "newer version", octantVersion: "v0.13.1"},
+		{name: "older version", octantVersion: "v0.11.0", wantErr: true},
+		{name: "development version", octantVersion: "(dev-version)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := manifest.CheckCompatibility(test.octantVersion)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestManifest_VerifyChecksum(t *testing.T) {
+	data := []byte("plugin")
+	manifest := Manifest{File: "plugin", Checksum: Checksum(data)}
+
+	require.NoError(t, manifest.VerifyChecksum(data))
+	require.Error(t, manifest.VerifyChecksum([]byte("other")))
+}
@@ -2,6 +2,7 @@ package plugin
 
 import (
 	"context"
+	"path"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -22,6 +23,7 @@ type ModuleProxy struct {
 }
 
 var _ module.Module = (*ModuleProxy)(nil)
+var _ module.NavigationContributor = (*ModuleProxy)(nil)
 
 // NewModuleProxy creates a ModuleProxy instance.
 func NewModuleProxy(pluginName string, metadata *Metadata, service ModuleService) (*ModuleProxy, error) {
@@ -64,6 +66,20 @@ func (m *ModuleProxy) Navigation(ctx context.Context, namespace, root string) ([
 	return []navigation.Navigation{topLevel}, nil
 }
 
+// NavigationContributions returns the navigation entries the plugin adds to other
+// modules' sections. Entry paths are resolved against the plugin's content path, so
+// they are routed to the plugin's Content.
+func (m *ModuleProxy) NavigationContributions() []navigation.Contribution {
+	var list []navigation.Contribution
+
+	for _, contribution := range m.Metadata.Capabilities.NavigationContributions {
+		contribution.Path = path.Join(m.ContentPath(), contribution.Path)
+		list = append(list, contribution)
+	}
+
+	return list
+}
+
 // SetNamespace is a no-op
 func (ModuleProxy) SetNamespace(namespace string) error {
 	return nil
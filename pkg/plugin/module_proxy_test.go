@@ -84,3 +84,30 @@ func TestModuleProxy_Navigation(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+func TestModuleProxy_NavigationContributions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := fake.NewMockModuleService(controller)
+
+	metadata := &plugin.Metadata{
+		Name: "plugin-name",
+		Capabilities: plugin.Capabilities{
+			IsModule: true,
+			NavigationContributions: []navigation.Contribution{
+				{Module: "overview", Section: "Config and Storage", Title: "Certificates", Path: "/certificates"},
+			},
+		},
+	}
+
+	moduleProxy, err := plugin.NewModuleProxy("plugin-name", metadata, service)
+	require.NoError(t, err)
+
+	expected := []navigation.Contribution{
+		{Module: "overview", Section: "Config and Storage", Title: "Certificates", Path: "plugin-name/certificates"},
+	}
+
+	assert.Equal(t, expected, moduleProxy.NavigationContributions())
+	assert.Equal(t, "/certificates", metadata.Capabilities.NavigationContributions[0].Path)
+}
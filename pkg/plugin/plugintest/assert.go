@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// AssertComponent asserts two components are equal. Components are compared as
+// indented JSON, so a failure shows a line by line diff of the component trees.
+func AssertComponent(t *testing.T, expected, actual component.Component, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return assertJSON(t, expected, actual, msgAndArgs...)
+}
+
+// AssertContent asserts two content responses are equal. Responses are compared as
+// indented JSON, so a failure shows a line by line diff of the component trees.
+func AssertContent(t *testing.T, expected, actual component.ContentResponse, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return assertJSON(t, expected, actual, msgAndArgs...)
+}
+
+func assertJSON(t *testing.T, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+
+	a, err := json.MarshalIndent(expected, "", "  ")
+	require.NoError(t, err, "marshal expected")
+
+	b, err := json.MarshalIndent(actual, "", "  ")
+	require.NoError(t, err, "marshal actual")
+
+	return assert.Equal(t, string(a), string(b), msgAndArgs...)
+}
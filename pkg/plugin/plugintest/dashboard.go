@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin/api"
+	"github.com/vmware-tanzu/octant/pkg/plugin/service"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Dashboard is an in-memory Dashboard API backed by a Store. It records port forwards
+// and frontend updates so tests can assert on them.
+type Dashboard struct {
+	Store *Store
+
+	mu                  sync.Mutex
+	portForwards        map[string]api.PortForwardRequest
+	nextPortForward     int
+	frontendUpdateCount int
+	pushedContent       map[string]component.ContentResponse
+}
+
+var _ service.Dashboard = (*Dashboard)(nil)
+
+// NewDashboard creates a Dashboard backed by a store.
+func NewDashboard(objectStore *Store) *Dashboard {
+	return &Dashboard{
+		Store:         objectStore,
+		portForwards:  make(map[string]api.PortForwardRequest),
+		pushedContent: make(map[string]component.ContentResponse),
+	}
+}
+
+// Close does nothing.
+func (d *Dashboard) Close() error {
+	return nil
+}
+
+// List lists objects in the store.
+func (d *Dashboard) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error) {
+	list, _, err := d.Store.List(ctx, key)
+	return list, err
+}
+
+// Get gets an object from the store.
+func (d *Dashboard) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	return d.Store.Get(ctx, key)
+}
+
+// Update replaces an object in the store.
+func (d *Dashboard) Update(ctx context.Context, object *unstructured.Unstructured) error {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return err
+	}
+
+	return d.Store.Update(ctx, key, func(u *unstructured.Unstructured) error {
+		u.Object = object.DeepCopy().Object
+		return nil
+	})
+}
+
+// PortForward records a port forward. The response uses the requested port.
+func (d *Dashboard) PortForward(_ context.Context, req api.PortForwardRequest) (api.PortForwardResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.nextPortForward++
+	id := fmt.Sprintf("port-forward-%d", d.nextPortForward)
+	d.portForwards[id] = req
+
+	return api.PortForwardResponse{
+		ID:   id,
+		Port: req.Port,
+	}, nil
+}
+
+// CancelPortForward cancels a port forward.
+func (d *Dashboard) CancelPortForward(_ context.Context, id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.portForwards, id)
+}
+
+// PortForwards returns the active port forwards by ID.
+func (d *Dashboard) PortForwards() map[string]api.PortForwardRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m := make(map[string]api.PortForwardRequest, len(d.portForwards))
+	for id, req := range d.portForwards {
+		m[id] = req
+	}
+
+	return m
+}
+
+// ListNamespaces lists the namespace objects in the store. If there are none, it lists
+// the namespaces of the objects in the store.
+func (d *Dashboard) ListNamespaces(ctx context.Context) (api.NamespacesResponse, error) {
+	list, err := d.List(ctx, store.Key{APIVersion: "v1", Kind: "Namespace"})
+	if err != nil {
+		return api.NamespacesResponse{}, err
+	}
+
+	var namespaces []string
+	for i := range list.Items {
+		namespaces = append(namespaces, list.Items[i].GetName())
+	}
+
+	if len(namespaces) > 0 {
+		return api.NamespacesResponse{Namespaces: namespaces}, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, object := range d.Store.Objects() {
+		if namespace := object.GetNamespace(); namespace != "" && !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Strings(namespaces)
+
+	return api.NamespacesResponse{Namespaces: namespaces}, nil
+}
+
+// ForceFrontendUpdate records a frontend update.
+func (d *Dashboard) ForceFrontendUpdate(_ context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.frontendUpdateCount++
+	return nil
+}
+
+// FrontendUpdateCount returns the number of times a frontend update was forced.
+func (d *Dashboard) FrontendUpdateCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.frontendUpdateCount
+}
+
+// PushContent records content pushed for a content path.
+func (d *Dashboard) PushContent(_ context.Context, contentPath string, response component.ContentResponse) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pushedContent[contentPath] = response
+	return nil
+}
+
+// EndContentPush removes pushed content for a content path.
+func (d *Dashboard) EndContentPush(_ context.Context, contentPath string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.pushedContent, contentPath)
+}
+
+// PushedContent returns the content most recently pushed for a content path.
+func (d *Dashboard) PushedContent(contentPath string) (component.ContentResponse, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	response, ok := d.pushedContent[contentPath]
+	return response, ok
+}
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package plugintest runs plugins in process for testing. Plugins use an in-memory
+// Dashboard API backed by a Store that tests can seed with objects.
+package plugintest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/service"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Option is an option for configuring a Harness.
+type Option func(o *options)
+
+type options struct {
+	ctx           context.Context
+	objects       []runtime.Object
+	configuration plugin.Configuration
+}
+
+// WithObjects seeds the harness's store with objects.
+func WithObjects(objects ...runtime.Object) Option {
+	return func(o *options) {
+		o.objects = append(o.objects, objects...)
+	}
+}
+
+// WithConfiguration sets the configuration the plugin is registered with.
+func WithConfiguration(configuration plugin.Configuration) Option {
+	return func(o *options) {
+		o.configuration = configuration
+	}
+}
+
+// WithContext sets the context passed to the plugin.
+func WithContext(ctx context.Context) Option {
+	return func(o *options) {
+		o.ctx = ctx
+	}
+}
+
+// Harness runs a plugin in process.
+type Harness struct {
+	// Store is the object store behind the plugin's dashboard client.
+	Store *Store
+	// Dashboard is the plugin's dashboard client for Go plugins.
+	Dashboard *Dashboard
+	// Metadata is the metadata the plugin registered with.
+	Metadata plugin.Metadata
+
+	ctx     context.Context
+	service plugin.ModuleService
+	close   func()
+}
+
+// New registers a Go plugin created with service.Register and runs it in process.
+// The plugin's dashboard client is a Dashboard backed by the harness's Store.
+func New(t *testing.T, p *service.Plugin, opts ...Option) *Harness {
+	t.Helper()
+
+	o := newOptions(opts)
+	h := newHarness(t, o)
+
+	service.WithDashboardFactory(func(string, string) (service.Dashboard, error) {
+		return h.Dashboard, nil
+	})(p)
+
+	registrationService, ok := p.Service().(plugin.RegistrationService)
+	require.True(t, ok, "plugin service accepts a registration")
+
+	metadata, err := registrationService.RegisterWith(h.ctx, plugin.Registration{Configuration: o.configuration})
+	require.NoError(t, err, "register plugin")
+
+	h.service = p.Service()
+	h.Metadata = metadata
+
+	return h
+}
+
+// NewJS loads a JavaScript plugin and runs it in process. WebAssembly plugins can be
+// loaded as well. The plugin's dashboard client uses the harness's Store. Call Close
+// when the test is finished.
+func NewJS(t *testing.T, pluginPath string, opts ...Option) *Harness {
+	t.Helper()
+
+	o := newOptions(opts)
+	h := newHarness(t, o)
+
+	p, err := plugin.NewInProcessPlugin(h.ctx, h.Store, pluginPath, plugin.Registration{Configuration: o.configuration})
+	require.NoError(t, err, "load plugin %s", pluginPath)
+
+	h.service = p
+	h.Metadata = *p.Metadata()
+	h.close = p.Close
+
+	return h
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		ctx: context.Background(),
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+func newHarness(t *testing.T, o options) *Harness {
+	objectStore, err := NewStore(o.objects...)
+	require.NoError(t, err, "seed store")
+
+	return &Harness{
+		Store:     objectStore,
+		Dashboard: NewDashboard(objectStore),
+		ctx:       o.ctx,
+	}
+}
+
+// Close closes the plugin.
+func (h *Harness) Close() {
+	if h.close != nil {
+		h.close()
+	}
+}
+
+// Print calls the plugin's printer for an object.
+func (h *Harness) Print(object runtime.Object) (plugin.PrintResponse, error) {
+	return h.service.Print(h.ctx, object)
+}
+
+// PrintTab calls the plugin's tab printer for an object.
+func (h *Harness) PrintTab(object runtime.Object) (plugin.TabResponse, error) {
+	return h.service.PrintTab(h.ctx, object)
+}
+
+// PrintListColumns calls the plugin's list column printer for objects.
+func (h *Harness) PrintListColumns(objects ...runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	return h.service.PrintListColumns(h.ctx, objects)
+}
+
+// RelatedObjects calls the plugin's related objects handler for an object.
+func (h *Harness) RelatedObjects(object runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	return h.service.RelatedObjects(h.ctx, object)
+}
+
+// ObjectStatus calls the plugin's object status handler for an object.
+func (h *Harness) ObjectStatus(object runtime.Object) (plugin.ObjectStatusResponse, error) {
+	return h.service.ObjectStatus(h.ctx, object)
+}
+
+// HandleAction sends an action to the plugin.
+func (h *Harness) HandleAction(actionName string, payload action.Payload) error {
+	return h.service.HandleAction(h.ctx, actionName, payload)
+}
+
+// Navigation calls the plugin's navigation handler.
+func (h *Harness) Navigation() (navigation.Navigation, error) {
+	return h.service.Navigation(h.ctx)
+}
+
+// Content requests content for a path. For Go plugins, the path is matched against
+// the plugin's router.
+func (h *Harness) Content(contentPath string) (component.ContentResponse, error) {
+	return h.service.Content(h.ctx, contentPath)
+}
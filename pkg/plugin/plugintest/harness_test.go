@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/service"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestHarness(t *testing.T) {
+	podKey := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Pod"}
+
+	p, err := service.Register("test", "test plugin", &plugin.Capabilities{
+		SupportsPrinterConfig: []schema.GroupVersionKind{gvk.Pod},
+		SupportsTab:           []schema.GroupVersionKind{gvk.Pod},
+		ActionNames:           []string{"test/label"},
+		IsModule:              true,
+	},
+		service.WithPrinter(func(request *service.PrintRequest) (plugin.PrintResponse, error) {
+			return plugin.PrintResponse{
+				Config: []component.SummarySection{
+					{Header: "Plugin", Content: component.NewText(request.Configuration()["greeting"])},
+				},
+			}, nil
+		}),
+		service.WithTabPrinter(func(request *service.PrintRequest) (plugin.TabResponse, error) {
+			layout := component.NewFlexLayout("Tab")
+			return plugin.TabResponse{Tab: component.NewTabWithContents(*layout)}, nil
+		}),
+		service.WithActionHandler(func(request *service.ActionRequest) error {
+			name, err := request.Payload.String("name")
+			if err != nil {
+				return err
+			}
+
+			key := podKey
+			key.Name = name
+			object, err := request.DashboardClient.Get(request.Context(), key)
+			if err != nil {
+				return err
+			}
+			if object == nil {
+				return fmt.Errorf("pod %s not found", name)
+			}
+
+			object.SetLabels(map[string]string{"labeled": "true"})
+			return request.DashboardClient.Update(request.Context(), object)
+		}),
+		service.WithNavigation(nil, func(router *service.Router) {
+			router.HandleFunc("/pods", func(request service.Request) (component.ContentResponse, error) {
+				list, err := request.DashboardClient().List(request.Context(), podKey)
+				if err != nil {
+					return component.ContentResponse{}, err
+				}
+
+				cr := component.NewContentResponse(component.TitleFromString("Pods"))
+				cr.Add(component.NewText(fmt.Sprintf("%d pods", len(list.Items))))
+				return *cr, nil
+			})
+		}),
+	)
+	require.NoError(t, err)
+
+	h := New(t, p,
+		WithObjects(testutil.CreatePod("pod-1"), testutil.CreatePod("pod-2")),
+		WithConfiguration(plugin.Configuration{"greeting": "hello"}))
+	defer h.Close()
+
+	assert.Equal(t, "test", h.Metadata.Name)
+
+	pr, err := h.Print(testutil.CreatePod("pod-1"))
+	require.NoError(t, err)
+	AssertComponent(t, component.NewText("hello"), pr.Config[0].Content)
+
+	tab, err := h.PrintTab(testutil.CreatePod("pod-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "Tab", tab.Tab.Name)
+
+	content, err := h.Content("/pods")
+	require.NoError(t, err)
+	expected := component.NewContentResponse(component.TitleFromString("Pods"))
+	expected.Add(component.NewText("2 pods"))
+	AssertContent(t, *expected, content)
+
+	content, err = h.Content("/missing")
+	require.NoError(t, err)
+	AssertContent(t, component.ContentResponse{}, content)
+
+	require.NoError(t, h.HandleAction("test/label", action.Payload{"name": "pod-2"}))
+	key := podKey
+	key.Name = "pod-2"
+	object, err := h.Store.Get(h.ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"labeled": "true"}, object.GetLabels())
+
+	require.EqualError(t, h.HandleAction("test/label", action.Payload{"name": "pod-3"}), "pod pod-3 not found")
+}
+
+func TestNewJS(t *testing.T) {
+	script := `
+var _octantPlugin = function(dashboardClient, httpClient, configuration) {
+  this.dashboardClient = dashboardClient;
+  this.configuration = configuration;
+  this.name = "js";
+  this.description = "js plugin";
+  this.isModule = true;
+  this.capabilities = {
+    navigationContributions: [{ module: "overview", section: "Config and Storage", title: "Certificates", path: "certificates" }]
+  };
+};
+
+_octantPlugin.prototype.contentHandler = function(request) {
+  var pods = this.dashboardClient.List({ namespace: "namespace", apiVersion: "v1", kind: "Pod" });
+  return {
+    content: {
+      title: [{ metadata: { type: "text" }, config: { value: this.configuration.title } }],
+      viewComponents: [{ metadata: { type: "text" }, config: { value: pods.length + " pods" } }]
+    }
+  };
+};
+`
+	dir, err := ioutil.TempDir("", "octant-plugintest")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	pluginPath := filepath.Join(dir, "plugin.js")
+	require.NoError(t, ioutil.WriteFile(pluginPath, []byte(script), 0644))
+
+	h := NewJS(t, pluginPath,
+		WithObjects(testutil.CreatePod("pod")),
+		WithConfiguration(plugin.Configuration{"title": "Pods"}))
+	defer h.Close()
+
+	assert.Equal(t, "js", h.Metadata.Name)
+	expectedContributions := []navigation.Contribution{
+		{Module: "overview", Section: "Config and Storage", Title: "Certificates", Path: "certificates"},
+	}
+	assert.Equal(t, expectedContributions, h.Metadata.Capabilities.NavigationContributions)
+
+	content, err := h.Content("/")
+	require.NoError(t, err)
+
+	expected := component.ContentResponse{
+		Title:      component.TitleFromString("Pods"),
+		Components: []component.Component{component.NewText("1 pods")},
+	}
+	AssertContent(t, expected, content)
+}
+
+func TestDashboard_Update(t *testing.T) {
+	objectStore, err := NewStore(testutil.CreatePod("pod"))
+	require.NoError(t, err)
+
+	dashboard := NewDashboard(objectStore)
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	pod.SetLabels(map[string]string{"app": "octant"})
+	require.NoError(t, dashboard.Update(context.Background(), pod))
+
+	missing := testutil.ToUnstructured(t, testutil.CreatePod("missing"))
+	require.Error(t, dashboard.Update(context.Background(), missing))
+
+	namespaces, err := dashboard.ListNamespaces(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{testutil.DefaultNamespace}, namespaces.Namespaces)
+
+	list, err := dashboard.List(context.Background(), store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Pod"})
+	require.NoError(t, err)
+	assert.Equal(t, []unstructured.Unstructured{*pod}, list.Items)
+}
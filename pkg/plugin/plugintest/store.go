@@ -0,0 +1,320 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Store is an in-memory object store for testing plugins. It is safe for concurrent use.
+type Store struct {
+	mu      sync.Mutex
+	objects map[store.Key]*unstructured.Unstructured
+}
+
+var _ store.Store = (*Store)(nil)
+
+// NewStore creates a Store seeded with objects.
+func NewStore(objects ...runtime.Object) (*Store, error) {
+	s := &Store{
+		objects: make(map[store.Key]*unstructured.Unstructured),
+	}
+
+	if err := s.Add(objects...); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Add adds objects to the store. Objects must have an API version and kind. Existing
+// objects with the same key are replaced.
+func (s *Store) Add(objects ...runtime.Object) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, object := range objects {
+		u, err := toUnstructured(object)
+		if err != nil {
+			return err
+		}
+
+		key, err := objectKey(u)
+		if err != nil {
+			return err
+		}
+
+		s.objects[key] = u
+	}
+
+	return nil
+}
+
+// Objects returns the objects in the store sorted by API version, kind, namespace, and name.
+func (s *Store) Objects() []*unstructured.Unstructured {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.match(func(store.Key, *unstructured.Unstructured) bool { return true })
+}
+
+// List lists objects matching the key's API version, kind, and, if they are set, namespace
+// and label selector.
+func (s *Store) List(_ context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var selector labels.Selector
+	if key.Selector != nil {
+		selector = key.Selector.AsSelector()
+	}
+
+	objects := s.match(func(k store.Key, u *unstructured.Unstructured) bool {
+		if k.APIVersion != key.APIVersion || k.Kind != key.Kind {
+			return false
+		}
+		if key.Namespace != "" && k.Namespace != key.Namespace {
+			return false
+		}
+		if selector != nil && !selector.Matches(labels.Set(u.GetLabels())) {
+			return false
+		}
+		return true
+	})
+
+	list := &unstructured.UnstructuredList{}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object)
+	}
+
+	return list, false, nil
+}
+
+// Get gets an object. It returns nil if the object does not exist.
+func (s *Store) Get(_ context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[storeKey(key)]
+	if !ok {
+		return nil, nil
+	}
+
+	return object.DeepCopy(), nil
+}
+
+// Delete deletes an object.
+func (s *Store) Delete(_ context.Context, key store.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key = storeKey(key)
+	if _, ok := s.objects[key]; !ok {
+		return notFound(key)
+	}
+
+	delete(s.objects, key)
+	return nil
+}
+
+// Watch does nothing. The store does not generate events.
+func (s *Store) Watch(_ context.Context, _ store.Key, _ cache.ResourceEventHandler) error {
+	return nil
+}
+
+// Unwatch does nothing.
+func (s *Store) Unwatch(_ context.Context, _ ...schema.GroupVersionKind) error {
+	return nil
+}
+
+// UpdateClusterClient does nothing.
+func (s *Store) UpdateClusterClient(_ context.Context, _ cluster.ClientInterface) error {
+	return nil
+}
+
+// RegisterOnUpdate does nothing.
+func (s *Store) RegisterOnUpdate(_ store.UpdateFn) {
+}
+
+// Update updates an object with an updater function.
+func (s *Store) Update(_ context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key = storeKey(key)
+	object, ok := s.objects[key]
+	if !ok {
+		return notFound(key)
+	}
+
+	updated := object.DeepCopy()
+	if err := updater(updated); err != nil {
+		return err
+	}
+
+	updatedKey, err := objectKey(updated)
+	if err != nil {
+		return err
+	}
+
+	if updatedKey != key {
+		return fmt.Errorf("updater changed the object's key from %s to %s", key, updatedKey)
+	}
+
+	s.objects[key] = updated
+	return nil
+}
+
+// IsLoading returns false. The store is always loaded.
+func (s *Store) IsLoading(_ context.Context, _ store.Key) bool {
+	return false
+}
+
+// Create creates an object. It returns an error if the object already exists.
+func (s *Store) Create(_ context.Context, object *unstructured.Unstructured) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, err := objectKey(object)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.objects[key]; ok {
+		return kerrors.NewAlreadyExists(groupResource(key), key.Name)
+	}
+
+	s.objects[key] = object.DeepCopy()
+	return nil
+}
+
+// CreateOrUpdateFromYAML creates or replaces objects from YAML. Objects without a
+// namespace are created in namespace.
+func (s *Store) CreateOrUpdateFromYAML(_ context.Context, namespace, input string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var results []string
+
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
+	for {
+		doc := map[string]interface{}{}
+		if err := d.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return results, nil
+			}
+			return results, fmt.Errorf("unable to parse yaml: %w", err)
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		object := &unstructured.Unstructured{Object: doc}
+		if object.GetNamespace() == "" {
+			object.SetNamespace(namespace)
+		}
+
+		key, err := objectKey(object)
+		if err != nil {
+			return results, err
+		}
+
+		verb := "Created"
+		if _, ok := s.objects[key]; ok {
+			verb = "Updated"
+		}
+
+		s.objects[key] = object
+		results = append(results, fmt.Sprintf("%s %s (%s) %s in %s", verb, key.Kind, key.APIVersion, key.Name, key.Namespace))
+	}
+}
+
+// match returns copies of the objects that match fn. The caller must hold the lock.
+func (s *Store) match(fn func(store.Key, *unstructured.Unstructured) bool) []*unstructured.Unstructured {
+	var keys []store.Key
+	for key, object := range s.objects {
+		if fn(key, object) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		return strings.Join([]string{a.APIVersion, a.Kind, a.Namespace, a.Name}, "/") <
+			strings.Join([]string{b.APIVersion, b.Kind, b.Namespace, b.Name}, "/")
+	})
+
+	var objects []*unstructured.Unstructured
+	for _, key := range keys {
+		objects = append(objects, s.objects[key].DeepCopy())
+	}
+
+	return objects
+}
+
+func toUnstructured(object runtime.Object) (*unstructured.Unstructured, error) {
+	if u, ok := object.(*unstructured.Unstructured); ok {
+		return u.DeepCopy(), nil
+	}
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("convert %T to unstructured: %w", object, err)
+	}
+
+	return &unstructured.Unstructured{Object: m}, nil
+}
+
+func objectKey(object *unstructured.Unstructured) (store.Key, error) {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return store.Key{}, err
+	}
+
+	if key.APIVersion == "" || key.Kind == "" {
+		return store.Key{}, fmt.Errorf("object %q requires an apiVersion and kind", key.Name)
+	}
+
+	return storeKey(key), nil
+}
+
+// storeKey returns the key an object is stored under.
+func storeKey(key store.Key) store.Key {
+	return store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+		Name:       key.Name,
+	}
+}
+
+func groupResource(key store.Key) schema.GroupResource {
+	return schema.GroupResource{
+		Group:    key.GroupVersionKind().Group,
+		Resource: strings.ToLower(key.Kind),
+	}
+}
+
+func notFound(key store.Key) error {
+	return kerrors.NewNotFound(groupResource(key), key.Name)
+}
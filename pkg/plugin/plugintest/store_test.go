@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestStore(t *testing.T) {
+	labeled := testutil.CreatePod("labeled", func(pod *corev1.Pod) {
+		pod.Labels = map[string]string{"app": "octant"}
+	})
+
+	objectStore, err := NewStore(testutil.CreatePod("pod"), labeled, testutil.CreateDeployment("deployment"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	podKey := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Pod"}
+
+	list, _, err := objectStore.List(ctx, podKey)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "labeled", list.Items[0].GetName())
+
+	selectorKey := podKey
+	selectorKey.Selector = &labels.Set{"app": "octant"}
+	list, _, err = objectStore.List(ctx, selectorKey)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "labeled", list.Items[0].GetName())
+
+	key := podKey
+	key.Name = "pod"
+	require.NoError(t, objectStore.Delete(ctx, key))
+	object, err := objectStore.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Nil(t, object)
+	assert.True(t, kerrors.IsNotFound(objectStore.Delete(ctx, key)))
+
+	err = objectStore.Create(ctx, testutil.ToUnstructured(t, labeled))
+	assert.True(t, kerrors.IsAlreadyExists(err))
+
+	_, err = NewStore(&corev1.Pod{})
+	require.Error(t, err)
+}
+
+func TestStore_CreateOrUpdateFromYAML(t *testing.T) {
+	objectStore, err := NewStore(testutil.CreateConfigMap("existing"))
+	require.NoError(t, err)
+
+	input := `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: existing
+  namespace: namespace
+data:
+  key: value
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: created
+`
+
+	results, err := objectStore.CreateOrUpdateFromYAML(context.Background(), testutil.DefaultNamespace, input)
+	require.NoError(t, err)
+
+	expected := []string{
+		"Updated ConfigMap (v1) existing in namespace",
+		"Created ConfigMap (v1) created in namespace",
+	}
+	assert.Equal(t, expected, results)
+
+	object, err := objectStore.Get(context.Background(), store.Key{
+		Namespace:  testutil.DefaultNamespace,
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       "existing",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"key": "value"}, object.Object["data"])
+}
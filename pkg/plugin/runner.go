@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -28,6 +29,12 @@ type Runners interface {
 	// ObjectStatus returns a runner for object status. The caller should
 	// close the channel when they are done with it.
 	ObjectStatus(ManagerStore) (DefaultRunner, chan ObjectStatusResponse)
+	// ListColumns returns a runner for list columns. The caller should
+	// close the channel when they are done with it.
+	ListColumns(ManagerStore) (ListRunner, chan []ListColumnsResponse)
+	// RelatedObjects returns a runner for related objects. The caller should
+	// close the channel when they are done with it.
+	RelatedObjects(ManagerStore) (DefaultRunner, chan RelatedObjectsResponse)
 }
 
 type defaultRunners struct{}
@@ -53,6 +60,16 @@ func (dr *defaultRunners) ObjectStatus(store ManagerStore) (DefaultRunner, chan
 	return ObjectStatusRunner(store, ch), ch
 }
 
+func (dr *defaultRunners) ListColumns(store ManagerStore) (ListRunner, chan []ListColumnsResponse) {
+	ch := make(chan []ListColumnsResponse)
+	return ListColumnsRunner(store, ch), ch
+}
+
+func (dr *defaultRunners) RelatedObjects(store ManagerStore) (DefaultRunner, chan RelatedObjectsResponse) {
+	ch := make(chan RelatedObjectsResponse)
+	return RelatedObjectsRunner(store, ch), ch
+}
+
 // DefaultRunner runs a function against all plugins
 type DefaultRunner struct {
 	RunFunc func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error
@@ -100,20 +117,60 @@ func (pr *DefaultRunner) validate(object runtime.Object) error {
 	return nil
 }
 
+// ListRunner runs a function against all plugins for a list of objects, so
+// each plugin is called once per list rather than once per object.
+type ListRunner struct {
+	RunFunc func(ctx context.Context, name string, objects []runtime.Object) error
+}
+
+// Run runs the runner for a list of objects with the provided clients.
+func (lr *ListRunner) Run(ctx context.Context, objects []runtime.Object, clientNames []string) error {
+	if lr.RunFunc == nil {
+		return fmt.Errorf("plugin runner validate: requires a runFunc")
+	}
+
+	for i := range objects {
+		if objects[i] == nil {
+			return fmt.Errorf("plugin runner validate: object %d is nil", i)
+		}
+	}
+
+	var g errgroup.Group
+
+	for _, name := range clientNames {
+		fn := func(name string) func() error {
+			return func() error {
+				if err := lr.RunFunc(ctx, name, objects); err != nil {
+					return fmt.Errorf("running on %s: %w", name, err)
+				}
+
+				return nil
+			}
+		}
+		g.Go(fn(name))
+	}
+
+	if err := g.Wait(); err != nil {
+		return fmt.Errorf("handle objects: %w", err)
+	}
+
+	return nil
+}
+
 // PrintRunner is a runner for printing.
 func PrintRunner(store ManagerStore, ch chan<- PrintResponse) DefaultRunner {
 	return DefaultRunner{
 		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
-			if IsJavaScriptPlugin(name) {
-				jsPlugin, ok := store.GetJS(name)
+			if IsInProcessPlugin(name) {
+				inProcessPlugin, ok := store.GetInProcess(name)
 				if !ok {
 					return fmt.Errorf("plugin %s not found", name)
 				}
-				if !jsPlugin.Metadata().Capabilities.HasPrinterSupport(gvk) {
+				if !inProcessPlugin.Metadata().Capabilities.HasPrinterSupport(gvk) {
 					return nil
 				}
 
-				resp, err := jsPlugin.Print(ctx, object)
+				resp, err := inProcessPlugin.Print(ctx, object)
 
 				if err != nil {
 					return err
@@ -164,17 +221,17 @@ func printObject(ctx context.Context, store ManagerStore, pluginName string, obj
 func TabRunner(store ManagerStore, ch chan<- component.Tab) DefaultRunner {
 	runner := DefaultRunner{
 		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
-			if IsJavaScriptPlugin(name) {
-				jsPlugin, ok := store.GetJS(name)
+			if IsInProcessPlugin(name) {
+				inProcessPlugin, ok := store.GetInProcess(name)
 				if !ok {
 					return fmt.Errorf("plugin %s not found", name)
 				}
 
-				if !jsPlugin.Metadata().Capabilities.HasTabSupport(gvk) {
+				if !inProcessPlugin.Metadata().Capabilities.HasTabSupport(gvk) {
 					return nil
 				}
 
-				resp, err := jsPlugin.PrintTab(ctx, object)
+				resp, err := inProcessPlugin.PrintTab(ctx, object)
 				if err != nil {
 					return fmt.Errorf("printing tabResponse for plugin: %q: %w", name, err)
 				}
@@ -219,17 +276,17 @@ func TabRunner(store ManagerStore, ch chan<- component.Tab) DefaultRunner {
 func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) DefaultRunner {
 	return DefaultRunner{
 		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
-			if IsJavaScriptPlugin(name) {
-				jsPlugin, ok := store.GetJS(name)
+			if IsInProcessPlugin(name) {
+				inProcessPlugin, ok := store.GetInProcess(name)
 				if !ok {
 					return fmt.Errorf("plugin %s not found", name)
 				}
 
-				if !jsPlugin.Metadata().Capabilities.HasObjectStatusSupport(gvk) {
+				if !inProcessPlugin.Metadata().Capabilities.HasObjectStatusSupport(gvk) {
 					return nil
 				}
 
-				resp, err := jsPlugin.ObjectStatus(ctx, object)
+				resp, err := inProcessPlugin.ObjectStatus(ctx, object)
 				if err != nil {
 					return fmt.Errorf("printing objectStatus for plugin: %q: %w", name, err)
 				}
@@ -262,3 +319,140 @@ func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) Defa
 		},
 	}
 }
+
+// ListColumnsRunner is a runner for list columns. Each plugin is sent the objects
+// in the list it supports in one call. Plugins which fail are logged and skipped,
+// so one plugin can't remove the columns of the others.
+func ListColumnsRunner(store ManagerStore, ch chan<- []ListColumnsResponse) ListRunner {
+	return ListRunner{
+		RunFunc: func(ctx context.Context, name string, objects []runtime.Object) error {
+			responses, err := printListColumns(ctx, store, name, objects)
+			if err != nil {
+				log.From(ctx).With("plugin-name", name).WithErr(err).Errorf("print list columns")
+				return nil
+			}
+
+			if responses != nil {
+				ch <- responses
+			}
+
+			return nil
+		},
+	}
+}
+
+// printListColumns prints list table columns with a plugin for the objects it supports.
+// There is a response for each object. The responses are nil if the plugin supports
+// none of the objects.
+func printListColumns(ctx context.Context, store ManagerStore, name string, objects []runtime.Object) ([]ListColumnsResponse, error) {
+	var capabilities Capabilities
+	var printer interface {
+		PrintListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error)
+	}
+
+	if IsInProcessPlugin(name) {
+		inProcessPlugin, ok := store.GetInProcess(name)
+		if !ok {
+			return nil, fmt.Errorf("plugin %s not found", name)
+		}
+
+		capabilities = inProcessPlugin.Metadata().Capabilities
+		printer = inProcessPlugin
+	} else {
+		metadata, err := store.GetMetadata(name)
+		if err != nil {
+			return nil, err
+		}
+
+		capabilities = metadata.Capabilities
+	}
+
+	var supported []runtime.Object
+	var indexes []int
+	for i, object := range objects {
+		if capabilities.HasListColumnsSupport(object.GetObjectKind().GroupVersionKind()) {
+			supported = append(supported, object)
+			indexes = append(indexes, i)
+		}
+	}
+
+	if len(supported) == 0 {
+		return nil, nil
+	}
+
+	if printer == nil {
+		service, err := store.GetService(name)
+		if err != nil {
+			return nil, err
+		}
+
+		printer = service
+	}
+
+	resp, err := printer.PrintListColumns(ctx, supported)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp) != len(supported) {
+		return nil, fmt.Errorf("returned columns for %d objects; expected %d", len(resp), len(supported))
+	}
+
+	responses := make([]ListColumnsResponse, len(objects))
+	for i, index := range indexes {
+		responses[index] = resp[i]
+	}
+
+	return responses, nil
+}
+
+// RelatedObjectsRunner is a runner for related objects. Plugins which fail to find
+// related objects are logged and skipped, so one plugin can't fail the others.
+func RelatedObjectsRunner(store ManagerStore, ch chan<- RelatedObjectsResponse) DefaultRunner {
+	return DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			if IsInProcessPlugin(name) {
+				inProcessPlugin, ok := store.GetInProcess(name)
+				if !ok {
+					return fmt.Errorf("plugin %s not found", name)
+				}
+
+				if !inProcessPlugin.Metadata().Capabilities.HasRelatedObjectsSupport(gvk) {
+					return nil
+				}
+
+				resp, err := inProcessPlugin.RelatedObjects(ctx, object)
+				if err != nil {
+					log.From(ctx).With("plugin-name", name).WithErr(err).Errorf("finding related objects")
+					return nil
+				}
+
+				ch <- resp
+				return nil
+			}
+
+			metadata, err := store.GetMetadata(name)
+			if err != nil {
+				return err
+			}
+
+			if !metadata.Capabilities.HasRelatedObjectsSupport(gvk) {
+				return nil
+			}
+
+			service, err := store.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			resp, err := service.RelatedObjects(ctx, object)
+			if err != nil {
+				log.From(ctx).With("plugin-name", name).WithErr(err).Errorf("finding related objects")
+				return nil
+			}
+
+			ch <- resp
+			return nil
+		},
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	octantStore "github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -186,3 +187,164 @@ func Test_TabRunner(t *testing.T) {
 	ctx := context.Background()
 	require.NoError(t, runner.Run(ctx, object, clientNames))
 }
+
+func Test_ListColumnsRunner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	pod := testutil.CreatePod("pod")
+	deployment := testutil.CreateDeployment("deployment")
+	objects := []runtime.Object{deployment, pod}
+	clientNames := []string{"plugin1", "plugin2"}
+
+	plugin1Metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsListColumns: []schema.GroupVersionKind{gvk.Pod},
+		},
+	}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(plugin1Metadata, nil)
+
+	plugin2Metadata := &plugin.Metadata{}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin2")).Return(plugin2Metadata, nil)
+
+	store.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	lcr := plugin.ListColumnsResponse{
+		Columns: []plugin.ListColumn{
+			{Name: "Team", Value: component.NewText("platform")},
+		},
+	}
+
+	service.EXPECT().
+		PrintListColumns(gomock.Any(), gomock.Eq([]runtime.Object{pod})).
+		Return([]plugin.ListColumnsResponse{lcr}, nil)
+
+	ch := make(chan []plugin.ListColumnsResponse, len(clientNames))
+	runner := plugin.ListColumnsRunner(store, ch)
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, objects, clientNames))
+
+	require.Len(t, ch, 1)
+	assert.Equal(t, []plugin.ListColumnsResponse{{}, lcr}, <-ch)
+}
+
+func Test_ListColumnsRunner_plugin_failure(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	objects := []runtime.Object{testutil.CreatePod("pod")}
+
+	metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsListColumns: []schema.GroupVersionKind{gvk.Pod},
+		},
+	}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(metadata, nil)
+	store.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	service.EXPECT().
+		PrintListColumns(gomock.Any(), gomock.Eq(objects)).Return(nil, errors.New("failed"))
+
+	ch := make(chan []plugin.ListColumnsResponse, 1)
+	runner := plugin.ListColumnsRunner(store, ch)
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, objects, []string{"plugin1"}))
+	assert.Empty(t, ch)
+}
+
+func Test_RelatedObjectsRunner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	object := testutil.CreatePod("pod")
+	clientNames := []string{"plugin1", "plugin2"}
+
+	plugin1Metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsRelatedObjects: []schema.GroupVersionKind{gvk.Pod},
+		},
+	}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(plugin1Metadata, nil)
+
+	plugin2Metadata := &plugin.Metadata{}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin2")).Return(plugin2Metadata, nil)
+
+	store.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	ror := plugin.RelatedObjectsResponse{
+		Keys: []octantStore.Key{
+			{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"},
+		},
+	}
+
+	service.EXPECT().
+		RelatedObjects(gomock.Any(), gomock.Eq(object)).Return(ror, nil)
+
+	ch := make(chan plugin.RelatedObjectsResponse)
+	defer close(ch)
+
+	runner := plugin.RelatedObjectsRunner(store, ch)
+
+	done := make(chan bool)
+	go func() {
+		resp := <-ch
+		assert.Equal(t, ror, resp)
+		done <- true
+	}()
+
+	defer func() {
+		<-done
+	}()
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, object, clientNames))
+}
+
+func Test_RelatedObjectsRunner_plugin_failure(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	object := testutil.CreatePod("pod")
+
+	metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsRelatedObjects: []schema.GroupVersionKind{gvk.Pod},
+		},
+	}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(metadata, nil)
+	store.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	service.EXPECT().
+		RelatedObjects(gomock.Any(), gomock.Eq(object)).Return(plugin.RelatedObjectsResponse{}, errors.New("failed"))
+
+	ch := make(chan plugin.RelatedObjectsResponse, 1)
+	runner := plugin.RelatedObjectsRunner(store, ch)
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, object, []string{"plugin1"}))
+	assert.Empty(t, ch)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 //go:generate mockgen -destination=./fake/mock_dashboard.go -package=fake github.com/vmware-tanzu/octant/pkg/plugin/service Dashboard
@@ -21,11 +22,13 @@ type Dashboard interface {
 	CancelPortForward(ctx context.Context, id string)
 	ListNamespaces(ctx context.Context) (api.NamespacesResponse, error)
 	ForceFrontendUpdate(ctx context.Context) error
+	PushContent(ctx context.Context, contentPath string, response component.ContentResponse) error
+	EndContentPush(ctx context.Context, contentPath string)
 }
 
-// NewDashboardClient creates a dashboard client.
-func NewDashboardClient(dashboardAPIAddress string) (Dashboard, error) {
-	client, err := api.NewClient(dashboardAPIAddress)
+// NewDashboardClient creates a dashboard client. The token identifies the plugin to Octant.
+func NewDashboardClient(dashboardAPIAddress, dashboardAPIToken string) (Dashboard, error) {
+	client, err := api.NewClient(dashboardAPIAddress, api.WithToken(dashboardAPIToken))
 	if err != nil {
 		return nil, err
 	}
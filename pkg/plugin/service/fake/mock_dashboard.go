@@ -13,6 +13,7 @@ import (
 
 	api "github.com/vmware-tanzu/octant/pkg/plugin/api"
 	store "github.com/vmware-tanzu/octant/pkg/store"
+	component "github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 // MockDashboard is a mock of Dashboard interface
@@ -64,6 +65,18 @@ func (mr *MockDashboardMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDashboard)(nil).Close))
 }
 
+// EndContentPush mocks base method
+func (m *MockDashboard) EndContentPush(arg0 context.Context, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EndContentPush", arg0, arg1)
+}
+
+// EndContentPush indicates an expected call of EndContentPush
+func (mr *MockDashboardMockRecorder) EndContentPush(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndContentPush", reflect.TypeOf((*MockDashboard)(nil).EndContentPush), arg0, arg1)
+}
+
 // ForceFrontendUpdate mocks base method
 func (m *MockDashboard) ForceFrontendUpdate(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -138,6 +151,20 @@ func (mr *MockDashboardMockRecorder) PortForward(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockDashboard)(nil).PortForward), arg0, arg1)
 }
 
+// PushContent mocks base method
+func (m *MockDashboard) PushContent(arg0 context.Context, arg1 string, arg2 component.ContentResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushContent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushContent indicates an expected call of PushContent
+func (mr *MockDashboardMockRecorder) PushContent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushContent", reflect.TypeOf((*MockDashboard)(nil).PushContent), arg0, arg1, arg2)
+}
+
 // Update mocks base method
 func (m *MockDashboard) Update(arg0 context.Context, arg1 *unstructured.Unstructured) error {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"path"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -19,16 +20,18 @@ type Handler struct {
 
 	mu sync.Mutex
 
-	name         string
-	description  string
-	capabilities *plugin.Capabilities
+	name          string
+	description   string
+	capabilities  *plugin.Capabilities
+	configuration plugin.Configuration
 
-	dashboardFactory func(dashboardAPIAddress string) (Dashboard, error)
+	dashboardFactory func(dashboardAPIAddress, dashboardAPIToken string) (Dashboard, error)
 	dashboardClient  Dashboard
 	router           *Router
 }
 
 var _ plugin.Service = (*Handler)(nil)
+var _ plugin.RegistrationService = (*Handler)(nil)
 
 // Validate validates Handler.
 func (p *Handler) Validate() error {
@@ -41,15 +44,22 @@ func (p *Handler) Validate() error {
 
 // Register registers a plugin with Octant.
 func (p *Handler) Register(ctx context.Context, dashboardAPIAddress string) (plugin.Metadata, error) {
+	return p.RegisterWith(ctx, plugin.Registration{DashboardAPIAddress: dashboardAPIAddress})
+}
+
+// RegisterWith registers a plugin with Octant. The plugin identifies itself to the
+// dashboard API with the registration's token.
+func (p *Handler) RegisterWith(ctx context.Context, registration plugin.Registration) (plugin.Metadata, error) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	client, err := p.dashboardFactory(dashboardAPIAddress)
+	client, err := p.dashboardFactory(registration.DashboardAPIAddress, registration.DashboardAPIToken)
 	if err != nil {
 		return plugin.Metadata{}, errors.Wrap(err, "create api client")
 	}
 
 	p.dashboardClient = client
+	p.configuration = registration.Configuration
 
 	return plugin.Metadata{
 		Name:         p.name,
@@ -65,7 +75,7 @@ func (p *Handler) Print(ctx context.Context, object runtime.Object) (plugin.Prin
 	}
 
 	request := &PrintRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
 		DashboardClient: p.dashboardClient,
 		Object:          object,
 	}
@@ -80,7 +90,7 @@ func (p *Handler) PrintTab(ctx context.Context, object runtime.Object) (plugin.T
 	}
 
 	request := &PrintRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
 		DashboardClient: p.dashboardClient,
 		Object:          object,
 	}
@@ -88,6 +98,48 @@ func (p *Handler) PrintTab(ctx context.Context, object runtime.Object) (plugin.T
 	return p.HandlerFuncs.PrintTab(request)
 }
 
+// PrintListColumns prints list table columns for objects. The plugin's list
+// columns printer is called for each object.
+func (p *Handler) PrintListColumns(ctx context.Context, objects []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	responses := make([]plugin.ListColumnsResponse, len(objects))
+
+	if p.HandlerFuncs.PrintListColumns == nil {
+		return responses, nil
+	}
+
+	for i, object := range objects {
+		request := &PrintRequest{
+			baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
+			DashboardClient: p.dashboardClient,
+			Object:          object,
+		}
+
+		response, err := p.HandlerFuncs.PrintListColumns(request)
+		if err != nil {
+			return nil, err
+		}
+
+		responses[i] = response
+	}
+
+	return responses, nil
+}
+
+// RelatedObjects finds objects related to an object.
+func (p *Handler) RelatedObjects(ctx context.Context, object runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	if p.HandlerFuncs.RelatedObjects == nil {
+		return plugin.RelatedObjectsResponse{}, nil
+	}
+
+	request := &PrintRequest{
+		baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
+		DashboardClient: p.dashboardClient,
+		Object:          object,
+	}
+
+	return p.HandlerFuncs.RelatedObjects(request)
+}
+
 // ObjectStatus creates status for an object.
 func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plugin.ObjectStatusResponse, error) {
 	if p.HandlerFuncs.ObjectStatus == nil {
@@ -95,7 +147,7 @@ func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plug
 	}
 
 	request := &PrintRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
 		DashboardClient: p.dashboardClient,
 		Object:          object,
 	}
@@ -103,14 +155,19 @@ func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plug
 	return p.HandlerFuncs.ObjectStatus(request)
 }
 
-// HandleAction handles actions given a payload.
+// HandleAction handles actions given a payload. A RequestSetPluginConfiguration action
+// updates the plugin's configuration before it is passed to the action handler.
 func (p *Handler) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
+	if actionName == action.RequestSetPluginConfiguration {
+		p.setConfiguration(payload)
+	}
+
 	if p.HandlerFuncs.HandleAction == nil {
 		return nil
 	}
 
 	request := &ActionRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
 		DashboardClient: p.dashboardClient,
 		ActionName:      actionName,
 		Payload:         payload,
@@ -126,7 +183,7 @@ func (p *Handler) Navigation(ctx context.Context) (navigation.Navigation, error)
 	}
 
 	request := &NavigationRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
 		DashboardClient: p.dashboardClient,
 	}
 
@@ -141,10 +198,48 @@ func (p *Handler) Content(ctx context.Context, contentPath string) (component.Co
 	}
 
 	request := &request{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.currentConfiguration()),
 		dashboardClient: p.dashboardClient,
 		path:            contentPath,
 	}
 
 	return handlerFunc(request)
 }
+
+// registeredDashboard returns the dashboard client created when the plugin registered.
+func (p *Handler) registeredDashboard() (Dashboard, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.dashboardClient == nil {
+		return nil, errors.New("plugin is not registered with octant")
+	}
+
+	return p.dashboardClient, nil
+}
+
+// contentPath returns the content path for a path in the plugin's router.
+func (p *Handler) contentPath(routePath string) string {
+	return path.Join(p.name, routePath)
+}
+
+func (p *Handler) currentConfiguration() plugin.Configuration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.configuration
+}
+
+func (p *Handler) setConfiguration(payload action.Payload) {
+	configuration := plugin.Configuration{}
+	for k, v := range payload {
+		if s, ok := v.(string); ok {
+			configuration[k] = s
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.configuration = configuration
+}
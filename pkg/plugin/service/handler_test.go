@@ -23,7 +23,7 @@ func TestHandler_Register(t *testing.T) {
 	defer controller.Finish()
 
 	dashboard := fake.NewMockDashboard(controller)
-	factory := func(string) (Dashboard, error) {
+	factory := func(string, string) (Dashboard, error) {
 		return dashboard, nil
 	}
 
@@ -39,7 +39,12 @@ func TestHandler_Register(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	got, err := h.Register(ctx, "address")
+	configuration := plugin.Configuration{"endpoint": "https://example.com"}
+	got, err := h.RegisterWith(ctx, plugin.Registration{
+		DashboardAPIAddress: "address",
+		DashboardAPIToken:   "token",
+		Configuration:       configuration,
+	})
 	require.NoError(t, err)
 
 	expected := plugin.Metadata{
@@ -49,13 +54,14 @@ func TestHandler_Register(t *testing.T) {
 	}
 
 	require.Equal(t, expected, got)
+	require.Equal(t, configuration, h.currentConfiguration())
 }
 
 func TestHandler_Register_with_dashboard_factory_failure(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
-	factory := func(string) (Dashboard, error) {
+	factory := func(string, string) (Dashboard, error) {
 		return nil, errors.New("failure")
 	}
 
@@ -278,6 +284,38 @@ func TestHandler_HandleAction_using_supplied_function(t *testing.T) {
 	assert.True(t, ran)
 }
 
+func TestHandler_HandleAction_set_plugin_configuration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+
+	payload := action.Payload{"endpoint": "https://example.com"}
+	expected := plugin.Configuration{"endpoint": "https://example.com"}
+
+	ran := false
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+		configuration:   plugin.Configuration{"endpoint": "https://old.example.com"},
+		HandlerFuncs: HandlerFuncs{
+			HandleAction: func(r *ActionRequest) error {
+				ran = true
+				assert.Equal(t, action.RequestSetPluginConfiguration, r.ActionName)
+				assert.Equal(t, expected, r.Configuration())
+
+				return nil
+			},
+		},
+	}
+
+	ctx := context.Background()
+	err := h.HandleAction(ctx, action.RequestSetPluginConfiguration, payload)
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, expected, h.currentConfiguration())
+}
+
 func TestHandler_Navigation_default(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gobwas/glob"
 
+	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -13,6 +14,7 @@ type HandleFunc func(request Request) (component.ContentResponse, error)
 
 type Request interface {
 	Context() context.Context
+	Configuration() plugin.Configuration
 	DashboardClient() Dashboard
 	Path() string
 }
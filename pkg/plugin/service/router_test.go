@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -82,7 +83,7 @@ func TestRouter_Match(t *testing.T) {
 			require.True(t, ok)
 
 			request := &request{
-				baseRequest:     newBaseRequest(context.Background(), "plugin-name"),
+				baseRequest:     newBaseRequest(context.Background(), "plugin-name", plugin.Configuration{}),
 				dashboardClient: nil,
 				path:            test.path,
 			}
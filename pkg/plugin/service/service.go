@@ -16,6 +16,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 func defaultServerFactory(service plugin.Service) {
@@ -39,6 +40,20 @@ func WithTabPrinter(fn HandlerTabPrintFunc) PluginOption {
 	}
 }
 
+// WithListColumnsPrinter configures the plugin to add columns to list tables.
+func WithListColumnsPrinter(fn HandlerListColumnsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.HandlerFuncs.PrintListColumns = fn
+	}
+}
+
+// WithRelatedObjects configures the plugin to find objects related to an object.
+func WithRelatedObjects(fn HandlerRelatedObjectsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.HandlerFuncs.RelatedObjects = fn
+	}
+}
+
 // WithObjectStatus configures the plugin to supply object status.
 func WithObjectStatus(fn HandlerObjectStatusFunc) PluginOption {
 	return func(p *Plugin) {
@@ -61,6 +76,15 @@ func WithNavigation(fn HandlerNavigationFunc, routerInit HandlerInitRoutesFunc)
 	}
 }
 
+// WithDashboardFactory configures how the plugin creates its dashboard client. The default
+// connects to the dashboard API address Octant sends when the plugin registers, and
+// identifies the plugin with the token Octant sends.
+func WithDashboardFactory(fn func(dashboardAPIAddress, dashboardAPIToken string) (Dashboard, error)) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.dashboardFactory = fn
+	}
+}
+
 // Plugin is a plugin service helper.
 type Plugin struct {
 	pluginHandler *Handler
@@ -124,20 +148,53 @@ func (p *Plugin) Validate() error {
 	return errors.Errorf("validation errors: %s", strings.Join(list, ", "))
 }
 
+// Service returns the service Octant calls when the plugin is served. It can be used
+// to run the plugin in process.
+func (p *Plugin) Service() plugin.ModuleService {
+	return p.pluginHandler
+}
+
+// PushContent pushes content for one of the plugin's content paths to the clients
+// viewing it. contentPath is relative to the plugin, like paths in the plugin's router.
+// Octant uses pushed content instead of calling the router until EndContentPush is
+// called. The plugin must be registered with Octant.
+func (p *Plugin) PushContent(ctx context.Context, contentPath string, response component.ContentResponse) error {
+	dashboardClient, err := p.pluginHandler.registeredDashboard()
+	if err != nil {
+		return err
+	}
+
+	return dashboardClient.PushContent(ctx, p.pluginHandler.contentPath(contentPath), response)
+}
+
+// EndContentPush ends pushed content for one of the plugin's content paths. Octant
+// goes back to calling the router for the path's content.
+func (p *Plugin) EndContentPush(ctx context.Context, contentPath string) error {
+	dashboardClient, err := p.pluginHandler.registeredDashboard()
+	if err != nil {
+		return err
+	}
+
+	dashboardClient.EndContentPush(ctx, p.pluginHandler.contentPath(contentPath))
+	return nil
+}
+
 // Serve serves a plugin.
 func (p *Plugin) Serve() {
 	p.serverFactory(p.pluginHandler)
 }
 
 type baseRequest struct {
-	ctx        context.Context
-	pluginName string
+	ctx           context.Context
+	pluginName    string
+	configuration plugin.Configuration
 }
 
-func newBaseRequest(ctx context.Context, pluginName string) baseRequest {
+func newBaseRequest(ctx context.Context, pluginName string, configuration plugin.Configuration) baseRequest {
 	return baseRequest{
-		ctx:        ctx,
-		pluginName: pluginName,
+		ctx:           ctx,
+		pluginName:    pluginName,
+		configuration: configuration,
 	}
 }
 
@@ -145,6 +202,11 @@ func (r *baseRequest) Context() context.Context {
 	return r.ctx
 }
 
+// Configuration returns the plugin's configuration.
+func (r *baseRequest) Configuration() plugin.Configuration {
+	return r.configuration
+}
+
 func (r *baseRequest) GeneratePath(pathParts ...string) string {
 	return path.Join(append([]string{r.pluginName}, pathParts...)...)
 }
@@ -175,6 +237,8 @@ type NavigationRequest struct {
 
 type HandlerPrinterFunc func(request *PrintRequest) (plugin.PrintResponse, error)
 type HandlerTabPrintFunc func(request *PrintRequest) (plugin.TabResponse, error)
+type HandlerListColumnsFunc func(request *PrintRequest) (plugin.ListColumnsResponse, error)
+type HandlerRelatedObjectsFunc func(request *PrintRequest) (plugin.RelatedObjectsResponse, error)
 type HandlerObjectStatusFunc func(request *PrintRequest) (plugin.ObjectStatusResponse, error)
 type HandlerActionFunc func(request *ActionRequest) error
 type HandlerNavigationFunc func(request *NavigationRequest) (navigation.Navigation, error)
@@ -182,10 +246,12 @@ type HandlerInitRoutesFunc func(router *Router)
 
 // HandlerFuncs are functions for configuring a plugin.
 type HandlerFuncs struct {
-	Print        HandlerPrinterFunc
-	PrintTab     HandlerTabPrintFunc
-	ObjectStatus HandlerObjectStatusFunc
-	HandleAction HandlerActionFunc
-	Navigation   HandlerNavigationFunc
-	InitRoutes   HandlerInitRoutesFunc
+	Print            HandlerPrinterFunc
+	PrintTab         HandlerTabPrintFunc
+	PrintListColumns HandlerListColumnsFunc
+	RelatedObjects   HandlerRelatedObjectsFunc
+	ObjectStatus     HandlerObjectStatusFunc
+	HandleAction     HandlerActionFunc
+	Navigation       HandlerNavigationFunc
+	InitRoutes       HandlerInitRoutesFunc
 }
@@ -1,12 +1,16 @@
 package service
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/service/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 func TestNewPlugin(t *testing.T) {
@@ -101,3 +105,29 @@ func TestPlugin_Serve(t *testing.T) {
 
 	assert.True(t, ran)
 }
+
+func TestPlugin_PushContent(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+	response := component.NewContentResponse(component.TitleFromString("Pushed"))
+
+	dashboardClient := fake.NewMockDashboard(controller)
+	dashboardClient.EXPECT().PushContent(ctx, "plugin-name/status", *response).Return(nil)
+	dashboardClient.EXPECT().EndContentPush(ctx, "plugin-name/status")
+
+	p, err := Register("plugin-name", "description", &plugin.Capabilities{},
+		WithDashboardFactory(func(string, string) (Dashboard, error) {
+			return dashboardClient, nil
+		}))
+	require.NoError(t, err)
+
+	require.EqualError(t, p.PushContent(ctx, "/status", *response), "plugin is not registered with octant")
+
+	_, err = p.Service().Register(ctx, "address")
+	require.NoError(t, err)
+
+	require.NoError(t, p.PushContent(ctx, "/status", *response))
+	require.NoError(t, p.EndContentPush(ctx, "/status"))
+}
@@ -0,0 +1,518 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	olog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// wasmHostModule is the name of the module that contains the host functions.
+	wasmHostModule = "octant"
+	// wasmMallocExport is the name of the function plugins export to allocate memory
+	// for the host. It takes a size and returns a pointer.
+	wasmMallocExport = "octant_malloc"
+	// wasmFreeExport is the name of the optional function plugins export to free memory
+	// allocated with octant_malloc. It takes a pointer and a size.
+	wasmFreeExport = "octant_free"
+	// wasmCallExport is the name of the function plugins export to handle calls from the
+	// host. It takes the method name and the JSON request as pointer and length pairs, and
+	// returns the JSON response as a pointer and length packed into a 64 bit integer.
+	wasmCallExport = "octant_call"
+)
+
+// IsWASMPlugin returns true if the plugin is a WebAssembly plugin.
+func IsWASMPlugin(pluginName string) bool {
+	return path.Ext(pluginName) == ".wasm"
+}
+
+// wasmModule is an instantiated WebAssembly plugin.
+type wasmModule interface {
+	// Call calls a plugin method with a JSON request and returns the JSON response.
+	Call(ctx context.Context, method string, request []byte) ([]byte, error)
+	// Close closes the module.
+	Close(ctx context.Context) error
+}
+
+type wasmModuleFactory func(ctx context.Context, pluginPath string, host *wasmHost) (wasmModule, error)
+
+// wasmEnvelope is the envelope for responses from plugins and from host functions.
+type wasmEnvelope struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// wasmMetadata is the metadata a plugin returns when it registers. It uses the same
+// names as JavaScript plugin metadata.
+type wasmMetadata struct {
+	Name         string           `json:"name"`
+	Description  string           `json:"description"`
+	IsModule     bool             `json:"isModule"`
+	Capabilities wasmCapabilities `json:"capabilities"`
+}
+
+type wasmCapabilities struct {
+	SupportPrinterConfig    []schema.GroupVersionKind `json:"supportPrinterConfig,omitempty"`
+	SupportPrinterStatus    []schema.GroupVersionKind `json:"supportPrinterStatus,omitempty"`
+	SupportPrinterItems     []schema.GroupVersionKind `json:"supportPrinterItems,omitempty"`
+	SupportObjectStatus     []schema.GroupVersionKind `json:"supportObjectStatus,omitempty"`
+	SupportTab              []schema.GroupVersionKind `json:"supportTab,omitempty"`
+	SupportListColumns      []schema.GroupVersionKind `json:"supportListColumns,omitempty"`
+	SupportRelatedObjects   []schema.GroupVersionKind `json:"supportRelatedObjects,omitempty"`
+	ActionNames             []string                  `json:"actionNames,omitempty"`
+	ObjectActions           []ObjectAction            `json:"objectActions,omitempty"`
+	NavigationContributions []navigation.Contribution `json:"navigationContributions,omitempty"`
+}
+
+func (m wasmMetadata) toMetadata() (*Metadata, error) {
+	if m.Name == "" {
+		return nil, fmt.Errorf("name is a required property")
+	}
+
+	if m.Description == "" {
+		return nil, fmt.Errorf("description is a required property")
+	}
+
+	return &Metadata{
+		Name:        m.Name,
+		Description: m.Description,
+		Capabilities: Capabilities{
+			SupportsPrinterConfig:   m.Capabilities.SupportPrinterConfig,
+			SupportsPrinterStatus:   m.Capabilities.SupportPrinterStatus,
+			SupportsPrinterItems:    m.Capabilities.SupportPrinterItems,
+			SupportsObjectStatus:    m.Capabilities.SupportObjectStatus,
+			SupportsTab:             m.Capabilities.SupportTab,
+			SupportsListColumns:     m.Capabilities.SupportListColumns,
+			SupportsRelatedObjects:  m.Capabilities.SupportRelatedObjects,
+			ActionNames:             m.Capabilities.ActionNames,
+			ObjectActions:           m.Capabilities.ObjectActions,
+			NavigationContributions: m.Capabilities.NavigationContributions,
+			IsModule:                m.IsModule,
+		},
+	}, nil
+}
+
+type wasmPrintResponse struct {
+	Config []component.SummarySection `json:"config,omitempty"`
+	Status []component.SummarySection `json:"status,omitempty"`
+	Items  []component.FlexLayoutItem `json:"items,omitempty"`
+}
+
+type wasmTabResponse struct {
+	Name     string                 `json:"name"`
+	Contents *component.TypedObject `json:"contents"`
+}
+
+type wasmListColumn struct {
+	Name  string                 `json:"name"`
+	Value *component.TypedObject `json:"value"`
+}
+
+type wasmListColumnsResponse struct {
+	Columns []wasmListColumn `json:"columns"`
+}
+
+type wasmObjectStatusResponse struct {
+	ObjectStatus component.PodSummary `json:"objectStatus"`
+}
+
+type wasmRelatedObjectsResponse struct {
+	Keys []store.Key `json:"keys"`
+}
+
+// WASMPlugin represents a WebAssembly plugin.
+type WASMPlugin interface {
+	InProcessPlugin
+}
+
+type wasmPlugin struct {
+	module   wasmModule
+	metadata *Metadata
+
+	pluginPath string
+
+	mu     sync.Mutex
+	ctx    context.Context
+	logger log.Logger
+}
+
+var _ WASMPlugin = (*wasmPlugin)(nil)
+
+// NewWASMPlugin creates an instance of a WebAssembly plugin. The plugin is registered
+// with its configuration and dashboard API token when it is created.
+func NewWASMPlugin(ctx context.Context, objectStore store.Store, pluginPath string, registration Registration, wmf wasmModuleFactory) (*wasmPlugin, error) {
+	logger := olog.From(ctx).With("plugin", pluginPath)
+
+	host := &wasmHost{
+		objectStore: objectStore,
+		logger:      logger,
+	}
+
+	module, err := wmf(ctx, pluginPath, host)
+	if err != nil {
+		return nil, fmt.Errorf("initializing runtime: %w", err)
+	}
+
+	p := &wasmPlugin{
+		module:     module,
+		pluginPath: pluginPath,
+		ctx:        ctx,
+		logger:     logger,
+	}
+
+	metadata, err := p.RegisterWith(ctx, registration)
+	if err != nil {
+		_ = module.Close(ctx)
+		return nil, err
+	}
+
+	p.metadata = &metadata
+
+	return p, nil
+}
+
+// Close closes the plugin's module.
+func (p *wasmPlugin) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.module.Close(p.ctx); err != nil {
+		p.logger.WithErr(err).Errorf("closing WebAssembly plugin")
+	}
+}
+
+// PluginPath returns the pluginPath.
+func (p *wasmPlugin) PluginPath() string {
+	return p.pluginPath
+}
+
+// Metadata returns the metadata the plugin registered with.
+func (p *wasmPlugin) Metadata() *Metadata {
+	return p.metadata
+}
+
+// Register registers the plugin.
+func (p *wasmPlugin) Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error) {
+	return p.RegisterWith(ctx, Registration{DashboardAPIAddress: dashboardAPIAddress})
+}
+
+// RegisterWith registers the plugin with its configuration and dashboard API token.
+func (p *wasmPlugin) RegisterWith(ctx context.Context, registration Registration) (Metadata, error) {
+	request := map[string]interface{}{
+		"dashboardAPIAddress": registration.DashboardAPIAddress,
+		"dashboardAPIToken":   registration.DashboardAPIToken,
+		"configuration":       registration.Configuration,
+	}
+
+	var response wasmMetadata
+	if err := p.call(ctx, "register", request, &response); err != nil {
+		return Metadata{}, err
+	}
+
+	metadata, err := response.toMetadata()
+	if err != nil {
+		return Metadata{}, fmt.Errorf("loading metadata: %w", err)
+	}
+
+	return *metadata, nil
+}
+
+func (p *wasmPlugin) Navigation(ctx context.Context) (navigation.Navigation, error) {
+	var nav navigation.Navigation
+	if err := p.call(ctx, "navigation", map[string]interface{}{}, &nav); err != nil {
+		return navigation.Navigation{}, err
+	}
+
+	return nav, nil
+}
+
+func (p *wasmPlugin) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
+	request := map[string]interface{}{
+		"contentPath": contentPath,
+	}
+
+	var cr component.ContentResponse
+	if err := p.call(ctx, "content", request, &cr); err != nil {
+		return component.ContentResponse{}, err
+	}
+
+	return cr, nil
+}
+
+func (p *wasmPlugin) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
+	var response wasmPrintResponse
+	if err := p.call(ctx, "print", objectRequest(object), &response); err != nil {
+		return PrintResponse{}, err
+	}
+
+	return PrintResponse{
+		Config: response.Config,
+		Status: response.Status,
+		Items:  response.Items,
+	}, nil
+}
+
+func (p *wasmPlugin) PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error) {
+	var response wasmTabResponse
+	if err := p.call(ctx, "printTab", objectRequest(object), &response); err != nil {
+		return TabResponse{}, err
+	}
+
+	if response.Contents == nil {
+		return TabResponse{}, fmt.Errorf("tab contents not found")
+	}
+
+	c, err := response.Contents.ToComponent()
+	if err != nil {
+		return TabResponse{}, fmt.Errorf("unable to extract tab contents: %w", err)
+	}
+
+	layout, ok := c.(*component.FlexLayout)
+	if !ok {
+		return TabResponse{}, fmt.Errorf("expected tab contents to be flex layout was: %T", c)
+	}
+
+	return TabResponse{
+		Tab: &component.Tab{
+			Name:     response.Name,
+			Contents: *layout,
+		},
+	}, nil
+}
+
+func (p *wasmPlugin) PrintListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error) {
+	return printListColumnsForEach(ctx, objects, p.printListColumns)
+}
+
+func (p *wasmPlugin) printListColumns(ctx context.Context, object runtime.Object) (ListColumnsResponse, error) {
+	var response wasmListColumnsResponse
+	if err := p.call(ctx, "listColumns", objectRequest(object), &response); err != nil {
+		return ListColumnsResponse{}, err
+	}
+
+	var lcr ListColumnsResponse
+	for i, column := range response.Columns {
+		if column.Name == "" {
+			return ListColumnsResponse{}, fmt.Errorf("column in position %d requires a name", i)
+		}
+
+		if column.Value == nil {
+			return ListColumnsResponse{}, fmt.Errorf("column %q requires a value", column.Name)
+		}
+
+		value, err := column.Value.ToComponent()
+		if err != nil {
+			return ListColumnsResponse{}, fmt.Errorf("unable to extract component: %w", err)
+		}
+
+		lcr.Columns = append(lcr.Columns, ListColumn{
+			Name:  column.Name,
+			Value: value,
+		})
+	}
+
+	return lcr, nil
+}
+
+func (p *wasmPlugin) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	var response wasmRelatedObjectsResponse
+	if err := p.call(ctx, "relatedObjects", objectRequest(object), &response); err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	return RelatedObjectsResponse{Keys: response.Keys}, nil
+}
+
+func (p *wasmPlugin) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+	var response wasmObjectStatusResponse
+	if err := p.call(ctx, "objectStatus", objectRequest(object), &response); err != nil {
+		return ObjectStatusResponse{}, err
+	}
+
+	return ObjectStatusResponse{ObjectStatus: response.ObjectStatus}, nil
+}
+
+func (p *wasmPlugin) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
+	request := map[string]interface{}{
+		"actionName": actionName,
+		"payload":    payload,
+	}
+
+	return p.call(ctx, "handleAction", request, nil)
+}
+
+// call calls a plugin method. Calls are serialized because modules are single threaded.
+func (p *wasmPlugin) call(ctx context.Context, method string, request interface{}, response interface{}) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("marshal %s request: %w", method, err)
+	}
+
+	out, err := p.module.Call(ctx, method, data)
+	if err != nil {
+		return fmt.Errorf("calling %s: %w", method, err)
+	}
+
+	var envelope wasmEnvelope
+	if err := json.Unmarshal(out, &envelope); err != nil {
+		return fmt.Errorf("unmarshal %s response: %w", method, err)
+	}
+
+	if envelope.Error != "" {
+		return fmt.Errorf("%s %s: %s", p.pluginPath, method, envelope.Error)
+	}
+
+	if response == nil || len(envelope.Result) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(envelope.Result, response); err != nil {
+		return fmt.Errorf("unmarshal %s result: %w", method, err)
+	}
+
+	return nil
+}
+
+func objectRequest(object runtime.Object) map[string]interface{} {
+	return map[string]interface{}{
+		"object": object,
+	}
+}
+
+// wasmHost implements the host functions plugins can call.
+type wasmHost struct {
+	objectStore store.Store
+	logger      log.Logger
+}
+
+type wasmDashboardRequest struct {
+	Key       store.Key `json:"key"`
+	Namespace string    `json:"namespace"`
+	YAML      string    `json:"yaml"`
+}
+
+// dashboardRequest handles a dashboard client request from a plugin and returns a JSON
+// envelope with the result. It supports the same methods as the JavaScript dashboard client.
+func (h *wasmHost) dashboardRequest(ctx context.Context, method string, data []byte) []byte {
+	result, err := h.handleDashboardRequest(ctx, method, data)
+
+	envelope := wasmEnvelope{}
+	if err != nil {
+		envelope.Error = err.Error()
+	} else if result != nil {
+		raw, err := json.Marshal(result)
+		if err != nil {
+			envelope.Error = fmt.Sprintf("marshal result: %s", err)
+		} else {
+			envelope.Result = raw
+		}
+	}
+
+	out, err := json.Marshal(envelope)
+	if err != nil {
+		return []byte(`{"error":"unable to marshal response"}`)
+	}
+
+	return out
+}
+
+func (h *wasmHost) handleDashboardRequest(ctx context.Context, method string, data []byte) (interface{}, error) {
+	if h.objectStore == nil {
+		return nil, fmt.Errorf("object store is not available")
+	}
+
+	var request wasmDashboardRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("unmarshal %s request: %w", method, err)
+	}
+
+	switch method {
+	case "Get":
+		u, err := h.objectStore.Get(ctx, request.Key)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			return nil, nil
+		}
+		return u.Object, nil
+	case "List":
+		u, _, err := h.objectStore.List(ctx, request.Key)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]interface{}, len(u.Items))
+		for i := range u.Items {
+			items[i] = u.Items[i].Object
+		}
+		return items, nil
+	case "Create", "Update":
+		if request.Namespace == "" {
+			return nil, fmt.Errorf("create/update: invalid namespace")
+		}
+		if request.YAML == "" {
+			return nil, fmt.Errorf("create/update: empty yaml")
+		}
+		results, err := h.objectStore.CreateOrUpdateFromYAML(ctx, request.Namespace, request.YAML)
+		if err != nil {
+			return nil, fmt.Errorf("create/update: %w", err)
+		}
+		return results, nil
+	case "Delete":
+		if err := h.objectStore.Delete(ctx, request.Key); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown dashboard method %q", method)
+	}
+}
+
+// log logs a message from a plugin. Levels are 0 for debug, 1 for info, 2 for warning,
+// and 3 for error.
+func (h *wasmHost) log(level uint32, message string) {
+	switch level {
+	case 0:
+		h.logger.Debugf("%s", message)
+	case 2:
+		h.logger.Warnf("%s", message)
+	case 3:
+		h.logger.Errorf("%s", message)
+	default:
+		h.logger.Infof("%s", message)
+	}
+}
+
+// wasmLogWriter writes a module's standard output and error to the log.
+type wasmLogWriter struct {
+	host  *wasmHost
+	level uint32
+}
+
+func (w wasmLogWriter) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		w.host.log(w.level, line)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,23 @@
+//go:build !wasm_plugins
+// +build !wasm_plugins
+
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+)
+
+// wasmPluginsEnabled is false because Octant was built without the WebAssembly runtime.
+const wasmPluginsEnabled = false
+
+// CreateWASMModule returns an error because Octant was built without the WebAssembly
+// runtime. Build with the wasm_plugins tag to run WebAssembly plugins.
+func CreateWASMModule(_ context.Context, pluginPath string, _ *wasmHost) (wasmModule, error) {
+	return nil, fmt.Errorf("%s: WebAssembly plugins require Octant to be built with the wasm_plugins tag", pluginPath)
+}
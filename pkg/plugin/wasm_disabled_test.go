@@ -0,0 +1,21 @@
+//go:build !wasm_plugins
+// +build !wasm_plugins
+
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWASMModule_disabled(t *testing.T) {
+	_, err := CreateWASMModule(context.Background(), "plugin.wasm", &wasmHost{})
+	require.EqualError(t, err, "plugin.wasm: WebAssembly plugins require Octant to be built with the wasm_plugins tag")
+}
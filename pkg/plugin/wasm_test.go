@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestIsInProcessPlugin(t *testing.T) {
+	assert.True(t, IsInProcessPlugin("/plugins/plugin.js"))
+	assert.True(t, IsInProcessPlugin("/plugins/plugin.wasm"))
+	assert.False(t, IsInProcessPlugin("/plugins/plugin"))
+	assert.True(t, IsWASMPlugin("plugin.wasm"))
+	assert.False(t, IsWASMPlugin("plugin.js"))
+}
+
+func TestWASMPlugin(t *testing.T) {
+	module := &fakeWASMModule{
+		responses: map[string]string{
+			"register": `{"result":{"name":"wasm","description":"wasm plugin","isModule":true,
+"capabilities":{"supportTab":[{"version":"v1","kind":"Pod"}],"actionNames":["wasm/action"],
+"navigationContributions":[{"module":"overview","section":"Workloads","title":"Traces","path":"traces"}]}}}`,
+			"content":        `{"result":{"title":[{"metadata":{"type":"text"},"config":{"value":"Title"}}],"viewComponents":[{"metadata":{"type":"text"},"config":{"value":"content"}}]}}`,
+			"printTab":       `{"result":{"name":"WASM","contents":{"metadata":{"type":"flexlayout"},"config":{"sections":[]}}}}`,
+			"listColumns":    `{"result":{"columns":[{"name":"Column","value":{"metadata":{"type":"text"},"config":{"value":"value"}}}]}}`,
+			"relatedObjects": `{"result":{"keys":[{"namespace":"default","apiVersion":"v1","kind":"Secret","name":"secret"}]}}`,
+			"print":          `{"error":"print failed"}`,
+			"handleAction":   `{}`,
+		},
+	}
+
+	registration := Registration{
+		DashboardAPIAddress: "127.0.0.1:12345",
+		DashboardAPIToken:   "token",
+		Configuration:       Configuration{"key": "value"},
+	}
+
+	p, err := NewWASMPlugin(context.Background(), nil, "/plugins/plugin.wasm", registration, module.factory)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	expectedMetadata := &Metadata{
+		Name:        "wasm",
+		Description: "wasm plugin",
+		Capabilities: Capabilities{
+			SupportsTab: []schema.GroupVersionKind{{Version: "v1", Kind: "Pod"}},
+			ActionNames: []string{"wasm/action"},
+			NavigationContributions: []navigation.Contribution{
+				{Module: "overview", Section: "Workloads", Title: "Traces", Path: "traces"},
+			},
+			IsModule: true,
+		},
+	}
+	assert.Equal(t, expectedMetadata, p.Metadata())
+	assert.JSONEq(t, `{"dashboardAPIAddress":"127.0.0.1:12345","dashboardAPIToken":"token","configuration":{"key":"value"}}`, module.requests["register"])
+
+	content, err := p.Content(ctx, "/path")
+	require.NoError(t, err)
+	assert.Equal(t, []component.Component{component.NewText("content")}, content.Components)
+	assert.JSONEq(t, `{"contentPath":"/path"}`, module.requests["content"])
+
+	pod := testutil.CreatePod("pod")
+
+	tab, err := p.PrintTab(ctx, pod)
+	require.NoError(t, err)
+	assert.Equal(t, "WASM", tab.Tab.Name)
+
+	columns, err := p.PrintListColumns(ctx, []runtime.Object{pod})
+	require.NoError(t, err)
+	require.Len(t, columns, 1)
+	assert.Equal(t, []ListColumn{{Name: "Column", Value: component.NewText("value")}}, columns[0].Columns)
+
+	related, err := p.RelatedObjects(ctx, pod)
+	require.NoError(t, err)
+	assert.Equal(t, []store.Key{{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"}}, related.Keys)
+
+	_, err = p.Print(ctx, pod)
+	require.EqualError(t, err, "/plugins/plugin.wasm print: print failed")
+
+	require.NoError(t, p.HandleAction(ctx, "wasm/action", action.Payload{"foo": "bar"}))
+	assert.JSONEq(t, `{"actionName":"wasm/action","payload":{"foo":"bar"}}`, module.requests["handleAction"])
+
+	p.Close()
+	assert.True(t, module.closed)
+}
+
+func TestWASMPlugin_invalidMetadata(t *testing.T) {
+	module := &fakeWASMModule{
+		responses: map[string]string{
+			"register": `{"result":{"description":"missing name"}}`,
+		},
+	}
+
+	_, err := NewWASMPlugin(context.Background(), nil, "plugin.wasm", Registration{}, module.factory)
+	require.EqualError(t, err, "loading metadata: name is a required property")
+	assert.True(t, module.closed)
+}
+
+func TestWASMHost_dashboardRequest(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(pod, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*pod}}, false, nil)
+	objectStore.EXPECT().Delete(gomock.Any(), key).Return(fmt.Errorf("delete failed"))
+
+	host := &wasmHost{objectStore: objectStore, logger: log.NopLogger()}
+	ctx := context.Background()
+
+	var got wasmEnvelope
+	require.NoError(t, json.Unmarshal(host.dashboardRequest(ctx, "Get",
+		[]byte(`{"key":{"namespace":"default","apiVersion":"v1","kind":"Pod","name":"pod"}}`)), &got))
+	require.Empty(t, got.Error)
+	var object map[string]interface{}
+	require.NoError(t, json.Unmarshal(got.Result, &object))
+	assert.Equal(t, "pod", object["metadata"].(map[string]interface{})["name"])
+
+	got = wasmEnvelope{}
+	require.NoError(t, json.Unmarshal(host.dashboardRequest(ctx, "List",
+		[]byte(`{"key":{"namespace":"default","apiVersion":"v1","kind":"Pod"}}`)), &got))
+	var items []interface{}
+	require.NoError(t, json.Unmarshal(got.Result, &items))
+	assert.Len(t, items, 1)
+
+	got = wasmEnvelope{}
+	require.NoError(t, json.Unmarshal(host.dashboardRequest(ctx, "Delete",
+		[]byte(`{"key":{"namespace":"default","apiVersion":"v1","kind":"Pod","name":"pod"}}`)), &got))
+	assert.Equal(t, "delete failed", got.Error)
+
+	got = wasmEnvelope{}
+	require.NoError(t, json.Unmarshal(host.dashboardRequest(ctx, "Patch", []byte(`{}`)), &got))
+	assert.Equal(t, `unknown dashboard method "Patch"`, got.Error)
+}
+
+type fakeWASMModule struct {
+	responses map[string]string
+	requests  map[string]string
+	closed    bool
+}
+
+func (m *fakeWASMModule) factory(_ context.Context, _ string, _ *wasmHost) (wasmModule, error) {
+	m.requests = map[string]string{}
+	return m, nil
+}
+
+func (m *fakeWASMModule) Call(_ context.Context, method string, request []byte) ([]byte, error) {
+	m.requests[method] = string(request)
+
+	response, ok := m.responses[method]
+	if !ok {
+		return nil, fmt.Errorf("unexpected method %s", method)
+	}
+	return []byte(response), nil
+}
+
+func (m *fakeWASMModule) Close(_ context.Context) error {
+	m.closed = true
+	return nil
+}
@@ -0,0 +1,231 @@
+//go:build wasm_plugins
+// +build wasm_plugins
+
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// wasmPluginsEnabled is true because Octant was built with the WebAssembly runtime.
+const wasmPluginsEnabled = true
+
+// wazeroModule is a plugin module running in wazero.
+type wazeroModule struct {
+	runtime wazero.Runtime
+	module  api.Module
+	malloc  api.Function
+	free    api.Function
+	call    api.Function
+}
+
+var _ wasmModule = (*wazeroModule)(nil)
+
+// CreateWASMModule compiles and instantiates a WebAssembly plugin. Plugins can use WASI,
+// but they do not have access to the file system or network. The octant host module
+// provides access to the dashboard client and the log.
+func CreateWASMModule(ctx context.Context, pluginPath string, host *wasmHost) (wasmModule, error) {
+	source, err := ioutil.ReadFile(pluginPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading module: %w", err)
+	}
+
+	return createWazeroModule(ctx, path.Base(pluginPath), source, host)
+}
+
+func createWazeroModule(ctx context.Context, name string, source []byte, host *wasmHost) (*wazeroModule, error) {
+	r := wazero.NewRuntime(ctx)
+
+	closeOnErr := func(err error) (*wazeroModule, error) {
+		_ = r.Close(ctx)
+		return nil, err
+	}
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		return closeOnErr(fmt.Errorf("instantiate wasi: %w", err))
+	}
+
+	i32 := api.ValueTypeI32
+	i64 := api.ValueTypeI64
+
+	_, err := r.NewHostModuleBuilder(wasmHostModule).
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(func(ctx context.Context, mod api.Module, stack []uint64) {
+			method, ok := readGuestString(ctx, mod, uint32(stack[0]), uint32(stack[1]))
+			if !ok {
+				stack[0] = 0
+				return
+			}
+			request, ok := readGuestBytes(ctx, mod, uint32(stack[2]), uint32(stack[3]))
+			if !ok {
+				stack[0] = 0
+				return
+			}
+
+			response := host.dashboardRequest(ctx, method, request)
+			packed, err := writeGuestBytes(ctx, mod, response)
+			if err != nil {
+				host.logger.WithErr(err).Errorf("writing dashboard response for %s", method)
+				stack[0] = 0
+				return
+			}
+			stack[0] = packed
+		}), []api.ValueType{i32, i32, i32, i32}, []api.ValueType{i64}).
+		WithParameterNames("method_ptr", "method_len", "request_ptr", "request_len").
+		Export("dashboard_request").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(func(ctx context.Context, mod api.Module, stack []uint64) {
+			message, ok := readGuestString(ctx, mod, uint32(stack[1]), uint32(stack[2]))
+			if !ok {
+				return
+			}
+			host.log(uint32(stack[0]), message)
+		}), []api.ValueType{i32, i32, i32}, []api.ValueType{}).
+		WithParameterNames("level", "message_ptr", "message_len").
+		Export("log").
+		Instantiate(ctx, r)
+	if err != nil {
+		return closeOnErr(fmt.Errorf("instantiate host module: %w", err))
+	}
+
+	compiled, err := r.CompileModule(ctx, source)
+	if err != nil {
+		return closeOnErr(fmt.Errorf("compiling: %w", err))
+	}
+
+	config := wazero.NewModuleConfig().
+		WithName(name).
+		WithStdout(wasmLogWriter{host: host, level: 1}).
+		WithStderr(wasmLogWriter{host: host, level: 3}).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithStartFunctions("_initialize", "_start")
+
+	module, err := r.InstantiateModule(ctx, compiled, config)
+	if err != nil {
+		return closeOnErr(fmt.Errorf("instantiating: %w", err))
+	}
+
+	m := &wazeroModule{
+		runtime: r,
+		module:  module,
+		malloc:  module.ExportedFunction(wasmMallocExport),
+		free:    module.ExportedFunction(wasmFreeExport),
+		call:    module.ExportedFunction(wasmCallExport),
+	}
+
+	if m.malloc == nil {
+		return closeOnErr(fmt.Errorf("module does not export %s", wasmMallocExport))
+	}
+
+	if m.call == nil {
+		return closeOnErr(fmt.Errorf("module does not export %s", wasmCallExport))
+	}
+
+	if module.Memory() == nil {
+		return closeOnErr(fmt.Errorf("module does not export memory"))
+	}
+
+	return m, nil
+}
+
+// Call calls the module's octant_call export.
+func (m *wazeroModule) Call(ctx context.Context, method string, request []byte) ([]byte, error) {
+	methodPtr, err := writeGuestBytes(ctx, m.module, []byte(method))
+	if err != nil {
+		return nil, fmt.Errorf("writing method: %w", err)
+	}
+	defer m.release(ctx, methodPtr)
+
+	requestPtr, err := writeGuestBytes(ctx, m.module, request)
+	if err != nil {
+		return nil, fmt.Errorf("writing request: %w", err)
+	}
+	defer m.release(ctx, requestPtr)
+
+	results, err := m.call.Call(ctx,
+		methodPtr>>32, uint64(uint32(methodPtr)),
+		requestPtr>>32, uint64(uint32(requestPtr)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) != 1 {
+		return nil, fmt.Errorf("%s returned %d results", wasmCallExport, len(results))
+	}
+	defer m.release(ctx, results[0])
+
+	out, ok := readGuestBytes(ctx, m.module, uint32(results[0]>>32), uint32(results[0]))
+	if !ok {
+		return nil, fmt.Errorf("response is out of range of module memory")
+	}
+
+	return out, nil
+}
+
+// Close closes the module and its runtime.
+func (m *wazeroModule) Close(ctx context.Context) error {
+	return m.runtime.Close(ctx)
+}
+
+// release frees memory allocated in the module if it exports octant_free.
+func (m *wazeroModule) release(ctx context.Context, packed uint64) {
+	if m.free == nil || packed == 0 {
+		return
+	}
+
+	_, _ = m.free.Call(ctx, packed>>32, uint64(uint32(packed)))
+}
+
+// writeGuestBytes allocates memory in a module with octant_malloc, copies data to it, and
+// returns the pointer and length packed into a 64 bit integer.
+func writeGuestBytes(ctx context.Context, mod api.Module, data []byte) (uint64, error) {
+	malloc := mod.ExportedFunction(wasmMallocExport)
+	if malloc == nil {
+		return 0, fmt.Errorf("module does not export %s", wasmMallocExport)
+	}
+
+	results, err := malloc.Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, fmt.Errorf("allocating %d bytes: %w", len(data), err)
+	}
+	if len(results) != 1 {
+		return 0, fmt.Errorf("%s returned %d results", wasmMallocExport, len(results))
+	}
+
+	ptr := uint32(results[0])
+	if !mod.Memory().Write(ctx, ptr, data) {
+		return 0, fmt.Errorf("%d bytes at %d are out of range of module memory", len(data), ptr)
+	}
+
+	return uint64(ptr)<<32 | uint64(len(data)), nil
+}
+
+// readGuestBytes copies bytes out of a module's memory.
+func readGuestBytes(ctx context.Context, mod api.Module, ptr, length uint32) ([]byte, bool) {
+	view, ok := mod.Memory().Read(ctx, ptr, length)
+	if !ok {
+		return nil, false
+	}
+
+	data := make([]byte, len(view))
+	copy(data, view)
+	return data, true
+}
+
+func readGuestString(ctx context.Context, mod api.Module, ptr, length uint32) (string, bool) {
+	data, ok := readGuestBytes(ctx, mod, ptr, length)
+	return string(data), ok
+}
@@ -0,0 +1,157 @@
+//go:build wasm_plugins
+// +build wasm_plugins
+
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestWazeroModule(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(pod, nil)
+
+	host := &wasmHost{objectStore: objectStore, logger: log.NopLogger()}
+	ctx := context.Background()
+
+	module, err := createWazeroModule(ctx, "test.wasm", forwardingWASMModule(), host)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, module.Close(ctx))
+	}()
+
+	out, err := module.Call(ctx, "Get", []byte(`{"key":{"namespace":"default","apiVersion":"v1","kind":"Pod","name":"pod"}}`))
+	require.NoError(t, err)
+
+	var got wasmEnvelope
+	require.NoError(t, json.Unmarshal(out, &got))
+	require.Empty(t, got.Error)
+	assert.Contains(t, string(got.Result), `"name":"pod"`)
+}
+
+func TestWazeroModule_missingExports(t *testing.T) {
+	host := &wasmHost{logger: log.NopLogger()}
+
+	// An empty module with only the header.
+	_, err := createWazeroModule(context.Background(), "empty.wasm", []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}, host)
+	require.EqualError(t, err, "module does not export octant_malloc")
+}
+
+// forwardingWASMModule returns a module that logs the method name and forwards every
+// call to the dashboard_request host function. It is equivalent to:
+//
+//	(module
+//	  (import "octant" "dashboard_request" (func $request (param i32 i32 i32 i32) (result i64)))
+//	  (import "octant" "log" (func $log (param i32 i32 i32)))
+//	  (memory (export "memory") 1)
+//	  (global $heap (mut i32) (i32.const 1024))
+//	  (func (export "octant_malloc") (param i32) (result i32)
+//	    global.get $heap
+//	    global.get $heap local.get 0 i32.add global.set $heap)
+//	  (func (export "octant_call") (param i32 i32 i32 i32) (result i64)
+//	    i32.const 1 local.get 0 local.get 1 call $log
+//	    local.get 0 local.get 1 local.get 2 local.get 3 call $request))
+func forwardingWASMModule() []byte {
+	const (
+		i32 = 0x7f
+		i64 = 0x7e
+	)
+
+	vec := func(items ...[]byte) []byte {
+		out := []byte{byte(len(items))}
+		for _, item := range items {
+			out = append(out, item...)
+		}
+		return out
+	}
+	name := func(s string) []byte {
+		return append([]byte{byte(len(s))}, s...)
+	}
+	section := func(id byte, items ...[]byte) []byte {
+		content := vec(items...)
+		return append([]byte{id, byte(len(content))}, content...)
+	}
+	join := func(parts ...[]byte) []byte {
+		var out []byte
+		for _, part := range parts {
+			out = append(out, part...)
+		}
+		return out
+	}
+	code := func(body ...byte) []byte {
+		body = append([]byte{0x00}, body...)
+		return append([]byte{byte(len(body))}, body...)
+	}
+
+	return join(
+		[]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00},
+		section(1,
+			[]byte{0x60, 4, i32, i32, i32, i32, 1, i64},
+			[]byte{0x60, 3, i32, i32, i32, 0},
+			[]byte{0x60, 1, i32, 1, i32},
+		),
+		section(2,
+			join(name("octant"), name("dashboard_request"), []byte{0x00, 0}),
+			join(name("octant"), name("log"), []byte{0x00, 1}),
+		),
+		section(3, []byte{2}, []byte{0}),
+		section(5, []byte{0x00, 1}),
+		section(6, []byte{i32, 0x01, 0x41, 0x80, 0x08, 0x0b}),
+		section(7,
+			join(name("memory"), []byte{0x02, 0}),
+			join(name("octant_malloc"), []byte{0x00, 2}),
+			join(name("octant_call"), []byte{0x00, 3}),
+		),
+		section(10,
+			code(0x23, 0, 0x23, 0, 0x20, 0, 0x6a, 0x24, 0, 0x0b),
+			code(0x41, 1, 0x20, 0, 0x20, 1, 0x10, 1,
+				0x20, 0, 0x20, 1, 0x20, 2, 0x20, 3, 0x10, 0, 0x0b),
+		),
+	)
+}
+
+func TestWriteGuestBytes(t *testing.T) {
+	host := &wasmHost{logger: log.NopLogger()}
+	ctx := context.Background()
+
+	module, err := createWazeroModule(ctx, "test.wasm", forwardingWASMModule(), host)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, module.Close(ctx))
+	}()
+
+	packed, err := writeGuestBytes(ctx, module.module, []byte{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1024)<<32, packed, "zero-length allocation")
+
+	packed, err = writeGuestBytes(ctx, module.module, []byte("data"))
+	require.NoError(t, err)
+	got, ok := readGuestBytes(ctx, module.module, uint32(packed>>32), uint32(packed))
+	require.True(t, ok)
+	assert.Equal(t, []byte("data"), got)
+
+	_, err = writeGuestBytes(ctx, module.module, make([]byte, 1<<16))
+	require.Error(t, err)
+}
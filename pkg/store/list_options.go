@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// cacheContinuePrefix prefixes continue tokens for pages listed from cached objects,
+// so they can be told apart from tokens issued by the API server.
+const cacheContinuePrefix = "cache:"
+
+// IsPaged returns true if the key lists a page of objects.
+func (k Key) IsPaged() bool {
+	return k.Limit > 0 || k.Continue != ""
+}
+
+// ListOptions converts the key to options for listing objects from the API server.
+func (k Key) ListOptions() metav1.ListOptions {
+	labelSelector := labels.Everything()
+	if k.Selector != nil {
+		labelSelector = k.Selector.AsSelector()
+	}
+
+	options := metav1.ListOptions{
+		LabelSelector: labelSelector.String(),
+		Limit:         k.Limit,
+		Continue:      k.Continue,
+	}
+
+	if k.FieldSelector != nil {
+		options.FieldSelector = k.FieldSelector.AsSelector().String()
+	}
+
+	return options
+}
+
+// MatchesFieldSelector returns true if an object matches a field selector. Fields
+// are paths in the object, e.g. spec.nodeName. Fields missing from the object
+// have an empty value.
+func MatchesFieldSelector(object *unstructured.Unstructured, fieldSelector *fields.Set) bool {
+	if fieldSelector == nil || object == nil {
+		return true
+	}
+
+	selector := fieldSelector.AsSelector()
+	if selector.Empty() {
+		return true
+	}
+
+	set := fields.Set{}
+	for _, requirement := range selector.Requirements() {
+		value, found, err := unstructured.NestedFieldNoCopy(object.Object, strings.Split(requirement.Field, ".")...)
+		if err != nil || !found || value == nil {
+			set[requirement.Field] = ""
+			continue
+		}
+		set[requirement.Field] = fmt.Sprint(value)
+	}
+
+	return selector.Matches(set)
+}
+
+// IsCacheContinueToken returns true if a continue token was created by Paginate.
+func IsCacheContinueToken(token string) bool {
+	return strings.HasPrefix(token, cacheContinuePrefix)
+}
+
+// Paginate returns the page of a list of objects selected by a key's limit and
+// continue token. Objects are sorted by namespace and name. If objects remain after
+// the page, the page's continue token is set.
+func Paginate(list *unstructured.UnstructuredList, key Key) (*unstructured.UnstructuredList, error) {
+	if list == nil {
+		return &unstructured.UnstructuredList{}, nil
+	}
+
+	var after string
+	if key.Continue != "" {
+		if !IsCacheContinueToken(key.Continue) {
+			return nil, fmt.Errorf("invalid continue token %q", key.Continue)
+		}
+
+		data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(key.Continue, cacheContinuePrefix))
+		if err != nil {
+			return nil, fmt.Errorf("invalid continue token %q: %w", key.Continue, err)
+		}
+		after = string(data)
+	}
+
+	items := make([]unstructured.Unstructured, len(list.Items))
+	copy(items, list.Items)
+	sort.Slice(items, func(i, j int) bool {
+		return objectPosition(&items[i]) < objectPosition(&items[j])
+	})
+
+	page := &unstructured.UnstructuredList{}
+	for i := range items {
+		if after != "" && objectPosition(&items[i]) <= after {
+			continue
+		}
+
+		if key.Limit > 0 && int64(len(page.Items)) == key.Limit {
+			last := objectPosition(&page.Items[len(page.Items)-1])
+			page.SetContinue(cacheContinuePrefix + base64.RawURLEncoding.EncodeToString([]byte(last)))
+			break
+		}
+
+		page.Items = append(page.Items, items[i])
+	}
+
+	return page, nil
+}
+
+func objectPosition(object *unstructured.Unstructured) string {
+	return object.GetNamespace() + "/" + object.GetName()
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+)
+
+func TestKey_ListOptions(t *testing.T) {
+	key := Key{
+		Namespace:     "default",
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		Selector:      &labels.Set{"app": "app"},
+		FieldSelector: &fields.Set{"spec.nodeName": "node"},
+		Limit:         10,
+		Continue:      "token",
+	}
+
+	expected := metav1.ListOptions{
+		LabelSelector: "app=app",
+		FieldSelector: "spec.nodeName=node",
+		Limit:         10,
+		Continue:      "token",
+	}
+
+	assert.Equal(t, expected, key.ListOptions())
+	assert.True(t, key.IsPaged())
+	assert.False(t, Key{APIVersion: "v1", Kind: "Pod"}.IsPaged())
+}
+
+func TestMatchesFieldSelector(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.NodeName = "node"
+	object := testutil.ToUnstructured(t, pod)
+
+	tests := []struct {
+		name          string
+		fieldSelector *fields.Set
+		expected      bool
+	}{
+		{
+			name:     "no selector",
+			expected: true,
+		},
+		{
+			name:          "matching fields",
+			fieldSelector: &fields.Set{"metadata.name": "pod", "spec.nodeName": "node"},
+			expected:      true,
+		},
+		{
+			name:          "field with different value",
+			fieldSelector: &fields.Set{"spec.nodeName": "other"},
+		},
+		{
+			name:          "missing field",
+			fieldSelector: &fields.Set{"spec.missing": "value"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, MatchesFieldSelector(object, test.fieldSelector))
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	list := testutil.ToUnstructuredList(t,
+		testutil.CreatePod("c"),
+		testutil.CreatePod("a"),
+		testutil.CreatePod("b"),
+	)
+
+	key := Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Limit: 2}
+
+	page, err := Paginate(list, key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, objectNames(page))
+	require.NotEmpty(t, page.GetContinue())
+	assert.True(t, IsCacheContinueToken(page.GetContinue()))
+
+	key.Continue = page.GetContinue()
+	page, err = Paginate(list, key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, objectNames(page))
+	assert.Empty(t, page.GetContinue())
+
+	key.Continue = "server-token"
+	_, err = Paginate(list, key)
+	require.Error(t, err)
+}
+
+func objectNames(list *unstructured.UnstructuredList) []string {
+	var names []string
+	for i := range list.Items {
+		names = append(names, list.Items[i].GetName())
+	}
+	return names
+}
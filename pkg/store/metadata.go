@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MetadataLister is a store that can list object metadata without caching full objects.
+type MetadataLister interface {
+	ListMetadata(ctx context.Context, key Key) (list *metav1.PartialObjectMetadataList, loading bool, err error)
+}
+
+// ListMetadata lists metadata for objects matching a key. Stores that are not a
+// MetadataLister list full objects, and their metadata is returned.
+func ListMetadata(ctx context.Context, o Store, key Key) (*metav1.PartialObjectMetadataList, bool, error) {
+	if lister, ok := o.(MetadataLister); ok {
+		return lister.ListMetadata(ctx, key)
+	}
+
+	list, loading, err := o.List(ctx, key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	metadataList, err := ToPartialObjectMetadataList(list)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return metadataList, loading, nil
+}
+
+// ListMatching lists objects matching a key for which match returns true. If the
+// store is a MetadataLister, only metadata is listed, and full objects are fetched
+// for matches only.
+func ListMatching(ctx context.Context, o Store, key Key, match func(object metav1.Object) bool) (*unstructured.UnstructuredList, error) {
+	out := &unstructured.UnstructuredList{}
+
+	lister, ok := o.(MetadataLister)
+	if !ok {
+		list, _, err := o.List(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range list.Items {
+			if match(&list.Items[i]) {
+				out.Items = append(out.Items, list.Items[i])
+			}
+		}
+
+		return out, nil
+	}
+
+	metadataList, _, err := lister.ListMetadata(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range metadataList.Items {
+		if !match(&metadataList.Items[i]) {
+			continue
+		}
+
+		objectKey := key
+		objectKey.Name = metadataList.Items[i].Name
+		objectKey.Namespace = metadataList.Items[i].Namespace
+		objectKey.Selector = nil
+
+		object, err := o.Get(ctx, objectKey)
+		if kerrors.IsNotFound(err) || (err == nil && object == nil) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "get %s", objectKey)
+		}
+
+		out.Items = append(out.Items, *object)
+	}
+
+	return out, nil
+}
+
+// ToPartialObjectMetadataList converts a list of objects to a list of their metadata.
+func ToPartialObjectMetadataList(list *unstructured.UnstructuredList) (*metav1.PartialObjectMetadataList, error) {
+	out := &metav1.PartialObjectMetadataList{}
+	if list == nil {
+		return out, nil
+	}
+
+	for i := range list.Items {
+		var item metav1.PartialObjectMetadata
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &item); err != nil {
+			return nil, errors.Wrapf(err, "convert %s %s to metadata", list.Items[i].GetKind(), list.Items[i].GetName())
+		}
+
+		out.Items = append(out.Items, item)
+	}
+
+	return out, nil
+}
+
+// FromPartialObjectMetadataList converts a list of object metadata to a list of objects
+// which only contain their apiVersion, kind, and metadata.
+func FromPartialObjectMetadataList(list *metav1.PartialObjectMetadataList, apiVersion, kind string) (*unstructured.UnstructuredList, error) {
+	out := &unstructured.UnstructuredList{}
+	if list == nil {
+		return out, nil
+	}
+
+	for i := range list.Items {
+		metadata, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&list.Items[i].ObjectMeta)
+		if err != nil {
+			return nil, errors.Wrapf(err, "convert metadata of %s %s", kind, list.Items[i].Name)
+		}
+
+		object := unstructured.Unstructured{Object: map[string]interface{}{"metadata": metadata}}
+		object.SetAPIVersion(apiVersion)
+		object.SetKind(kind)
+
+		out.Items = append(out.Items, object)
+	}
+
+	return out, nil
+}
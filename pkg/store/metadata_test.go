@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestListMetadata(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Secret"}
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(testutil.ToUnstructuredList(t, testutil.CreateSecret("secret")), true, nil)
+
+	list, loading, err := store.ListMetadata(context.Background(), objectStore, key)
+	require.NoError(t, err)
+	assert.True(t, loading)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "secret", list.Items[0].Name)
+	assert.Equal(t, "Secret", list.Items[0].Kind)
+}
+
+func TestListMatching(t *testing.T) {
+	key := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Secret"}
+	match := func(object metav1.Object) bool {
+		return object.GetName() == "match"
+	}
+
+	t.Run("store lists full objects", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		objectStore := fake.NewMockStore(controller)
+		objectStore.EXPECT().
+			List(gomock.Any(), key).
+			Return(testutil.ToUnstructuredList(t, testutil.CreateSecret("match"), testutil.CreateSecret("other")), false, nil)
+
+		list, err := store.ListMatching(context.Background(), objectStore, key, match)
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "match", list.Items[0].GetName())
+	})
+
+	t.Run("store lists metadata", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		objectStore := &metadataStore{
+			MockStore: fake.NewMockStore(controller),
+			items: []metav1.PartialObjectMetadata{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: testutil.DefaultNamespace, Name: "match"}},
+				{ObjectMeta: metav1.ObjectMeta{Namespace: testutil.DefaultNamespace, Name: "other"}},
+			},
+		}
+
+		getKey := key
+		getKey.Name = "match"
+		objectStore.EXPECT().
+			Get(gomock.Any(), getKey).
+			Return(testutil.ToUnstructured(t, testutil.CreateSecret("match")), nil)
+
+		list, err := store.ListMatching(context.Background(), objectStore, key, match)
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "match", list.Items[0].GetName())
+	})
+}
+
+type metadataStore struct {
+	*fake.MockStore
+	items []metav1.PartialObjectMetadata
+}
+
+var _ store.MetadataLister = (*metadataStore)(nil)
+
+func (s *metadataStore) ListMetadata(context.Context, store.Key) (*metav1.PartialObjectMetadataList, bool, error) {
+	return &metav1.PartialObjectMetadataList{Items: s.items}, false, nil
+}
+
+func TestFromPartialObjectMetadataList(t *testing.T) {
+	metadataList := &metav1.PartialObjectMetadataList{
+		Items: []metav1.PartialObjectMetadata{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: testutil.DefaultNamespace,
+					Labels:    map[string]string{"app": "app"},
+				},
+			},
+		},
+	}
+
+	list, err := store.FromPartialObjectMetadataList(metadataList, "v1", "Secret")
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+
+	object := list.Items[0]
+	assert.Equal(t, "v1", object.GetAPIVersion())
+	assert.Equal(t, "Secret", object.GetKind())
+	assert.Equal(t, "secret", object.GetName())
+	assert.Equal(t, testutil.DefaultNamespace, object.GetNamespace())
+	assert.Equal(t, map[string]string{"app": "app"}, object.GetLabels())
+	assert.NotContains(t, object.Object, "data")
+}
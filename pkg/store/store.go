@@ -12,6 +12,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -43,11 +44,17 @@ type Store interface {
 
 // Key is a key for the object store.
 type Key struct {
-	Namespace  string      `json:"namespace"`
-	APIVersion string      `json:"apiVersion"`
-	Kind       string      `json:"kind"`
-	Name       string      `json:"name"`
-	Selector   *labels.Set `json:"selector"`
+	Namespace     string      `json:"namespace"`
+	APIVersion    string      `json:"apiVersion"`
+	Kind          string      `json:"kind"`
+	Name          string      `json:"name"`
+	Selector      *labels.Set `json:"selector"`
+	FieldSelector *fields.Set `json:"fieldSelector,omitempty"`
+	// Limit is the maximum number of objects a list returns. If there are more objects,
+	// the list's continue token is set. A limit of 0 is unlimited.
+	Limit int64 `json:"limit,omitempty"`
+	// Continue is the continue token of the previous list, used to list the next page.
+	Continue string `json:"continue,omitempty"`
 }
 
 func (k Key) String() string {
@@ -68,6 +75,18 @@ func (k Key) String() string {
 		sb.WriteString(fmt.Sprintf(", Selector='%s'", k.Selector.String()))
 	}
 
+	if k.FieldSelector != nil && k.FieldSelector.String() != "" {
+		sb.WriteString(fmt.Sprintf(", FieldSelector='%s'", k.FieldSelector.String()))
+	}
+
+	if k.Limit > 0 {
+		sb.WriteString(fmt.Sprintf(", Limit='%d'", k.Limit))
+	}
+
+	if k.Continue != "" {
+		sb.WriteString(fmt.Sprintf(", Continue='%s'", k.Continue))
+	}
+
 	sb.WriteString("]")
 
 	return sb.String()
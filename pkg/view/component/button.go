@@ -25,11 +25,20 @@ func WithButtonConfirmation(title, body string) ButtonOption {
 	}
 }
 
+// WithButtonForm configures a button with a form. The form's values are
+// added to the button's payload when it is submitted.
+func WithButtonForm(form Form) ButtonOption {
+	return func(button *Button) {
+		button.Form = &form
+	}
+}
+
 // Button is a button in a group.
 type Button struct {
 	Name         string         `json:"name"`
 	Payload      action.Payload `json:"payload"`
 	Confirmation *Confirmation  `json:"confirmation,omitempty"`
+	Form         *Form          `json:"form,omitempty"`
 }
 
 // NewButton creates an instance of Button.
@@ -39,6 +39,9 @@ type GridAction struct {
 	// Confirmation is a confirmation that will be show to the user before the
 	// action is invoked. It is optional.
 	Confirmation *Confirmation `json:"confirmation,omitempty"`
+	// Form is a form that will be shown to the user before the action is invoked.
+	// Its values are added to the payload. It is optional.
+	Form *Form `json:"form,omitempty"`
 	// Type is the type of button that will be created.
 	Type GridActionType `json:"type"`
 }
@@ -1,5 +1,10 @@
 import { Component, EventEmitter, Input, OnInit, Output } from '@angular/core';
-import { ButtonGroupView, Confirmation } from '../../../models/content';
+import { FormGroup } from '@angular/forms';
+import {
+  ActionForm,
+  ButtonGroupView,
+  Confirmation,
+} from '../../../models/content';
 import { ActionService } from '../../../services/action/action.service';
 
 @Component({
@@ -22,6 +27,7 @@ export class ButtonGroupComponent implements OnInit {
   isModalOpen = false;
   modalTitle = '';
   modalBody = '';
+  modalForm: ActionForm;
   payload = {};
   class = '';
 
@@ -39,9 +45,9 @@ export class ButtonGroupComponent implements OnInit {
     }
   }
 
-  onClick(payload: {}, confirmation?: Confirmation) {
-    if (confirmation) {
-      this.activateModal(payload, confirmation);
+  onClick(payload: {}, confirmation?: Confirmation, form?: ActionForm) {
+    if (confirmation || form) {
+      this.activateModal(payload, confirmation, form);
     } else {
       this.buttonLoad.emit(true);
       this.doAction(payload);
@@ -52,8 +58,9 @@ export class ButtonGroupComponent implements OnInit {
     this.resetModal();
   }
 
-  acceptModal() {
-    const payload = this.payload;
+  acceptModal(formGroup?: FormGroup) {
+    const formValues = formGroup ? formGroup.value : {};
+    const payload = { ...this.payload, ...formValues };
     this.resetModal();
     this.doAction(payload);
   }
@@ -66,9 +73,14 @@ export class ButtonGroupComponent implements OnInit {
     this.actionService.perform(payload);
   }
 
-  private activateModal(payload: {}, confirmation: Confirmation) {
-    this.modalTitle = confirmation.title;
-    this.modalBody = confirmation.body;
+  private activateModal(
+    payload: {},
+    confirmation?: Confirmation,
+    form?: ActionForm
+  ) {
+    this.modalTitle = confirmation ? confirmation.title : '';
+    this.modalBody = confirmation ? confirmation.body : '';
+    this.modalForm = form;
     this.isModalOpen = true;
 
     this.payload = payload;
@@ -78,6 +90,7 @@ export class ButtonGroupComponent implements OnInit {
     this.isModalOpen = false;
     this.modalBody = '';
     this.modalTitle = '';
+    this.modalForm = undefined;
     this.payload = {};
   }
 }
@@ -4,7 +4,9 @@
 
 import { ClrDatagridSortOrder } from '@clr/angular';
 import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { FormGroup } from '@angular/forms';
 import {
+  ActionForm,
   Confirmation,
   GridAction,
   GridActionsView,
@@ -103,7 +105,7 @@ export class DatagridComponent implements OnChanges {
   }
 
   runAction(action: GridAction) {
-    if (!action.confirmation) {
+    if (!action.confirmation && !action.form) {
       const update = { ...action.payload, action: action.actionPath };
       this.actionService.perform(update);
       return;
@@ -114,6 +116,7 @@ export class DatagridComponent implements OnChanges {
       text: action.name,
       type: action.type,
       confirmation: action.confirmation,
+      form: action.form,
     };
 
     this.isModalOpen = true;
@@ -130,14 +133,15 @@ export class DatagridComponent implements OnChanges {
     this.resetModal();
   }
 
-  acceptModal() {
+  acceptModal(formGroup?: FormGroup) {
     if (this.actionDialogOptions === undefined) {
       return;
     }
 
     const action = this.actionDialogOptions.action;
     const actionPath = this.actionDialogOptions.action.actionPath;
-    const update = { ...action.payload, action: actionPath };
+    const formValues = formGroup ? formGroup.value : {};
+    const update = { ...action.payload, ...formValues, action: actionPath };
     this.actionService.perform(update);
 
     this.resetModal();
@@ -154,4 +158,5 @@ interface ActionDialogOptions {
   text: string;
   type: string;
   confirmation?: Confirmation;
+  form?: ActionForm;
 }
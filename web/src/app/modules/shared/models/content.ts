@@ -109,6 +109,7 @@ export interface Button {
   payload: {};
   name: string;
   confirmation?: Confirmation;
+  form?: ActionForm;
 }
 
 export interface ButtonGroupView extends View {
@@ -129,6 +130,7 @@ export interface GridAction {
   actionPath: string;
   payload: {};
   confirmation?: Confirmation;
+  form?: ActionForm;
   type: string;
 }
 